@@ -1,15 +1,20 @@
 package ocpp
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"runtime/debug"
 	"sync"
 	"time"
 
 	"github.com/aliml92/ocpp/logger"
 	"github.com/aliml92/ocpp/v16"
 	"github.com/aliml92/ocpp/v201"
+	"github.com/aliml92/ocpp/v21"
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 )
@@ -21,6 +26,7 @@ func init() {
 const (
 	ocppV16  = "ocpp1.6"
 	ocppV201 = "ocpp2.0.1"
+	ocppV21  = "ocpp2.1"
 
 	// Time allowed to wait until corresponding ocpp call result received
 	ocppWait = 20 * time.Second
@@ -41,6 +47,7 @@ const (
 // TODO: refactor or wrap with function
 var validateV16 = v16.Validate
 var validateV201 = v201.Validate
+var validateV21 = v21.Validate
 
 var log logger.Logger
 
@@ -54,6 +61,7 @@ func SetLogger(logger logger.Logger) {
 var ErrChargePointNotConnected = errors.New("charge point not connected")
 var ErrCallQuequeFull = errors.New("call queque full")
 var ErrChargePointDisconnected = errors.New("charge point disconnected unexpectedly")
+var ErrChargePointClosing = errors.New("charge point is shutting down")
 
 // ChargePoint Represents a connected ChargePoint (also known as a Charging Station)
 type ChargePoint struct {
@@ -67,7 +75,7 @@ type ChargePoint struct {
 	Id string
 
 	// outgoing message channel
-	out chan []byte
+	out chan outgoing
 
 	// incoming message channel
 	in chan []byte
@@ -86,8 +94,7 @@ type ChargePoint struct {
 	isServer bool
 
 	// TODO:
-	validatePayloadFunc   func(s interface{}) error
-	unmarshalResponseFunc func(a string, r json.RawMessage) (Payload, error)
+	validatePayloadFunc func(s interface{}) error
 
 	// ping in channel
 	pingIn chan []byte
@@ -106,6 +113,39 @@ type ChargePoint struct {
 
 	stopC        chan struct{}
 	dispatcherIn chan *callReq
+	// pending is the call request waiting for its response
+	pending *callReq
+
+	// peer is the Server or Client that owns this ChargePoint
+	peer Peer
+
+	// clock of the peer, used for Call timeouts and outbox retries
+	clock Clock
+
+	// inFlight tracks outgoing Calls and running handlers
+	inFlight sync.WaitGroup
+	// draining is set once no more Calls or handlers may start
+	draining bool
+	// writerDone is closed when the writer goroutine exits
+	writerDone chan struct{}
+	// readerDone is closed when the reader goroutine exits
+	readerDone chan struct{}
+	// shutdown is set by Shutdown, a client-side ChargePoint
+	// does not reconnect afterwards
+	shutdown bool
+
+	// readErr is the error that ended the reader goroutine
+	readErr error
+
+	// jobs are incoming Call handler runs waiting for a worker
+	// when strict ordering is enabled
+	jobsMu      sync.Mutex
+	jobs        []func()
+	jobsRunning bool
+
+	// outbox queues transaction-related messages on the client side,
+	// it is nil unless enabled with Client.EnableOutbox
+	outbox *outbox
 }
 
 // TimeoutConfig is for setting timeout configs at ChargePoint level
@@ -135,23 +175,44 @@ func (e *TimeoutError) Error() string {
 	return fmt.Sprintf("3: %s", e.Message)
 }
 
+// outgoing is a message for the writer goroutine
+type outgoing struct {
+	data []byte
+	// written is closed after data has been written to the socket, it may be nil
+	written chan struct{}
+}
+
 // callReq is a container for calls
 type callReq struct {
 	id       string
 	data     []byte
 	recvChan chan interface{}
+	// ctx of the caller, the call is abandoned once it is done
+	ctx context.Context
+	// done is closed when the dispatcher stops waiting for the response
+	done chan struct{}
 }
 
 // Payload used as a container is for both Call and CallResult' Payload
 type Payload interface{}
 
+// Peer is implemented by Server and Client, the owners of ChargePoints
 type Peer interface {
-	getHandler(string) func(*ChargePoint, Payload) Payload
+	getHandler(string) func(context.Context, *ChargePoint, Payload) Payload
 	getAfterHandler(string) func(*ChargePoint, Payload)
+	setHandler(string, func(context.Context, *ChargePoint, Payload) Payload)
+	getHandlerTimeout(string) time.Duration
+	getInterceptors() interceptors
+	getValidationMode(action string, dir Direction) ValidationMode
+	getValidationBackend() ValidationBackend
+	getCallQueueSize() int
+	// getWorkerPool returns nil if handlers run on the reader goroutine
+	getWorkerPool() (pool *workerPool, ordered bool)
+	getClock() Clock
 }
 
 func (cp *ChargePoint) unmarshalResponse(a string, r json.RawMessage) (Payload, error) {
-	return cp.unmarshalResponseFunc(a, r)
+	return unmarshalResponsePayload(a, r, cp.proto)
 }
 
 func (cp *ChargePoint) validatePayload(v interface{}) error {
@@ -170,10 +231,109 @@ func (cp *ChargePoint) IsConnected() bool {
 	return cp.connected
 }
 
+// TLSConnectionState returns the state of the TLS connection,
+// or nil if the connection is not secured with TLS
+func (cp *ChargePoint) TLSConnectionState() *tls.ConnectionState {
+	cp.mu.Lock()
+	conn := cp.conn
+	cp.mu.Unlock()
+	c, ok := conn.UnderlyingConn().(*tls.Conn)
+	if !ok {
+		return nil
+	}
+	state := c.ConnectionState()
+	return &state
+}
+
+// PeerCertificate returns the leaf certificate presented by the other side
+// of the connection: the charging station's client certificate on the server
+// side and the CSMS certificate on the client side. It returns nil if the
+// connection is not secured with TLS or no certificate was presented
+func (cp *ChargePoint) PeerCertificate() *x509.Certificate {
+	state := cp.TLSConnectionState()
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return nil
+	}
+	return state.PeerCertificates[0]
+}
+
 func (cp *ChargePoint) Shutdown() {
+	cp.mu.Lock()
+	cp.shutdown = true
+	cp.mu.Unlock()
+	cp.close(websocket.CloseNormalClosure, "")
+	if cp.outbox != nil {
+		cp.outbox.notify()
+	}
+}
+
+func (cp *ChargePoint) isShutdown() bool {
 	cp.mu.Lock()
 	defer cp.mu.Unlock()
-	cp.closeC <- websocket.CloseError{Code: websocket.CloseNormalClosure, Text: ""}
+	return cp.shutdown
+}
+
+// close asks the writer goroutine to send a close frame with the given code
+func (cp *ChargePoint) close(code int, text string) {
+	cp.mu.Lock()
+	closeC := cp.closeC
+	cp.mu.Unlock()
+	select {
+	case closeC <- websocket.CloseError{Code: code, Text: text}:
+	default:
+	}
+}
+
+// beginInFlight registers a Call or a handler run, it returns false
+// once the ChargePoint has started draining
+func (cp *ChargePoint) beginInFlight() bool {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	if cp.draining {
+		return false
+	}
+	cp.inFlight.Add(1)
+	return true
+}
+
+// drain stops new Calls and handlers from starting and waits for
+// the ones in progress to finish or ctx to expire
+func (cp *ChargePoint) drain(ctx context.Context) error {
+	cp.mu.Lock()
+	cp.draining = true
+	cp.mu.Unlock()
+	done := make(chan struct{})
+	go func() {
+		cp.inFlight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// write passes a message to the writer goroutine,
+// it returns false if the writer has already exited
+func (cp *ChargePoint) write(msg []byte) bool {
+	// setConn replaces writerDone on reconnect
+	cp.mu.Lock()
+	out, writerDone := cp.out, cp.writerDone
+	cp.mu.Unlock()
+	return writeTo(out, writerDone, outgoing{data: cp.interceptFrame(msg)})
+}
+
+// writeTo is like write for handler jobs which must not read connection
+// bound fields of the ChargePoint, since it may reconnect meanwhile
+func writeTo(out chan<- outgoing, writerDone <-chan struct{}, msg outgoing) bool {
+	select {
+	case out <- msg:
+		return true
+	case <-writerDone:
+		return false
+	}
 }
 
 // ResetPingPong resets ping/pong configuration upon WebSocketPingInterval
@@ -249,14 +409,24 @@ func (cp *ChargePoint) EnableServerPing(t int) (err error) {
 // and it runs as a goroutine on client-side charge point (physical device)
 func (cp *ChargePoint) clientReader() {
 	defer func() {
+		cp.mu.Lock()
 		cp.connected = false
+		cp.mu.Unlock()
+		close(cp.readerDone)
+		c := cp.peer.(*Client)
+		c.disconnected(cp, cp.readErr)
+		if c.reconnectEnabled() && !cp.isShutdown() {
+			go c.reconnect(cp)
+		} else {
+			c.closeWorkers()
+		}
 	}()
 	cp.conn.SetPongHandler(func(appData string) error {
 		log.Debug("<- pong")
 		return cp.conn.SetReadDeadline(cp.getReadTimeout())
 	})
 	for {
-		if cp.processIncoming(client) {
+		if cp.processIncoming(cp.peer) {
 			break
 		}
 	}
@@ -267,6 +437,7 @@ func (cp *ChargePoint) clientReader() {
 func (cp *ChargePoint) clientWriter() {
 	defer func() {
 		_ = cp.conn.Close()
+		close(cp.writerDone)
 	}()
 	if cp.tc.pingPeriod != 0 {
 		cp.ticker = time.NewTicker(cp.tc.pingPeriod)
@@ -291,10 +462,13 @@ func (cp *ChargePoint) serverReader() {
 	})
 	defer func() {
 		_ = cp.conn.Close()
-		server.Delete(cp.Id)
+		s := cp.server()
+		s.remove(cp)
+		s.disconnected(cp, cp.readErr)
+		close(cp.readerDone)
 	}()
 	for {
-		if cp.processIncoming(server) {
+		if cp.processIncoming(cp.peer) {
 			break
 		}
 	}
@@ -303,7 +477,10 @@ func (cp *ChargePoint) serverReader() {
 // serverWriter writes websocket messages
 // and it runs as a goroutine on server-side charge point (virtual device)
 func (cp *ChargePoint) serverWriter() {
-	defer server.Delete(cp.Id)
+	defer func() {
+		close(cp.writerDone)
+		cp.server().remove(cp)
+	}()
 	for {
 		if !cp.processOutgoing() {
 			break
@@ -319,11 +496,15 @@ func (cp *ChargePoint) serverWriter() {
 //   - ocpp Call
 //   - ocpp CallResult
 //   - ocpp CallError
+//
+// ocpp2.1 adds Send, which is handled like a Call without response, and
+// CallResultError, which is only logged
 func (cp *ChargePoint) processIncoming(peer Peer) (br bool) {
 	messageType, msg, err := cp.conn.ReadMessage()
 	log.Debugf("messageType: %d", messageType)
 	if err != nil {
 		log.Debug(err)
+		cp.readErr = err
 		if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure, websocket.CloseNormalClosure) {
 			// TODO: handle specific logs
 			log.Debug(err)
@@ -335,62 +516,208 @@ func (cp *ChargePoint) processIncoming(peer Peer) (br bool) {
 		return true
 	}
 	ocppMsg, err := unpack(msg, cp.proto)
-
-	// TODO: handle this situation carefully
-	// at this level, it is unknown if err.(*ocppError) is caused from a corrupt Call
-	// this is very complicated case, because it could be any of corrupted Call, CallResult or CallError
-	// possible solution:
-	//
-	//   -   if err.id is known to application (for example, id of waiting Call request in queque)
-	//       this means msg is a corrupted CallResult or CallError
-	//       and then the err can be dropped and pushed to logger
-	//   -   if err.id is "-1", there is a chance it could be a corrupted Call if call request queque is empty
-	//       only in this case, CallError can be constructed and send to the peer
-	if ocppMsg == nil && err != nil {
-		log.Error(err)
+	var frameErr *frameError
+	if errors.As(err, &frameErr) {
+		cp.rejectFrame(frameErr)
 		return
 	}
 	if call, ok := ocppMsg.(*Call); ok {
+		send := call.MessageTypeId == MessageTypeIdSend
+		if err == nil {
+			if e := cp.checkRequest(call.Action, Incoming, call.Payload, call.raw); e != nil {
+				log.Errorf("invalid %s from %s: %s", call.Action, cp.Id, e.cause)
+				err = e
+			}
+		}
+		if err != nil && send {
+			// a Send is never answered, not even with a CallError
+			log.Errorf("dropping %s Send from %s: %v", call.Action, cp.Id, err)
+			return
+		}
 		if err != nil {
-			cp.out <- call.createCallError(err)
+			cp.write(call.createCallError(err, cp.proto))
 			return
 		}
-		handler := peer.getHandler(call.Action)
-		if handler != nil {
-			// TODO: possible feature additions
-			//   -  pushing an incoming Call into a queque
-			//   -  pass Context with timeout down to handler
-			//   -  or recover from panic and print error logs
-			responsePayload := handler(cp, call.Payload)
-			err = cp.validatePayload(responsePayload)
-			if err != nil {
-				log.Error(err)
-			} else {
-				cp.out <- call.createCallResult(responsePayload)
-				if afterHandler := peer.getAfterHandler(call.Action); afterHandler != nil {
-					// hadcoded delay between a Call and after Call handler
-					time.Sleep(time.Second)
-					go afterHandler(cp, call.Payload)
-				}
+		if !cp.beginInFlight() {
+			if send {
+				log.Errorf("dropping %s Send from %s: connection is shutting down", call.Action, cp.Id)
+				return
 			}
-		} else {
-			var err error = &ocppError{
+			err = &ocppError{
 				id:    call.UniqueId,
-				code:  "NotSupported",
-				cause: fmt.Sprintf("Action %s is not supported", call.Action),
+				code:  GenericError,
+				cause: "Charge point connection is shutting down",
 			}
-			cp.out <- call.createCallError(err)
-			log.Errorf("No handler for action %s", call.Action)
+			cp.write(call.createCallError(err, cp.proto))
+			return
 		}
+		out, writerDone := cp.out, cp.writerDone
+		scheduled := cp.schedule(func() {
+			defer cp.inFlight.Done()
+			cp.handleCall(peer, call, out, writerDone)
+		})
+		if !scheduled {
+			cp.inFlight.Done()
+			if send {
+				log.Errorf("dropping %s Send from %s: worker pool is busy", call.Action, cp.Id)
+				return
+			}
+			err = &ocppError{
+				id:    call.UniqueId,
+				code:  InternalError,
+				cause: "Too many Calls are being handled, try again later",
+			}
+			cp.write(call.createCallError(err, cp.proto))
+		}
+	} else if ce, ok := ocppMsg.(*CallError); ok && ce.MessageTypeId == MessageTypeIdCallResultError {
+		log.Errorf("%s rejected the CallResult with id %s: %s %s %v", cp.Id, ce.UniqueId, ce.ErrorCode, ce.ErrorDescription, ce.ErrorDetails)
 	} else {
+		cp.mu.Lock()
+		pending := cp.pending
+		cp.mu.Unlock()
+		if pending == nil || pending.id != ocppMsg.getID() {
+			log.Debugf("dropping response to unknown or abandoned Call with id: %s", ocppMsg.getID())
+			return
+		}
 		select {
 		case cp.ocppRespCh <- ocppMsg:
-		default:
+		case <-pending.done:
 		}
 	}
 	return false
 }
 
+// rejectFrame logs a malformed frame and answers it with a CallError if it may
+// be a corrupted Call. A frame that may be the corrupted response to our
+// pending Call, since its MessageId is unknown or the one of the pending Call,
+// is only dropped: a CallError in reply to a response is never sent
+func (cp *ChargePoint) rejectFrame(e *frameError) {
+	log.Errorf("malformed message from %s: %v", cp.Id, e)
+	if !e.reply {
+		return
+	}
+	cp.mu.Lock()
+	pending := cp.pending
+	cp.mu.Unlock()
+	if pending != nil && (e.id == "-1" || e.id == pending.id) {
+		return
+	}
+	cp.write(newCallError(e.id, e, cp.proto).marshal())
+}
+
+// handleCall runs the middleware and handler of an incoming Call, writes the
+// CallResult and starts the after-handler once the CallResult has been written
+// to the socket
+func (cp *ChargePoint) handleCall(peer Peer, call *Call, out chan<- outgoing, writerDone <-chan struct{}) {
+	responsePayload, err := cp.runHandler(peer, call)
+	if call.MessageTypeId == MessageTypeIdSend {
+		if err != nil {
+			log.Errorf("%s Send handler of %s failed: %v", call.Action, cp.Id, err)
+			return
+		}
+		if afterHandler := peer.getAfterHandler(call.Action); afterHandler != nil {
+			cp.runAfterHandler(afterHandler, call)
+		}
+		return
+	}
+	if err != nil {
+		frame := call.createCallError(err, cp.proto)
+		writeTo(out, writerDone, outgoing{data: cp.interceptFrame(frame)})
+		return
+	}
+	if e := cp.checkResponse(call.Action, Outgoing, responsePayload, nil); e != nil {
+		// the peer waits for a response, tell it that ours is broken
+		log.Errorf("invalid %s response to %s: %s", call.Action, cp.Id, e.cause)
+		e.code = InternalError
+		frame := call.createCallError(e, cp.proto)
+		writeTo(out, writerDone, outgoing{data: cp.interceptFrame(frame)})
+		return
+	}
+	afterHandler := peer.getAfterHandler(call.Action)
+	msg := outgoing{data: cp.interceptFrame(call.createCallResult(responsePayload))}
+	if afterHandler != nil {
+		msg.written = make(chan struct{})
+	}
+	if !writeTo(out, writerDone, msg) || afterHandler == nil {
+		return
+	}
+	select {
+	case <-msg.written:
+	case <-writerDone:
+		select {
+		case <-msg.written:
+		default:
+			// the connection was closed before the CallResult was written
+			return
+		}
+	}
+	cp.runAfterHandler(afterHandler, call)
+}
+
+// runAfterHandler starts the after-handler of call on its own goroutine
+func (cp *ChargePoint) runAfterHandler(afterHandler func(*ChargePoint, Payload), call *Call) {
+	cp.inFlight.Add(1)
+	go func() {
+		defer cp.inFlight.Done()
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("panic in %s after-handler of %s: %v\n%s", call.Action, cp.Id, r, debug.Stack())
+			}
+		}()
+		afterHandler(cp, call.Payload)
+	}()
+}
+
+// runHandler runs handler with the deadline set for the action. A panic or an
+// expired deadline is returned as an InternalError, the result of a handler
+// that is still running after its deadline is discarded. Such a handler
+// stays in flight until it returns, so draining waits for it
+func (cp *ChargePoint) runHandler(peer Peer, call *Call) (Payload, error) {
+	timeout := peer.getHandlerTimeout(call.Action)
+	if timeout <= 0 {
+		return cp.callHandler(context.Background(), peer, call)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	type result struct {
+		p   Payload
+		err error
+	}
+	resC := make(chan result, 1)
+	cp.inFlight.Add(1)
+	go func() {
+		defer cp.inFlight.Done()
+		p, err := cp.callHandler(ctx, peer, call)
+		resC <- result{p, err}
+	}()
+	select {
+	case r := <-resC:
+		return r.p, r.err
+	case <-ctx.Done():
+		log.Errorf("%s handler of %s did not return within %s", call.Action, cp.Id, timeout)
+		return nil, &ocppError{
+			id:    call.UniqueId,
+			code:  InternalError,
+			cause: fmt.Sprintf("Handler for %s timed out", call.Action),
+		}
+	}
+}
+
+// callHandler recovers from a panic in the middleware or handler
+func (cp *ChargePoint) callHandler(ctx context.Context, peer Peer, call *Call) (p Payload, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("panic in %s handler of %s: %v\n%s", call.Action, cp.Id, r, debug.Stack())
+			err = &ocppError{
+				id:    call.UniqueId,
+				code:  InternalError,
+				cause: fmt.Sprintf("Handler for %s failed", call.Action),
+			}
+		}
+	}()
+	return cp.dispatchCall(ctx, peer, call)
+}
+
 // process outOutoing writes both ping/pong messages and ocpp messages
 // to websocket connection.
 // also listens on extra two channels:
@@ -419,7 +746,7 @@ func (cp *ChargePoint) processOutgoing() (br bool) {
 			log.Debug(err)
 			return
 		}
-		n, err := w.Write(message)
+		n, err := w.Write(message.data)
 		if err != nil {
 			log.Error(err)
 			return
@@ -428,6 +755,9 @@ func (cp *ChargePoint) processOutgoing() (br bool) {
 			log.Error(err)
 			return
 		}
+		if message.written != nil {
+			close(message.written)
+		}
 		log.Debugf("text msg -> %d", n)
 		return true
 	case <-cp.pingIn:
@@ -492,51 +822,17 @@ func (cp *ChargePoint) getReadTimeout() time.Time {
 
 // callDispatcher sends ocpp call requests
 func (cp *ChargePoint) callDispatcher() {
-	cleanUp := make(chan struct{}, 1)
 	for {
 		select {
 		case callReq := <-cp.dispatcherIn:
 			log.Debug("dispatcher in")
-			select {
-			case cp.out <- callReq.data:
-			case <-cp.stopC:
-				close(callReq.recvChan)
+			if !cp.dispatch(callReq) {
 				goto CleanupDrain
 			}
-			deadline := time.Now().Add(cp.tc.ocppWait)
-		in:
-			for {
-				select {
-				case <-cleanUp:
-					log.Debug("clean up")
-					break in
-				case <-cp.stopC:
-					log.Debug("charge point is closed")
-					close(callReq.recvChan)
-					goto CleanupDrain
-				case ocppResp := <-cp.ocppRespCh:
-					if ocppResp.getID() == callReq.id {
-						callReq.recvChan <- ocppResp
-						break in
-					}
-				case <-time.After(time.Until(deadline)):
-					log.Debug("ocpp timeout occured")
-					callReq.recvChan <- &TimeoutError{
-						Message: fmt.Sprintf("timeout of %s sec for response to Call with id: %s passed", cp.tc.ocppWait, callReq.id),
-					}
-					break in
-				}
-			}
-			log.Debug("broke from loop")
 		case <-cp.stopC:
 			log.Debug("charge point is closed")
-			select {
-			case cleanUp <- struct{}{}:
-			default:
-			}
 			goto CleanupDrain
 		}
-
 	}
 
 CleanupDrain:
@@ -555,16 +851,128 @@ CleanupDrain:
 
 }
 
-// Call sends a message to peer
+// dispatch sends a single call request and waits for its response,
+// it returns false if the connection has been closed meanwhile
+func (cp *ChargePoint) dispatch(callReq *callReq) bool {
+	if callReq.ctx.Err() != nil {
+		log.Debugf("call with id: %s canceled before sending", callReq.id)
+		return true
+	}
+	callReq.done = make(chan struct{})
+	cp.mu.Lock()
+	cp.pending = callReq
+	cp.mu.Unlock()
+	defer func() {
+		cp.mu.Lock()
+		cp.pending = nil
+		cp.mu.Unlock()
+		close(callReq.done)
+	}()
+	select {
+	case cp.out <- outgoing{data: callReq.data}:
+	case <-callReq.ctx.Done():
+		log.Debugf("call with id: %s canceled before sending", callReq.id)
+		return true
+	case <-cp.stopC:
+		close(callReq.recvChan)
+		return false
+	}
+	timeout, stop := cp.clock.NewTimer(cp.tc.ocppWait)
+	defer stop()
+	for {
+		select {
+		case <-cp.stopC:
+			log.Debug("charge point is closed")
+			close(callReq.recvChan)
+			return false
+		case ocppResp := <-cp.ocppRespCh:
+			if ocppResp.getID() == callReq.id {
+				callReq.recvChan <- ocppResp
+				return true
+			}
+		case <-callReq.ctx.Done():
+			// a late response is dropped by processIncoming
+			log.Debugf("call with id: %s canceled", callReq.id)
+			return true
+		case <-timeout:
+			log.Debug("ocpp timeout occured")
+			callReq.recvChan <- &TimeoutError{
+				Message: fmt.Sprintf("timeout of %s sec for response to Call with id: %s passed", cp.tc.ocppWait, callReq.id),
+			}
+			return true
+		}
+	}
+}
+
+// Call sends a message to peer.
+// If the outbox is enabled for action and the ChargePoint is offline or
+// older messages are still queued, the message is queued instead and
+// ErrMessageQueued is returned; its response is reported to the function
+// registered with Client.OnOutboxResult. Sent directly, it is retried after
+// a CallError or an invalid response up to OutboxConfig.Attempts times like
+// a queued one
 func (cp *ChargePoint) Call(action string, p Payload) (Payload, error) {
+	return cp.CallContext(context.Background(), action, p)
+}
+
+// CallContext is like Call but stops waiting for the response when ctx is
+// canceled or its deadline passes, returning ctx.Err(). A canceled Call is
+// not sent if it is still in the queue, and a response arriving after
+// cancellation is discarded. The TimeoutConfig response timeout still applies
+func (cp *ChargePoint) CallContext(ctx context.Context, action string, p Payload) (Payload, error) {
+	if ob := cp.outbox; ob != nil && ob.actions[action] {
+		_, queued, err := ob.store.Front()
+		if err != nil {
+			return nil, err
+		}
+		if queued || !cp.IsConnected() {
+			if err := cp.enqueue(action, p); err != nil {
+				return nil, err
+			}
+			return nil, ErrMessageQueued
+		}
+		attempts := 0
+		res, err := cp.callTransaction(ctx, action, p, &attempts)
+		if errors.Is(err, ErrChargePointNotConnected) || errors.Is(err, ErrChargePointDisconnected) {
+			if err := cp.enqueue(action, p); err != nil {
+				return nil, err
+			}
+			return nil, ErrMessageQueued
+		}
+		return res, err
+	}
+	return cp.call(ctx, action, p)
+}
+
+// call sends a Call through the call interceptors of the peer
+func (cp *ChargePoint) call(ctx context.Context, action string, p Payload) (Payload, error) {
+	var f CallFunc = func(ctx context.Context, cp *ChargePoint, action string, p Payload) (Payload, error) {
+		return cp.send(ctx, action, p)
+	}
+	ic := cp.peer.getInterceptors().calls
+	for i := len(ic) - 1; i >= 0; i-- {
+		f = ic[i](f)
+	}
+	return f(ctx, cp, action, p)
+}
+
+func (cp *ChargePoint) send(ctx context.Context, action string, p Payload) (Payload, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	// check if charge point is connected
 	if !cp.IsConnected() {
 		return nil, ErrChargePointNotConnected
 	}
-	// add validator function
-	err := cp.validatePayload(p)
-	if err != nil {
-		return nil, err
+	if !cp.beginInFlight() {
+		return nil, ErrChargePointClosing
+	}
+	defer cp.inFlight.Done()
+	if _, ok := responseMap(cp.proto)[action]; !ok && requestMap(cp.proto)[action] != nil {
+		return nil, fmt.Errorf("ocpp: %s has no response, use Send", action)
+	}
+	if e := cp.checkRequest(action, Outgoing, p, nil); e != nil {
+		return nil, e
 	}
 	id := uuid.New().String()
 	call := [4]interface{}{
@@ -577,8 +985,9 @@ func (cp *ChargePoint) Call(action string, p Payload) (Payload, error) {
 	recvChan := make(chan interface{}, 1)
 	cr := &callReq{
 		id:       id,
-		data:     raw,
+		data:     cp.interceptFrame(raw),
 		recvChan: recvChan,
+		ctx:      ctx,
 	}
 	select {
 	case cp.dispatcherIn <- cr:
@@ -586,15 +995,26 @@ func (cp *ChargePoint) Call(action string, p Payload) (Payload, error) {
 	default:
 		return nil, ErrCallQuequeFull
 	}
-	r, ok := <-recvChan
+	var r interface{}
+	var ok bool
+	select {
+	case r, ok = <-recvChan:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
 	if !ok {
 		return nil, ErrChargePointDisconnected
 	}
 	if callResult, ok := r.(*CallResult); ok {
 		resPayload, err := cp.unmarshalResponse(action, callResult.Payload)
 		if err != nil {
+			cp.rejectCallResult(id, err)
 			return nil, err
 		}
+		if e := cp.checkResponse(action, Incoming, resPayload, callResult.Payload); e != nil {
+			cp.rejectCallResult(id, e)
+			return nil, e
+		}
 		return resPayload, nil
 	}
 	if callError, ok := r.(*CallError); ok {
@@ -603,49 +1023,138 @@ func (cp *ChargePoint) Call(action string, p Payload) (Payload, error) {
 	return nil, r.(*TimeoutError)
 }
 
-// NewChargepoint creates a new ChargePoint
-func NewChargePoint(conn *websocket.Conn, id, proto string, isServer bool) *ChargePoint {
+// rejectCallResult tells an ocpp2.1 peer with a CallResultError that its
+// CallResult to the Call with the given id could not be processed
+func (cp *ChargePoint) rejectCallResult(id string, err error) {
+	if cp.proto != ocppV21 {
+		return
+	}
+	ce := newCallError(id, err, cp.proto)
+	ce.MessageTypeId = MessageTypeIdCallResultError
+	cp.write(ce.marshal())
+}
+
+// Send sends an unconfirmed message in a Send frame of ocpp2.1, such as
+// NotifyPeriodicEventStream. The peer does not reply, so Send returns once
+// the message has been passed to the writer
+func (cp *ChargePoint) Send(action string, p Payload) error {
+	if cp.proto != ocppV21 {
+		return fmt.Errorf("ocpp: Send is not supported by %s", cp.proto)
+	}
+	if !cp.IsConnected() {
+		return ErrChargePointNotConnected
+	}
+	if !cp.beginInFlight() {
+		return ErrChargePointClosing
+	}
+	defer cp.inFlight.Done()
+	if _, ok := requestMap(cp.proto)[action]; !ok {
+		return fmt.Errorf("ocpp: unknown action %s", action)
+	}
+	if e := cp.checkRequest(action, Outgoing, p, nil); e != nil {
+		return e
+	}
+	send := [4]interface{}{
+		MessageTypeIdSend,
+		uuid.New().String(),
+		action,
+		p,
+	}
+	raw, err := json.Marshal(send)
+	if err != nil {
+		return err
+	}
+	if !cp.write(raw) {
+		return ErrChargePointDisconnected
+	}
+	return nil
+}
+
+// NewChargepoint creates a new ChargePoint owned by peer, which must be
+// either a *Server or a *Client
+func NewChargePoint(conn *websocket.Conn, id, proto string, peer Peer) *ChargePoint {
+	cp := newChargePoint(conn, id, proto, peer)
+	cp.start()
+	return cp
+}
+
+// newChargePoint creates a ChargePoint without starting the goroutines
+// serving its connection
+func newChargePoint(conn *websocket.Conn, id, proto string, peer Peer) *ChargePoint {
 	cp := &ChargePoint{
-		proto:       proto,
-		conn:        conn,
-		Id:          id,
-		out:         make(chan []byte),
-		in:          make(chan []byte),
-		ocppRespCh:  make(chan OcppMessage),
-		Extras:      make(map[string]interface{}),
-		closeC:      make(chan websocket.CloseError, 1),
-		forceWClose: make(chan error, 1),
-		stopC:       make(chan struct{}),
-		connected:   true,
-	}
-	if isServer {
-		cp.dispatcherIn = make(chan *callReq, server.getCallQueueSize())
+		proto:        proto,
+		Id:           id,
+		out:          make(chan outgoing),
+		in:           make(chan []byte),
+		ocppRespCh:   make(chan OcppMessage),
+		Extras:       make(map[string]interface{}),
+		dispatcherIn: make(chan *callReq, peer.getCallQueueSize()),
+		peer:         peer,
+		clock:        peer.getClock(),
+	}
+	cp.setPayloadValidator()
+	cp.setConn(conn)
+	switch p := peer.(type) {
+	case *Server:
 		cp.pingIn = make(chan []byte)
 		cp.isServer = true
 		cp.tickerC = nil
-		cp.inheritServerTimeoutConfig()
+		cp.inheritServerTimeoutConfig(p)
+	case *Client:
+		cp.inheritClientTimeoutConfig(p)
+		cp.outbox = p.getOutbox()
+	default:
+		panic("ocpp: peer must be either *Server or *Client")
+	}
+	return cp
+}
+
+// start runs the reader, writer and Call dispatcher of the connection
+func (cp *ChargePoint) start() {
+	if cp.isServer {
 		go cp.serverReader()
 		go cp.serverWriter()
 	} else {
-		cp.dispatcherIn = make(chan *callReq, client.callQuequeSize)
-		cp.inheritClientTimeoutConfig()
 		go cp.clientReader()
 		go cp.clientWriter()
+		if cp.outbox != nil {
+			go cp.deliverOutbox()
+			cp.outbox.notify()
+		}
 	}
 	go cp.callDispatcher()
-	cp.setResponseUnmarshaller()
-	cp.setPayloadValidator()
+}
 
-	return cp
+// setConn resets the state bound to a single websocket connection
+func (cp *ChargePoint) setConn(conn *websocket.Conn) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	cp.conn = conn
+	cp.closeC = make(chan websocket.CloseError, 1)
+	cp.forceWClose = make(chan error, 1)
+	cp.stopC = make(chan struct{})
+	cp.writerDone = make(chan struct{})
+	cp.readerDone = make(chan struct{})
+	cp.connected = true
 }
 
-func (cp *ChargePoint) setResponseUnmarshaller() {
-	switch cp.proto {
-	case ocppV16:
-		cp.unmarshalResponseFunc = unmarshalResponsePv16
-	case ocppV201:
-		cp.unmarshalResponseFunc = unmarshalResponsePv201
-	}
+// reconnect attaches a new connection to a client-side ChargePoint after
+// the goroutines of the previous connection have exited
+func (cp *ChargePoint) reconnect(conn *websocket.Conn) {
+	cp.setConn(conn)
+	go cp.clientReader()
+	go cp.clientWriter()
+	go cp.callDispatcher()
+}
+
+// Peer returns the Server or Client that owns the ChargePoint
+func (cp *ChargePoint) Peer() Peer {
+	return cp.peer
+}
+
+// server returns the owning Server of a server-side ChargePoint
+func (cp *ChargePoint) server() *Server {
+	return cp.peer.(*Server)
 }
 
 func (cp *ChargePoint) setPayloadValidator() {
@@ -654,18 +1163,24 @@ func (cp *ChargePoint) setPayloadValidator() {
 		cp.validatePayloadFunc = validateV16.Struct
 	case ocppV201:
 		cp.validatePayloadFunc = validateV201.Struct
+	case ocppV21:
+		cp.validatePayloadFunc = validateV21.Struct
 	}
 }
 
-func (cp *ChargePoint) inheritServerTimeoutConfig() {
-	cp.tc.ocppWait = server.ocppWait
-	cp.tc.writeWait = server.writeWait
-	cp.tc.pingWait = server.pingWait
+func (cp *ChargePoint) inheritServerTimeoutConfig(s *Server) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cp.tc.ocppWait = s.ocppWait
+	cp.tc.writeWait = s.writeWait
+	cp.tc.pingWait = s.pingWait
 }
 
-func (cp *ChargePoint) inheritClientTimeoutConfig() {
-	cp.tc.ocppWait = client.ocppWait
-	cp.tc.writeWait = client.writeWait
-	cp.tc.pongWait = client.pongWait
-	cp.tc.pingPeriod = client.pingPeriod
+func (cp *ChargePoint) inheritClientTimeoutConfig(c *Client) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cp.tc.ocppWait = c.ocppWait
+	cp.tc.writeWait = c.writeWait
+	cp.tc.pongWait = c.pongWait
+	cp.tc.pingPeriod = c.pingPeriod
 }
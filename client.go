@@ -1,16 +1,19 @@
 package ocpp
 
 import (
+	"context"
+	"crypto/tls"
 	"encoding/base64"
+	"math/rand"
+	"net"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
 )
 
-var client *Client
-
 type ClientTimeoutConfig struct {
 	// ocpp response timeout in seconds
 	OcppWait time.Duration
@@ -25,10 +28,25 @@ type ClientTimeoutConfig struct {
 	PingPeriod time.Duration
 }
 
+// ReconnectConfig configures the backoff between reconnection attempts,
+// it mirrors the OCPP 2.0.1 RetryBackOff* configuration variables
+type ReconnectConfig struct {
+	// delay before the first attempt (RetryBackOffWaitMinimum),
+	// one second if not set
+	InitialDelay time.Duration
+
+	// upper limit of the delay, which doubles after every failed attempt,
+	// one hour if not set
+	MaxDelay time.Duration
+
+	// maximum random duration added to every delay (RetryBackOffRandomRange)
+	Jitter time.Duration
+}
+
 type Client struct {
 	Id string
 	// register implemented action handler functions
-	actionHandlers map[string]func(*ChargePoint, Payload) Payload
+	actionHandlers map[string]func(context.Context, *ChargePoint, Payload) Payload
 	// register after-action habdler functions
 	afterHandlers map[string]func(*ChargePoint, Payload)
 	// timeout configuration
@@ -42,49 +60,238 @@ type Client struct {
 
 	header http.Header
 
-	returnError func(error)
+	// dialer is used to open the websocket connection
+	dialer *websocket.Dialer
+
+	// url of the CSMS, set by Start
+	url string
+
+	mu sync.Mutex
+
+	// reconnectConfig is nil unless reconnection is enabled
+	reconnectConfig *ReconnectConfig
+
+	onReconnect func(*ChargePoint)
+
+	// outbox is nil unless enabled with EnableOutbox
+	outbox *outbox
+
+	// connection lifecycle hooks
+	onConnect    func(*ChargePoint)
+	onDisconnect func(*ChargePoint, error)
 
 	callQuequeSize int
+
+	// middleware and outbound interceptors
+	interceptors interceptors
+
+	// validation modes set by SetValidationMode
+	validationModes validationModes
+	// validationBackend set by SetValidationBackend
+	validationBackend ValidationBackend
+
+	// handlerTimeouts maps actions to handler deadlines, "" is the default
+	handlerTimeouts map[string]time.Duration
+
+	// handlers run on workers, it is started with the first incoming Call
+	workers        *workerPool
+	workerPoolSize int
+	unordered      bool
+
+	// clock set by SetClock
+	clock Clock
 }
 
 // create new Client instance
 func NewClient() *Client {
-	client = &Client{
-		actionHandlers: make(map[string]func(*ChargePoint, Payload) Payload),
+	return &Client{
+		actionHandlers: make(map[string]func(context.Context, *ChargePoint, Payload) Payload),
 		afterHandlers:  make(map[string]func(*ChargePoint, Payload)),
 		ocppWait:       ocppWait,
 		writeWait:      writeWait,
 		pongWait:       pongWait,
 		pingPeriod:     pingPeriod,
+		workerPoolSize: defaultWorkerPoolSize,
 		header:         http.Header{},
+		dialer: &websocket.Dialer{
+			Proxy:            http.ProxyFromEnvironment,
+			HandshakeTimeout: 45 * time.Second,
+		},
+	}
+}
+
+// SetWorkerPoolSize sets the number of goroutines running action handlers.
+// With size 0 handlers run on the reader goroutine. A Call is answered with
+// an InternalError CallError when 64 handlers per goroutine, or with strict
+// ordering 64 Calls, are already queued. It must be called before the first
+// Call is received
+func (c *Client) SetWorkerPoolSize(size int) {
+	c.mu.Lock()
+	c.workerPoolSize = size
+	c.mu.Unlock()
+}
+
+// SetStrictOrdering controls whether Calls are handled one at a time in
+// the order they arrived, which is the default
+func (c *Client) SetStrictOrdering(strict bool) {
+	c.mu.Lock()
+	c.unordered = !strict
+	c.mu.Unlock()
+}
+
+func (c *Client) getWorkerPool() (*workerPool, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.workers == nil && c.workerPoolSize > 0 {
+		c.workers = newWorkerPool(c.workerPoolSize)
+	}
+	return c.workers, !c.unordered
+}
+
+// closeWorkers stops the worker pool once the ChargePoint is closed for good,
+// a new pool is started if the Client is started again
+func (c *Client) closeWorkers() {
+	c.mu.Lock()
+	workers := c.workers
+	c.workers = nil
+	c.mu.Unlock()
+	if workers != nil {
+		workers.close()
+	}
+}
+
+// SetHandlerTimeout limits how long the handler of action may run, an empty
+// action sets the limit of all actions without their own. When it expires the
+// handler's context is cancelled and an InternalError CallError is sent.
+// A zero timeout means no limit
+func (c *Client) SetHandlerTimeout(action string, timeout time.Duration) {
+	c.mu.Lock()
+	if c.handlerTimeouts == nil {
+		c.handlerTimeouts = make(map[string]time.Duration)
+	}
+	c.handlerTimeouts[action] = timeout
+	c.mu.Unlock()
+}
+
+func (c *Client) getHandlerTimeout(action string) time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if timeout, ok := c.handlerTimeouts[action]; ok {
+		return timeout
+	}
+	return c.handlerTimeouts[""]
+}
+
+// SetValidationMode sets how payloads of action sent or received in dir are
+// validated, an empty action sets the mode of all actions without their own.
+// Invalid incoming Calls are answered with a CallError and our own invalid
+// responses are replaced by an InternalError CallError in strict mode
+func (c *Client) SetValidationMode(action string, dir Direction, mode ValidationMode) {
+	c.mu.Lock()
+	if c.validationModes == nil {
+		c.validationModes = make(validationModes)
 	}
-	return client
+	c.validationModes.set(action, dir, mode)
+	c.mu.Unlock()
+}
+
+func (c *Client) getValidationMode(action string, dir Direction) ValidationMode {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.validationModes.get(action, dir)
+}
+
+// SetValidationBackend sets what payloads are validated against, either the
+// struct tags of the v16 and v201 types (the default) or the OCPP JSON schemas
+func (c *Client) SetValidationBackend(b ValidationBackend) {
+	c.mu.Lock()
+	c.validationBackend = b
+	c.mu.Unlock()
+}
+
+func (c *Client) getValidationBackend() ValidationBackend {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.validationBackend
 }
 
 func (c *Client) SetCallQueueSize(size int) {
+	c.mu.Lock()
 	c.callQuequeSize = size
+	c.mu.Unlock()
+}
+
+func (c *Client) getCallQueueSize() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.callQuequeSize
 }
 
 func (c *Client) SetTimeoutConfig(config ClientTimeoutConfig) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.ocppWait = config.OcppWait
 	c.writeWait = config.WriteWait
 	c.pongWait = config.PongWait
 	c.pingPeriod = config.PingPeriod
 }
 
-// register action handler function
+// register action handler function, the handler may return a *CallError
+// created by NewCallError instead of a response payload
 func (c *Client) On(action string, f func(*ChargePoint, Payload) Payload) *Client {
+	c.actionHandlers[action] = func(_ context.Context, cp *ChargePoint, p Payload) Payload {
+		return f(cp, p)
+	}
+	return c
+}
+
+// register action handler function that receives a context, it is
+// cancelled when the handler timeout set by SetHandlerTimeout expires
+func (c *Client) OnContext(action string, f func(context.Context, *ChargePoint, Payload) Payload) *Client {
 	c.actionHandlers[action] = f
 	return c
 }
 
+// Use adds middleware around the dispatch of incoming Calls, the first
+// middleware added is the outermost
+func (c *Client) Use(mw ...Middleware) *Client {
+	c.mu.Lock()
+	c.interceptors.middleware = append(c.interceptors.middleware, mw...)
+	c.mu.Unlock()
+	return c
+}
+
+// UseCall adds interceptors around outgoing Calls, the first
+// interceptor added is the outermost
+func (c *Client) UseCall(ic ...CallInterceptor) *Client {
+	c.mu.Lock()
+	c.interceptors.calls = append(c.interceptors.calls, ic...)
+	c.mu.Unlock()
+	return c
+}
+
+// UseFrame adds interceptors of raw outgoing Call, CallResult and CallError
+// frames, they run in the order they were added
+func (c *Client) UseFrame(ic ...FrameInterceptor) *Client {
+	c.mu.Lock()
+	c.interceptors.frames = append(c.interceptors.frames, ic...)
+	c.mu.Unlock()
+	return c
+}
+
+func (c *Client) getInterceptors() interceptors {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.interceptors
+}
+
 // register after-action handler function
 func (c *Client) After(action string, f func(*ChargePoint, Payload)) *Client {
 	c.afterHandlers[action] = f
 	return c
 }
 
-func (c *Client) getHandler(action string) func(*ChargePoint, Payload) Payload {
+func (c *Client) getHandler(action string) func(context.Context, *ChargePoint, Payload) Payload {
 	return c.actionHandlers[action]
 }
 
@@ -92,6 +299,10 @@ func (c *Client) getAfterHandler(action string) func(*ChargePoint, Payload) {
 	return c.afterHandlers[action]
 }
 
+func (c *Client) setHandler(action string, f func(context.Context, *ChargePoint, Payload) Payload) {
+	c.actionHandlers[action] = f
+}
+
 func (c *Client) AddSubProtocol(protocol string) {
 	c.header.Add("Sec-WebSocket-Protocol", protocol)
 }
@@ -102,20 +313,204 @@ func (c *Client) SetBasicAuth(username string, password string) {
 	c.header.Set("Authorization", "Basic "+enc)
 }
 
+// SetTLSConfig sets the TLS configuration used to dial wss:// addresses.
+// Set RootCAs to trust a custom CA (security profile 2) and Certificates
+// to present a client certificate (security profile 3)
+func (c *Client) SetTLSConfig(config *tls.Config) {
+	c.dialer.TLSClientConfig = config
+}
+
 func (c *Client) Start(addr string, path string) (cp *ChargePoint, err error) {
 	urlStr, err := url.JoinPath(addr, path, c.Id)
 	if err != nil {
-		c.returnError(err)
 		return
 	}
-	conn, _, err := websocket.DefaultDialer.Dial(urlStr, c.header)
+	c.mu.Lock()
+	c.url = urlStr
+	c.mu.Unlock()
+	conn, err := c.dial()
 	if err != nil {
 		return
 	}
-	cp = NewChargePoint(conn, c.Id, conn.Subprotocol(), false)
+	cp = NewChargePoint(conn, c.Id, conn.Subprotocol(), c)
+	c.connected(cp)
 	return
 }
 
+// SetNetDialContext sets the function opening the network connection to the
+// CSMS, e.g. to dial through an in-memory network in tests
+func (c *Client) SetNetDialContext(f func(ctx context.Context, network, addr string) (net.Conn, error)) {
+	c.dialer.NetDialContext = f
+}
+
+// SetClock replaces the clock used for Call timeouts, reconnection delays
+// and outbox retries. It must be called before Start
+func (c *Client) SetClock(clock Clock) {
+	c.mu.Lock()
+	c.clock = clock
+	c.mu.Unlock()
+}
+
+func (c *Client) getClock() Clock {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.clock == nil {
+		return RealClock{}
+	}
+	return c.clock
+}
+
 func (c *Client) SetID(id string) {
 	c.Id = id
 }
+
+// OnConnect registers a function called when the connection to the CSMS
+// is established, including every reconnection
+func (c *Client) OnConnect(f func(*ChargePoint)) *Client {
+	c.mu.Lock()
+	c.onConnect = f
+	c.mu.Unlock()
+	return c
+}
+
+// OnDisconnect registers a function called when the connection to the CSMS
+// is closed. err is the error that ended the connection, a *websocket.CloseError
+// carries the close code and reason sent by the CSMS
+func (c *Client) OnDisconnect(f func(cp *ChargePoint, err error)) *Client {
+	c.mu.Lock()
+	c.onDisconnect = f
+	c.mu.Unlock()
+	return c
+}
+
+func (c *Client) connected(cp *ChargePoint) {
+	c.mu.Lock()
+	onConnect := c.onConnect
+	c.mu.Unlock()
+	if onConnect != nil {
+		onConnect(cp)
+	}
+}
+
+func (c *Client) disconnected(cp *ChargePoint, err error) {
+	c.mu.Lock()
+	onDisconnect := c.onDisconnect
+	c.mu.Unlock()
+	if onDisconnect != nil {
+		onDisconnect(cp, err)
+	}
+}
+
+// EnableReconnect makes the ChargePoint returned by Start reconnect
+// automatically when the connection drops, unless it was closed with Shutdown.
+// The same ChargePoint with its handlers is reused after reconnection
+func (c *Client) EnableReconnect(config ReconnectConfig) *Client {
+	if config.InitialDelay <= 0 {
+		config.InitialDelay = time.Second
+	}
+	c.mu.Lock()
+	c.reconnectConfig = &config
+	c.mu.Unlock()
+	return c
+}
+
+// OnReconnect registers a function called after every successful reconnection,
+// e.g. to send BootNotification again
+func (c *Client) OnReconnect(f func(*ChargePoint)) *Client {
+	c.mu.Lock()
+	c.onReconnect = f
+	c.mu.Unlock()
+	return c
+}
+
+func (c *Client) reconnectEnabled() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.reconnectConfig != nil
+}
+
+func (c *Client) dial() (*websocket.Conn, error) {
+	c.mu.Lock()
+	urlStr := c.url
+	c.mu.Unlock()
+	conn, _, err := c.dialer.Dial(urlStr, c.header)
+	return conn, err
+}
+
+// backoff returns the delay before the given reconnection attempt
+func (c *Client) backoff(attempt int) time.Duration {
+	c.mu.Lock()
+	config := *c.reconnectConfig
+	c.mu.Unlock()
+	maxDelay := config.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = time.Hour
+	}
+	delay := config.InitialDelay
+	for i := 0; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	if config.Jitter > 0 {
+		delay += time.Duration(rand.Int63n(int64(config.Jitter)))
+	}
+	return delay
+}
+
+// reconnect dials the CSMS until it succeeds or cp is shut down
+func (c *Client) reconnect(cp *ChargePoint) {
+	<-cp.writerDone
+	for attempt := 0; ; attempt++ {
+		sleep(c.getClock(), c.backoff(attempt))
+		if cp.isShutdown() {
+			return
+		}
+		conn, err := c.dial()
+		if err != nil {
+			log.Debugf("reconnect attempt %d failed: %v", attempt+1, err)
+			continue
+		}
+		log.Debug("reconnected")
+		cp.reconnect(conn)
+		if cp.outbox != nil {
+			cp.outbox.notify()
+		}
+		c.connected(cp)
+		c.mu.Lock()
+		onReconnect := c.onReconnect
+		c.mu.Unlock()
+		if onReconnect != nil {
+			onReconnect(cp)
+		}
+		return
+	}
+}
+
+// EnableOutbox makes the ChargePoint returned by Start queue transaction-related
+// messages in store while offline and deliver them in order once connected.
+// It must be called before Start
+func (c *Client) EnableOutbox(store OutboxStore, config OutboxConfig) {
+	c.mu.Lock()
+	c.outbox = newOutbox(store, config)
+	c.mu.Unlock()
+}
+
+// OnOutboxResult registers a function called when a queued message has been
+// delivered, or dropped after the configured number of attempts.
+// It must be called after EnableOutbox
+func (c *Client) OnOutboxResult(f func(cp *ChargePoint, action string, res Payload, err error)) *Client {
+	c.mu.Lock()
+	if c.outbox != nil {
+		c.outbox.onResult = f
+	}
+	c.mu.Unlock()
+	return c
+}
+
+func (c *Client) getOutbox() *outbox {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.outbox
+}
@@ -0,0 +1,119 @@
+package ocpp
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aliml92/ocpp/v16"
+	"github.com/gorilla/websocket"
+)
+
+func TestClientReconnect(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+
+	c := NewClient()
+	c.SetID("cp1")
+	c.AddSubProtocol(ocppV16)
+	c.SetCallQueueSize(8)
+	reconnected := make(chan *ChargePoint, 1)
+	c.EnableReconnect(ReconnectConfig{InitialDelay: 50 * time.Millisecond, MaxDelay: time.Second}).OnReconnect(func(cp *ChargePoint) {
+		reconnected <- cp
+	})
+	connects := make(chan struct{}, 2)
+	c.OnConnect(func(cp *ChargePoint) {
+		connects <- struct{}{}
+	})
+	disconnected := make(chan error, 1)
+	c.OnDisconnect(func(cp *ChargePoint, err error) {
+		disconnected <- err
+	})
+	cp, err := c.Start("ws"+strings.TrimPrefix(ts.URL, "http"), "/ws")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cp.Shutdown()
+	bootNotification(t, cp)
+
+	serverCp, ok := csms.Load("cp1")
+	if !ok {
+		t.Fatal("cp1 is not stored on the server")
+	}
+	serverCp.Shutdown()
+
+	select {
+	case got := <-reconnected:
+		if got != cp {
+			t.Error("a new ChargePoint was created on reconnect")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("client did not reconnect")
+	}
+	if !cp.IsConnected() {
+		t.Error("charge point is not connected after reconnect")
+	}
+	if err := <-disconnected; !websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+		t.Errorf("got %v want normal closure", err)
+	}
+	if len(connects) != 2 {
+		t.Errorf("OnConnect called %d times want 2", len(connects))
+	}
+	bootNotification(t, cp)
+}
+
+func TestClientBackoff(t *testing.T) {
+	c := NewClient()
+	c.EnableReconnect(ReconnectConfig{InitialDelay: time.Second, MaxDelay: 5 * time.Second})
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, w := range want {
+		if got := c.backoff(i); got != w {
+			t.Errorf("attempt %d: got %s want %s", i, got, w)
+		}
+	}
+	c.EnableReconnect(ReconnectConfig{InitialDelay: time.Second, Jitter: time.Second})
+	for i := 0; i < 100; i++ {
+		if got := c.backoff(0); got < time.Second || got >= 2*time.Second {
+			t.Fatalf("got %s, want delay in [1s, 2s)", got)
+		}
+	}
+	c.EnableReconnect(ReconnectConfig{})
+	want = []time.Duration{time.Second, 2 * time.Second, 4 * time.Second}
+	for i, w := range want {
+		if got := c.backoff(i); got != w {
+			t.Errorf("zero config attempt %d: got %s want %s", i, got, w)
+		}
+	}
+}
+
+func TestCallContext(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+	csms.On("Authorize", func(cp *ChargePoint, p Payload) Payload {
+		time.Sleep(300 * time.Millisecond)
+		return &v16.AuthorizeConf{IdTagInfo: v16.IdTagInfo{Status: "Accepted"}}
+	})
+	cp := newTestClient(t, "cp1", ts.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := cp.CallContext(ctx, "Authorize", &v16.AuthorizeReq{IdTag: "tag"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 250*time.Millisecond {
+		t.Errorf("CallContext returned after %s", elapsed)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	if _, err := cp.CallContext(ctx, "Authorize", &v16.AuthorizeReq{IdTag: "tag"}); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v want context.Canceled", err)
+	}
+
+	// the late Authorize response must not be taken for this one
+	if got := *bootNotification(t, cp).Interval; got != 10 {
+		t.Errorf("got interval %d want 10", got)
+	}
+}
@@ -0,0 +1,46 @@
+package ocpp
+
+import (
+	"context"
+	"time"
+)
+
+// Clock is the source of time of Call timeouts, reconnection delays and
+// outbox retries. Tests replace it with a fake one, e.g. ocpptest.Clock,
+// to make these deterministic
+type Clock interface {
+	Now() time.Time
+	// NewTimer returns a channel receiving the time once d has passed and
+	// a function stopping the timer, which reports whether it was active
+	NewTimer(d time.Duration) (c <-chan time.Time, stop func() bool)
+}
+
+// RealClock is the default Clock backed by the time package
+type RealClock struct{}
+
+func (RealClock) Now() time.Time {
+	return time.Now()
+}
+
+func (RealClock) NewTimer(d time.Duration) (<-chan time.Time, func() bool) {
+	t := time.NewTimer(d)
+	return t.C, t.Stop
+}
+
+// sleep pauses the current goroutine for d on clock
+func sleep(clock Clock, d time.Duration) {
+	c, _ := clock.NewTimer(d)
+	<-c
+}
+
+// sleepContext is sleep returning early with the error of ctx once it is done
+func sleepContext(ctx context.Context, clock Clock, d time.Duration) error {
+	c, stop := clock.NewTimer(d)
+	defer stop()
+	select {
+	case <-c:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
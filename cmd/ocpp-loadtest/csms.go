@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/aliml92/ocpp"
+	"github.com/aliml92/ocpp/v16"
+	"github.com/aliml92/ocpp/v201"
+)
+
+// localCSMS is the Server started by -serve, it accepts every station and
+// answers the messages of the scenario as fast as it can
+type localCSMS struct {
+	server *ocpp.Server
+	hs     *http.Server
+	ln     net.Listener
+}
+
+// serve starts a Server for protocol listening on addr, under /ocpp/
+func serve(addr, protocol string, heartbeat time.Duration) (*localCSMS, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	server := ocpp.NewServer()
+	server.AddSubProtocol(protocol)
+	server.SetCallQueueSize(32)
+	interval := int(heartbeat / time.Second)
+	now := func() string {
+		return time.Now().UTC().Format(time.RFC3339)
+	}
+	var handlers map[string]func(ocpp.Payload) ocpp.Payload
+	if protocol == "ocpp2.0.1" {
+		handlers = map[string]func(ocpp.Payload) ocpp.Payload{
+			"BootNotification": func(ocpp.Payload) ocpp.Payload {
+				return &v201.BootNotificationRes{CurrentTime: now(), Interval: &interval, Status: v201.RegistrationStatusAccepted}
+			},
+			"Heartbeat": func(ocpp.Payload) ocpp.Payload {
+				return &v201.HeartbeatRes{CurrentTime: now()}
+			},
+			"StatusNotification": func(ocpp.Payload) ocpp.Payload { return &v201.StatusNotificationRes{} },
+			"Authorize": func(ocpp.Payload) ocpp.Payload {
+				return &v201.AuthorizeRes{IdTokenInfo: v201.IdTokenInfoType{Status: v201.AuthorizationStatusAccepted}}
+			},
+			"TransactionEvent": func(ocpp.Payload) ocpp.Payload { return &v201.TransactionEventRes{} },
+		}
+	} else {
+		var txId int64
+		handlers = map[string]func(ocpp.Payload) ocpp.Payload{
+			"BootNotification": func(ocpp.Payload) ocpp.Payload {
+				return &v16.BootNotificationConf{CurrentTime: now(), Interval: &interval, Status: v16.RegistrationStatusAccepted}
+			},
+			"Heartbeat": func(ocpp.Payload) ocpp.Payload {
+				return &v16.HeartbeatConf{CurrentTime: now()}
+			},
+			"StatusNotification": func(ocpp.Payload) ocpp.Payload { return &v16.StatusNotificationConf{} },
+			"Authorize": func(ocpp.Payload) ocpp.Payload {
+				return &v16.AuthorizeConf{IdTagInfo: v16.IdTagInfo{Status: v16.AuthorizationStatusAccepted}}
+			},
+			"StartTransaction": func(ocpp.Payload) ocpp.Payload {
+				id := int(atomic.AddInt64(&txId, 1))
+				return &v16.StartTransactionConf{
+					IdTagInfo:     v16.IdTagInfo{Status: v16.AuthorizationStatusAccepted},
+					TransactionId: &id,
+				}
+			},
+			"MeterValues":     func(ocpp.Payload) ocpp.Payload { return &v16.MeterValuesConf{} },
+			"StopTransaction": func(ocpp.Payload) ocpp.Payload { return &v16.StopTransactionConf{} },
+		}
+	}
+	for action, f := range handlers {
+		f := f
+		server.On(action, func(_ *ocpp.ChargePoint, p ocpp.Payload) ocpp.Payload { return f(p) })
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/ocpp/", server)
+	c := &localCSMS{server: server, hs: &http.Server{Handler: mux}, ln: ln}
+	go func() {
+		_ = c.hs.Serve(ln)
+	}()
+	return c, nil
+}
+
+// url is the address the stations connect to
+func (c *localCSMS) url() string {
+	return "ws://" + c.ln.Addr().String() + "/ocpp"
+}
+
+func (c *localCSMS) close() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_ = c.server.Shutdown(ctx)
+	_ = c.hs.Close()
+}
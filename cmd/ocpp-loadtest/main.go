@@ -0,0 +1,236 @@
+// Command ocpp-loadtest sizes a CSMS by connecting many simulated charging
+// stations to it. The stations are ramped up, boot, send heartbeats and run
+// transactions with periodic meter values; at the end the latency percentiles
+// of every action, the timeouts, CallErrors and reconnects are reported.
+//
+//	ocpp-loadtest -url ws://csms.example.com/ocpp -stations 2000 -ramp 1m -duration 10m
+//
+// With -serve the stations connect to a Server started by the command itself,
+// which benchmarks the library:
+//
+//	ocpp-loadtest -serve 127.0.0.1:0 -stations 1000 -meter-interval 10s
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aliml92/ocpp"
+	"github.com/aliml92/ocpp/simulator"
+)
+
+// config is the load test given on the command line
+type config struct {
+	url        string
+	serve      string
+	protocol   string
+	idPrefix   string
+	stations   int
+	connectors int
+	// ramp spreads the connections of the stations evenly
+	ramp time.Duration
+	// duration is how long the load is held after the ramp up
+	duration  time.Duration
+	heartbeat time.Duration
+	// txInterval is the mean idle time of a connector between two
+	// transactions, 0 disables transactions
+	txInterval    time.Duration
+	txDuration    time.Duration
+	meterInterval time.Duration
+	power         float64
+	timeout       time.Duration
+	reconnect     bool
+	report        time.Duration
+}
+
+func main() {
+	var cfg config
+	flag.StringVar(&cfg.url, "url", "ws://localhost:8999/ocpp", "CSMS websocket `url`, the station id is appended")
+	flag.StringVar(&cfg.serve, "serve", "", "start a local Server on `addr` and connect to it instead of -url")
+	flag.StringVar(&cfg.protocol, "protocol", "ocpp1.6", "ocpp1.6 or ocpp2.0.1")
+	flag.StringVar(&cfg.idPrefix, "id-prefix", "loadtest-", "prefix of the station ids")
+	flag.IntVar(&cfg.stations, "stations", 100, "number of concurrent station connections")
+	flag.IntVar(&cfg.connectors, "connectors", 1, "connectors per station")
+	flag.DurationVar(&cfg.ramp, "ramp", 10*time.Second, "time over which the stations connect")
+	flag.DurationVar(&cfg.duration, "duration", time.Minute, "how long the load is held after the ramp up")
+	flag.DurationVar(&cfg.heartbeat, "heartbeat", time.Minute, "heartbeat interval if the CSMS gives none, and the one given by -serve")
+	flag.DurationVar(&cfg.txInterval, "tx-interval", time.Minute, "mean idle time of a connector between transactions, 0 disables them")
+	flag.DurationVar(&cfg.txDuration, "tx-duration", 5*time.Minute, "duration of a transaction")
+	flag.DurationVar(&cfg.meterInterval, "meter-interval", 30*time.Second, "interval of meter values during a transaction")
+	flag.Float64Var(&cfg.power, "power", 11000, "charging power in W")
+	flag.DurationVar(&cfg.timeout, "timeout", 30*time.Second, "response timeout of a Call")
+	flag.BoolVar(&cfg.reconnect, "reconnect", true, "reconnect dropped stations")
+	flag.DurationVar(&cfg.report, "report", 10*time.Second, "interval of the progress lines, 0 disables them")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if err := run(ctx, cfg, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "ocpp-loadtest:", err)
+		os.Exit(1)
+	}
+}
+
+// run runs the load test until its duration is over or ctx is done and
+// writes the report to w
+func run(ctx context.Context, cfg config, w io.Writer) error {
+	if cfg.protocol != "ocpp1.6" && cfg.protocol != "ocpp2.0.1" {
+		return fmt.Errorf("unsupported protocol %q", cfg.protocol)
+	}
+	if cfg.stations <= 0 || cfg.connectors <= 0 {
+		return fmt.Errorf("-stations and -connectors must be positive")
+	}
+	if cfg.serve != "" {
+		csms, err := serve(cfg.serve, cfg.protocol, cfg.heartbeat)
+		if err != nil {
+			return err
+		}
+		defer csms.close()
+		cfg.url = csms.url()
+		fmt.Fprintf(w, "serving %s on %s\n", cfg.protocol, cfg.url)
+	}
+	ctx, cancel := context.WithTimeout(ctx, cfg.ramp+cfg.duration)
+	defer cancel()
+
+	st := newStats()
+	if cfg.report > 0 {
+		go func() {
+			ticker := time.NewTicker(cfg.report)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					st.progress(w)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < cfg.stations; i++ {
+		delay := cfg.ramp * time.Duration(i) / time.Duration(cfg.stations)
+		if !sleep(ctx, delay-time.Since(st.start)) {
+			break
+		}
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			runStation(ctx, cfg, id, st)
+		}(fmt.Sprintf("%s%05d", cfg.idPrefix, i+1))
+	}
+	<-ctx.Done()
+	wg.Wait()
+	fmt.Fprintln(w)
+	st.report(w, cfg.stations)
+	return nil
+}
+
+// runStation connects one station and runs transactions on each of its
+// connectors until ctx is done
+func runStation(ctx context.Context, cfg config, id string, st *stats) {
+	client := ocpp.NewClient()
+	client.SetID(id)
+	// heartbeats and the meter values of every connector may be in flight
+	client.SetCallQueueSize(4 + 2*cfg.connectors)
+	client.SetTimeoutConfig(ocpp.ClientTimeoutConfig{
+		OcppWait:   cfg.timeout,
+		WriteWait:  10 * time.Second,
+		PongWait:   30 * time.Second,
+		PingPeriod: 27 * time.Second,
+	})
+	client.UseCall(st.intercept)
+	if cfg.reconnect {
+		client.EnableReconnect(ocpp.ReconnectConfig{
+			InitialDelay: time.Second,
+			MaxDelay:     time.Minute,
+			Jitter:       time.Second,
+		})
+		client.OnReconnect(func(*ocpp.ChargePoint) {
+			atomic.AddInt64(&st.reconnects, 1)
+		})
+	}
+	station, err := simulator.New(client, simulator.Config{
+		Protocol:          cfg.protocol,
+		Connectors:        cfg.connectors,
+		SerialNumber:      id,
+		HeartbeatInterval: cfg.heartbeat,
+		MeterInterval:     cfg.meterInterval,
+		Energy:            simulator.ConstantPower(cfg.power),
+	})
+	if err != nil {
+		atomic.AddInt64(&st.connectFailed, 1)
+		return
+	}
+	if err := station.Start(cfg.url, ""); err != nil {
+		atomic.AddInt64(&st.connectFailed, 1)
+		return
+	}
+	atomic.AddInt64(&st.connected, 1)
+	defer station.Stop()
+	select {
+	case <-station.Registered():
+		atomic.AddInt64(&st.registered, 1)
+	case <-ctx.Done():
+		return
+	}
+	if cfg.txInterval <= 0 {
+		<-ctx.Done()
+		return
+	}
+	var wg sync.WaitGroup
+	for n := 1; n <= cfg.connectors; n++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			runTransactions(ctx, cfg, station, n, st)
+		}(n)
+	}
+	wg.Wait()
+}
+
+// runTransactions starts and stops transactions on a connector, the idle
+// times are spread uniformly around the mean txInterval
+func runTransactions(ctx context.Context, cfg config, station *simulator.Station, connector int, st *stats) {
+	idTag := fmt.Sprintf("%08X", rand.Uint32())
+	for {
+		idle := cfg.txInterval/2 + time.Duration(rand.Int63n(int64(cfg.txInterval)))
+		if !sleep(ctx, idle) {
+			return
+		}
+		if err := station.StartTransaction(ctx, connector, idTag); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			atomic.AddInt64(&st.txFailed, 1)
+			continue
+		}
+		atomic.AddInt64(&st.txStarted, 1)
+		if !sleep(ctx, cfg.txDuration) {
+			return
+		}
+		if err := station.StopTransaction(ctx, connector); err == nil {
+			atomic.AddInt64(&st.txStopped, 1)
+		}
+	}
+}
+
+// sleep waits for d, it returns false if ctx is done first
+func sleep(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aliml92/ocpp"
+)
+
+func TestPercentile(t *testing.T) {
+	var sorted []time.Duration
+	for i := 1; i <= 100; i++ {
+		sorted = append(sorted, time.Duration(i)*time.Millisecond)
+	}
+	for p, want := range map[float64]time.Duration{
+		0:   time.Millisecond,
+		50:  50 * time.Millisecond,
+		99:  99 * time.Millisecond,
+		100: 100 * time.Millisecond,
+	} {
+		if got := percentile(sorted, p); got != want {
+			t.Errorf("p%v: got %s, want %s", p, got, want)
+		}
+	}
+	if got := percentile(nil, 50); got != 0 {
+		t.Errorf("got %s", got)
+	}
+}
+
+func TestRecord(t *testing.T) {
+	st := newStats()
+	st.record("Heartbeat", time.Millisecond, nil)
+	st.record("Heartbeat", 0, &ocpp.TimeoutError{})
+	st.record("Heartbeat", 0, &ocpp.CallError{ErrorCode: ocpp.InternalError})
+	st.record("Heartbeat", 0, errors.New("write failed"))
+	// Calls canceled at the end of the test are not counted
+	st.record("Heartbeat", 0, context.Canceled)
+	a := st.actions["Heartbeat"]
+	if len(a.latencies) != 1 || a.timeouts != 1 || a.callErrors != 1 || a.errors != 1 || st.calls != 4 {
+		t.Errorf("got %+v after %d calls", a, st.calls)
+	}
+}
+
+func TestRunLocal(t *testing.T) {
+	for _, protocol := range []string{"ocpp1.6", "ocpp2.0.1"} {
+		t.Run(protocol, func(t *testing.T) {
+			var out bytes.Buffer
+			err := run(context.Background(), config{
+				serve:         "127.0.0.1:0",
+				protocol:      protocol,
+				idPrefix:      "cp",
+				stations:      5,
+				connectors:    2,
+				ramp:          100 * time.Millisecond,
+				duration:      time.Second,
+				heartbeat:     200 * time.Millisecond,
+				txInterval:    100 * time.Millisecond,
+				txDuration:    300 * time.Millisecond,
+				meterInterval: 100 * time.Millisecond,
+				power:         11000,
+				timeout:       5 * time.Second,
+			}, &out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			report := out.String()
+			for _, want := range []string{"BootNotification", "Heartbeat", "StatusNotification", "5/5 connected", "5 registered", "0 timeouts"} {
+				if !strings.Contains(report, want) {
+					t.Errorf("no %q in report:\n%s", want, report)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aliml92/ocpp"
+)
+
+// stats collects the outcome of every Call made by the stations
+type stats struct {
+	// the counters come first to be 64-bit aligned for sync/atomic
+	connected     int64
+	connectFailed int64
+	reconnects    int64
+	registered    int64
+	txStarted     int64
+	txFailed      int64
+	txStopped     int64
+	calls         int64
+	timeouts      int64
+	callErrors    int64
+	otherErrors   int64
+
+	start time.Time
+
+	mu      sync.Mutex
+	actions map[string]*actionStats
+	// lastCalls and lastReportTime are the state of the previous progress line
+	lastCalls      int64
+	lastReportTime time.Time
+}
+
+// actionStats are the results of the Calls of one action
+type actionStats struct {
+	latencies  []time.Duration
+	timeouts   int
+	callErrors int
+	errors     int
+}
+
+func newStats() *stats {
+	now := time.Now()
+	return &stats{
+		start:          now,
+		lastReportTime: now,
+		actions:        make(map[string]*actionStats),
+	}
+}
+
+// intercept is a CallInterceptor measuring the latency of every Call
+func (s *stats) intercept(next ocpp.CallFunc) ocpp.CallFunc {
+	return func(ctx context.Context, cp *ocpp.ChargePoint, action string, p ocpp.Payload) (ocpp.Payload, error) {
+		start := time.Now()
+		res, err := next(ctx, cp, action, p)
+		s.record(action, time.Since(start), err)
+		return res, err
+	}
+}
+
+// record adds the result of a Call, Calls canceled when the test ends are
+// not counted
+func (s *stats) record(action string, latency time.Duration, err error) {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return
+	}
+	var timeoutErr *ocpp.TimeoutError
+	var callErr *ocpp.CallError
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	a := s.actions[action]
+	if a == nil {
+		a = &actionStats{}
+		s.actions[action] = a
+	}
+	atomic.AddInt64(&s.calls, 1)
+	switch {
+	case err == nil:
+		a.latencies = append(a.latencies, latency)
+	case errors.As(err, &timeoutErr):
+		a.timeouts++
+		atomic.AddInt64(&s.timeouts, 1)
+	case errors.As(err, &callErr):
+		a.callErrors++
+		atomic.AddInt64(&s.callErrors, 1)
+	default:
+		a.errors++
+		atomic.AddInt64(&s.otherErrors, 1)
+	}
+}
+
+// progress writes a line with the counters and the Call rate since the
+// previous line
+func (s *stats) progress(w io.Writer) {
+	now := time.Now()
+	calls := atomic.LoadInt64(&s.calls)
+	s.mu.Lock()
+	rate := float64(calls-s.lastCalls) / now.Sub(s.lastReportTime).Seconds()
+	s.lastCalls, s.lastReportTime = calls, now
+	s.mu.Unlock()
+	fmt.Fprintf(w, "%6s connected=%d registered=%d reconnects=%d calls=%d (%.0f/s) timeouts=%d callerrors=%d errors=%d\n",
+		now.Sub(s.start).Round(time.Second), atomic.LoadInt64(&s.connected), atomic.LoadInt64(&s.registered), atomic.LoadInt64(&s.reconnects),
+		calls, rate, atomic.LoadInt64(&s.timeouts), atomic.LoadInt64(&s.callErrors), atomic.LoadInt64(&s.otherErrors))
+}
+
+// report writes the latency percentiles and error counts of every action
+// and the connection and transaction counters
+func (s *stats) report(w io.Writer, stations int) {
+	elapsed := time.Since(s.start)
+	s.mu.Lock()
+	names := make([]string, 0, len(s.actions))
+	for name := range s.actions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "action\tok\tp50\tp90\tp99\tmax\ttimeouts\tcallerrors\terrors\t")
+	for _, name := range names {
+		a := s.actions[name]
+		sort.Slice(a.latencies, func(i, j int) bool { return a.latencies[i] < a.latencies[j] })
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\t%s\t%s\t%d\t%d\t%d\t\n", name, len(a.latencies),
+			latency(percentile(a.latencies, 50)), latency(percentile(a.latencies, 90)),
+			latency(percentile(a.latencies, 99)), latency(percentile(a.latencies, 100)),
+			a.timeouts, a.callErrors, a.errors)
+	}
+	s.mu.Unlock()
+	tw.Flush()
+	calls := atomic.LoadInt64(&s.calls)
+	fmt.Fprintf(w, "\nstations:     %d/%d connected, %d failed to connect, %d registered, %d reconnects\n",
+		atomic.LoadInt64(&s.connected), stations, atomic.LoadInt64(&s.connectFailed), atomic.LoadInt64(&s.registered), atomic.LoadInt64(&s.reconnects))
+	fmt.Fprintf(w, "transactions: %d started, %d stopped, %d failed to start\n",
+		atomic.LoadInt64(&s.txStarted), atomic.LoadInt64(&s.txStopped), atomic.LoadInt64(&s.txFailed))
+	fmt.Fprintf(w, "calls:        %d in %s (%.0f/s), %d timeouts, %d CallErrors, %d other errors\n",
+		calls, elapsed.Round(time.Second), float64(calls)/elapsed.Seconds(),
+		atomic.LoadInt64(&s.timeouts), atomic.LoadInt64(&s.callErrors), atomic.LoadInt64(&s.otherErrors))
+}
+
+// percentile returns the nearest-rank percentile p of sorted latencies
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+	if i < 0 {
+		i = 0
+	}
+	return sorted[i]
+}
+
+func latency(d time.Duration) string {
+	if d == 0 {
+		return "-"
+	}
+	return d.Round(10 * time.Microsecond).String()
+}
@@ -0,0 +1,209 @@
+package ocpp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrorCode is the error code of a CallError
+type ErrorCode string
+
+// error codes defined by ocpp1.6, ocpp2.0.1 and ocpp2.1
+const (
+	NotImplemented              ErrorCode = "NotImplemented"
+	NotSupported                ErrorCode = "NotSupported"
+	InternalError               ErrorCode = "InternalError"
+	ProtocolError               ErrorCode = "ProtocolError"
+	SecurityError               ErrorCode = "SecurityError"
+	PropertyConstraintViolation ErrorCode = "PropertyConstraintViolation"
+	TypeConstraintViolation     ErrorCode = "TypeConstraintViolation"
+	GenericError                ErrorCode = "GenericError"
+)
+
+// error codes of ocpp1.6, OccurenceConstraintViolation is misspelled in the specification
+const (
+	FormationViolation           ErrorCode = "FormationViolation"
+	OccurenceConstraintViolation ErrorCode = "OccurenceConstraintViolation"
+)
+
+// error codes of ocpp2.0.1 and ocpp2.1
+const (
+	FormatViolation               ErrorCode = "FormatViolation"
+	OccurrenceConstraintViolation ErrorCode = "OccurrenceConstraintViolation"
+	MessageTypeNotSupported       ErrorCode = "MessageTypeNotSupported"
+	RpcFrameworkError             ErrorCode = "RpcFrameworkError"
+)
+
+var errorDescriptions = map[ErrorCode]string{
+	NotImplemented:                "Requested Action is not known by receiver",
+	NotSupported:                  "Requested Action is recognized but not supported by the receiver",
+	InternalError:                 "An internal error occurred and the receiver was not able to process the requested Action successfully",
+	ProtocolError:                 "Payload for Action is not conform the PDU structure",
+	SecurityError:                 "During the processing of Action a security issue occurred preventing receiver from completing the Action successfully",
+	PropertyConstraintViolation:   "Payload is syntactically correct but at least one field contains an invalid value",
+	TypeConstraintViolation:       "Payload for Action is syntactically correct but at least one of the fields violates data type constraints",
+	GenericError:                  "Any other error not covered by the more specific error codes",
+	FormationViolation:            "Payload for Action is syntactically incorrect or not conform the PDU structure for Action",
+	OccurenceConstraintViolation:  "Payload is syntactically correct but at least one of the fields violates occurence constraints",
+	FormatViolation:               "Payload for Action is syntactically incorrect",
+	OccurrenceConstraintViolation: "Payload is syntactically correct but at least one of the fields violates occurrence constraints",
+	MessageTypeNotSupported:       "A message with a Message Type Number received that is not supported by this implementation",
+	RpcFrameworkError:             "Content of the call is not a valid RPC Request",
+}
+
+// forProto translates c into the error code proto uses for the same error
+func (c ErrorCode) forProto(proto string) ErrorCode {
+	switch proto {
+	case ocppV16:
+		switch c {
+		case FormatViolation:
+			return FormationViolation
+		case OccurrenceConstraintViolation:
+			return OccurenceConstraintViolation
+		case MessageTypeNotSupported, RpcFrameworkError:
+			return GenericError
+		}
+	case ocppV201, ocppV21:
+		switch c {
+		case FormationViolation:
+			return FormatViolation
+		case OccurenceConstraintViolation:
+			return OccurrenceConstraintViolation
+		}
+	}
+	return c
+}
+
+// CallError represents OCPP CallError. It is returned by ChargePoint.Call when
+// the peer replies with a CallError, and handlers or middleware can return
+// one created by NewCallError to reply with a specific error. A CallResultError
+// of ocpp2.1 is a CallError with MessageTypeId MessageTypeIdCallResultError
+type CallError struct {
+	MessageTypeId    uint8
+	UniqueId         string
+	ErrorCode        ErrorCode
+	ErrorDescription string
+	ErrorDetails     map[string]interface{}
+}
+
+// NewCallError creates a CallError to be returned by a handler, an empty
+// description is replaced by the one of the specification for code
+func NewCallError(code ErrorCode, description string, details map[string]interface{}) *CallError {
+	return &CallError{
+		MessageTypeId:    MessageTypeIdCallError,
+		ErrorCode:        code,
+		ErrorDescription: description,
+		ErrorDetails:     details,
+	}
+}
+
+func (ce *CallError) marshal() []byte {
+	details := ce.ErrorDetails
+	if details == nil {
+		details = map[string]interface{}{}
+	}
+	var mti uint8 = MessageTypeIdCallError
+	if ce.MessageTypeId == MessageTypeIdCallResultError {
+		mti = MessageTypeIdCallResultError
+	}
+	out := [5]interface{}{
+		mti,
+		ce.UniqueId,
+		ce.ErrorCode,
+		ce.ErrorDescription,
+		details,
+	}
+	raw, err := json.Marshal(out)
+	if err != nil {
+		// details that cannot be marshaled are dropped
+		out[4] = map[string]interface{}{}
+		raw, _ = json.Marshal(out)
+	}
+	return raw
+}
+
+func (ce *CallError) Error() string {
+	return fmt.Sprintf("CallError: UniqueId=%s, ErrorCode=%s, ErrorDescription=%s, ErrorDetails=%v",
+		ce.UniqueId, ce.ErrorCode, ce.ErrorDescription, ce.ErrorDetails)
+}
+
+func (ce *CallError) getID() string {
+	return ce.UniqueId
+}
+
+// ocppError is an error detected by this package while processing a message
+type ocppError struct {
+	id    string
+	code  ErrorCode
+	cause string
+	// details are sent as ErrorDetails, cause is used if they are nil
+	details map[string]interface{}
+}
+
+func (e *ocppError) Error() string {
+	return string(e.code) + ": " + e.cause
+}
+
+// frameError is a received frame that is not a valid message of any type
+type frameError struct {
+	ocppError
+	// reply is set if the frame may be a Call, it is then answered with
+	// a CallError unless it may also be the response to our pending Call
+	reply bool
+}
+
+func newFrameError(id string, code ErrorCode, reply bool, cause string) *frameError {
+	return &frameError{
+		ocppError: ocppError{id: id, code: code, cause: cause},
+		reply:     reply,
+	}
+}
+
+func (e *frameError) Unwrap() error {
+	return &e.ocppError
+}
+
+// Creates a CallError from a received Call, err is usually an *ocppError
+// or a *CallError returned by a handler, any other error is sent as GenericError
+func (call *Call) createCallError(err error, proto string) []byte {
+	return newCallError(call.UniqueId, err, proto).marshal()
+}
+
+// newCallError converts err into the CallError sent for the message with
+// the given id
+func newCallError(id string, err error, proto string) *CallError {
+	callError := &CallError{
+		MessageTypeId: MessageTypeIdCallError,
+		UniqueId:      id,
+	}
+	var ocppErr *ocppError
+	var ce *CallError
+	switch {
+	case errors.As(err, &ocppErr):
+		if ocppErr.id != "" {
+			callError.UniqueId = ocppErr.id
+		}
+		callError.ErrorCode = ocppErr.code
+		if ocppErr.details != nil {
+			callError.ErrorDetails = ocppErr.details
+		} else if ocppErr.cause != "" {
+			callError.ErrorDetails = map[string]interface{}{"cause": ocppErr.cause}
+		}
+	case errors.As(err, &ce):
+		callError.ErrorCode = ce.ErrorCode
+		callError.ErrorDescription = ce.ErrorDescription
+		callError.ErrorDetails = ce.ErrorDetails
+	default:
+		callError.ErrorCode = GenericError
+		callError.ErrorDetails = map[string]interface{}{"cause": err.Error()}
+	}
+	if callError.UniqueId == "" {
+		callError.UniqueId = "-1"
+	}
+	callError.ErrorCode = callError.ErrorCode.forProto(proto)
+	if callError.ErrorDescription == "" {
+		callError.ErrorDescription = errorDescriptions[callError.ErrorCode]
+	}
+	return callError
+}
@@ -0,0 +1,90 @@
+package ocpp
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aliml92/ocpp/v16"
+)
+
+func TestCreateCallError(t *testing.T) {
+	call := &Call{UniqueId: "42", Action: "Authorize"}
+	cases := []struct {
+		name  string
+		err   error
+		proto string
+		want  string
+	}{
+		{
+			"ocpp1.6 code",
+			&ocppError{code: FormatViolation, cause: `bad "json"`},
+			ocppV16,
+			`[4,"42","FormationViolation","Payload for Action is syntactically incorrect or not conform the PDU structure for Action",{"cause":"bad \"json\""}]`,
+		},
+		{
+			"ocpp2.0.1 code",
+			NewCallError(OccurenceConstraintViolation, "idToken missing", map[string]interface{}{"field": "idToken"}),
+			ocppV201,
+			`[4,"42","OccurrenceConstraintViolation","idToken missing",{"field":"idToken"}]`,
+		},
+		{
+			"plain error",
+			errors.New("boom"),
+			ocppV201,
+			`[4,"42","GenericError","Any other error not covered by the more specific error codes",{"cause":"boom"}]`,
+		},
+		{
+			"unmarshalable details",
+			NewCallError(InternalError, "x", map[string]interface{}{"f": func() {}}),
+			ocppV16,
+			`[4,"42","InternalError","x",{}]`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := string(call.createCallError(c.err, c.proto)); got != c.want {
+				t.Errorf("got %s want %s", got, c.want)
+			}
+		})
+	}
+}
+
+func TestUnpackCallError(t *testing.T) {
+	msg, err := unpack([]byte(`[4,"42","SecurityError","denied",{"reason":"tenant"}]`), ocppV16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &CallError{
+		MessageTypeId:    MessageTypeIdCallError,
+		UniqueId:         "42",
+		ErrorCode:        SecurityError,
+		ErrorDescription: "denied",
+		ErrorDetails:     map[string]interface{}{"reason": "tenant"},
+	}
+	if !reflect.DeepEqual(msg, want) {
+		t.Errorf("got %+v want %+v", msg, want)
+	}
+	if _, err := unpack([]byte(`[4,"42",1,2,"details"]`), ocppV16); err != nil {
+		t.Errorf("malformed CallError fields: %v", err)
+	}
+}
+
+func TestHandlerCallError(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+	csms.On("Authorize", func(cp *ChargePoint, p Payload) Payload {
+		return NewCallError(SecurityError, "", map[string]interface{}{"idTag": p.(*v16.AuthorizeReq).IdTag})
+	})
+	cp := newTestClient(t, "cp1", ts.URL)
+	_, err := cp.Call("Authorize", &v16.AuthorizeReq{IdTag: "tag"})
+	var callErr *CallError
+	if !errors.As(err, &callErr) {
+		t.Fatalf("got %v want *CallError", err)
+	}
+	if callErr.ErrorCode != SecurityError || callErr.ErrorDetails["idTag"] != "tag" {
+		t.Errorf("got %+v", callErr)
+	}
+	if callErr.ErrorDescription != errorDescriptions[SecurityError] {
+		t.Errorf("got description %q", callErr.ErrorDescription)
+	}
+}
@@ -82,8 +82,9 @@ func ChangeAvailabilityHandler(cp *ocpp.ChargePoint, p ocpp.Payload) ocpp.Payloa
 func GetLocalListVersionHandler(cp *ocpp.ChargePoint, p ocpp.Payload) ocpp.Payload {
 	req := p.(*v16.GetLocalListVersionReq)
 	log.Debugf("GetLocalListVersionReq: %v\n", req)
+	listVersion := 1
 	var res ocpp.Payload = &v16.GetLocalListVersionConf{
-		ListVersion: 1,
+		ListVersion: &listVersion,
 	}
 	return res
 }
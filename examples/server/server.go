@@ -49,7 +49,9 @@ func main() {
 	csms.On("BootNotification", BootNotificationHandler)
 	// csms.On("Authorize", AuthorizationHandler)
 	csms.After("BootNotification", SendChangeConfigration)
-	csms.Start("0.0.0.0:8999", "/ws/", nil)
+	if err := csms.Start("0.0.0.0:8999", "/ws/", nil); err != nil {
+		log.Fatal(err)
+	}
 	
 
 }
@@ -102,9 +104,10 @@ func customPreUpgradeHandler(w http.ResponseWriter, r *http.Request) bool {
 func BootNotificationHandler(cp *ocpp.ChargePoint, p ocpp.Payload) ocpp.Payload {
 	req := p.(*v16.BootNotificationReq)
 	log.Debugf("\nid: %s\nBootNotification: %v", cp.Id, req)
+	interval := 60
 	var res ocpp.Payload = &v16.BootNotificationConf{
 		CurrentTime: time.Now().Format("2006-01-02T15:04:05.000Z"),
-		Interval:    60 ,
+		Interval:    &interval,
 		Status:      "Accepted",
 	}
 	return res
@@ -0,0 +1,308 @@
+package ocpp
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+var fuzzProtos = []string{ocppV16, ocppV201, ocppV21}
+
+// FuzzUnpack checks that unpack never panics and that a Call it accepts
+// survives being sent again
+func FuzzUnpack(f *testing.F) {
+	for _, seed := range []string{
+		`[2,"1","Heartbeat",{}]`,
+		`[3,"1",{"currentTime":"2022-10-18T10:00:00Z"}]`,
+		`[4,"1","NotImplemented","",{}]`,
+		`[5,"1","FormatViolation","",{}]`,
+		`[6,"1","NotifyPeriodicEventStream",{"id":1,"pending":0,"basetime":"2025-01-01T00:00:00Z","data":[{"t":0,"v":"1"}]}]`,
+		`[2,"1"]`,
+		`[]`,
+		`null`,
+	} {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, proto := range fuzzProtos {
+			msg, err := unpack(data, proto)
+			call, ok := msg.(*Call)
+			if !ok || err != nil {
+				continue
+			}
+			frame, err := json.Marshal([4]interface{}{call.MessageTypeId, call.UniqueId, call.Action, call.Payload})
+			if err != nil {
+				t.Fatalf("%s: %v", proto, err)
+			}
+			again, err := unpack(frame, proto)
+			if err != nil {
+				t.Fatalf("%s: %s does not unpack: %v", proto, frame, err)
+			}
+			// an empty slice matched case-insensitively is dropped by
+			// omitempty, so the encodings are compared instead of the values
+			if got := mustMarshal(t, again.(*Call).Payload); string(got) != string(mustMarshal(t, call.Payload)) {
+				t.Errorf("%s: %s changed to %s", proto, frame, got)
+			}
+		}
+	})
+}
+
+// FuzzCreateCallResult checks that every CallResult we create unpacks to
+// the same MessageId and payload
+func FuzzCreateCallResult(f *testing.F) {
+	f.Add("1", []byte(`{"currentTime":"2022-10-18T10:00:00Z","interval":60,"status":"Accepted"}`))
+	f.Add("19223201", []byte(`{}`))
+	f.Add("", []byte(`{"a":[1,2,{"b":null}]}`))
+	f.Fuzz(func(t *testing.T, id string, payload []byte) {
+		var p map[string]interface{}
+		if err := json.Unmarshal(payload, &p); err != nil || p == nil || !utf8.ValidString(id) {
+			return
+		}
+		call := &Call{UniqueId: id}
+		frame := call.createCallResult(p)
+		msg, err := unpack(frame, ocppV201)
+		if !validId(t, id) {
+			if err == nil {
+				t.Fatalf("%s: got nil error for an invalid MessageId", frame)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", frame, err)
+		}
+		res := msg.(*CallResult)
+		var got map[string]interface{}
+		if err := json.Unmarshal(res.Payload, &got); err != nil {
+			t.Fatal(err)
+		}
+		if res.UniqueId != id || !reflect.DeepEqual(got, p) {
+			t.Errorf("got %s %v want %s %v", res.UniqueId, got, id, p)
+		}
+	})
+}
+
+// FuzzCreateCallError checks that every CallError we create unpacks to the
+// same error
+func FuzzCreateCallError(f *testing.F) {
+	f.Add("1", string(NotImplemented), "", []byte(`{}`), uint8(0))
+	f.Add("2", string(FormatViolation), "bad \"json\"", []byte(`{"errors":["idTag: required"]}`), uint8(1))
+	f.Add("3", "NoSuchCode", " ", []byte(`null`), uint8(2))
+	f.Fuzz(func(t *testing.T, id, code, description string, details []byte, protoIdx uint8) {
+		if !utf8.ValidString(id) || !utf8.ValidString(code) || !utf8.ValidString(description) {
+			return
+		}
+		proto := fuzzProtos[int(protoIdx)%len(fuzzProtos)]
+		var d map[string]interface{}
+		_ = json.Unmarshal(details, &d)
+		call := &Call{UniqueId: id}
+		frame := call.createCallError(NewCallError(ErrorCode(code), description, d), proto)
+		msg, err := unpack(frame, proto)
+		want := newCallError(id, NewCallError(ErrorCode(code), description, d), proto)
+		if !validId(t, want.UniqueId) {
+			if err == nil {
+				t.Fatalf("%s: got nil error for an invalid MessageId", frame)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", frame, err)
+		}
+		// the details are compared after a JSON round trip of their own
+		want.ErrorDetails = jsonRoundTrip(t, want.ErrorDetails)
+		if got := msg.(*CallError); !reflect.DeepEqual(got, want) {
+			t.Errorf("got %+v want %+v", got, want)
+		}
+	})
+}
+
+// FuzzRequestPayloads feeds every request type of every subprotocol and
+// checks that the accepted payloads are stable under marshal and unmarshal
+func FuzzRequestPayloads(f *testing.F) {
+	type entry struct {
+		proto, action string
+	}
+	var entries []entry
+	for _, proto := range fuzzProtos {
+		for _, action := range sortedActions(requestMap(proto)) {
+			entries = append(entries, entry{proto, action})
+		}
+	}
+	for i, e := range entries {
+		f.Add(uint16(i), mustMarshal(f, fill(reflect.TypeOf(requestPayload(f, e.proto, e.action)).Elem(), 0).Interface()))
+	}
+	f.Add(uint16(0), []byte(`{"idTag":"\ud800"}`))
+	f.Add(uint16(1), []byte(`{"chargePointVendor":1}`))
+	f.Fuzz(func(t *testing.T, idx uint16, payload []byte) {
+		e := entries[int(idx)%len(entries)]
+		unmarshal := requestMap(e.proto)[e.action]
+		p, err := unmarshal(payload)
+		if err != nil {
+			return
+		}
+		raw, err := json.Marshal(p)
+		if err != nil {
+			t.Fatalf("%s %s: %v", e.proto, e.action, err)
+		}
+		again, err := unmarshal(raw)
+		if err != nil {
+			t.Fatalf("%s %s: %s does not unmarshal: %v", e.proto, e.action, raw, err)
+		}
+		if got := mustMarshal(t, again); string(got) != string(raw) {
+			t.Errorf("%s %s: %s changed to %s", e.proto, e.action, raw, got)
+		}
+	})
+}
+
+// TestPayloadRoundTrip sends every request and response type of every
+// subprotocol, once with all fields set and once empty, through the codec
+// and checks that nothing is lost
+func TestPayloadRoundTrip(t *testing.T) {
+	for _, proto := range fuzzProtos {
+		for _, response := range []bool{false, true} {
+			m := requestMap(proto)
+			if response {
+				m = responseMap(proto)
+			}
+			for _, action := range sortedActions(m) {
+				typ := reflect.TypeOf(mustUnmarshal(t, m[action], []byte(`{}`))).Elem()
+				for _, want := range []interface{}{fill(typ, 0).Addr().Interface(), reflect.New(typ).Interface()} {
+					raw := mustMarshal(t, want)
+					var got Payload
+					if response {
+						frame := (&Call{UniqueId: "1"}).createCallResult(want)
+						msg, err := unpack(frame, proto)
+						if err != nil {
+							t.Fatalf("%s %s: %v", proto, action, err)
+						}
+						got = mustUnmarshal(t, m[action], msg.(*CallResult).Payload)
+					} else {
+						frame := mustMarshal(t, [4]interface{}{MessageTypeIdCall, "1", action, want})
+						msg, err := unpack(frame, proto)
+						if err != nil {
+							t.Fatalf("%s %s: %s: %v", proto, action, frame, err)
+						}
+						got = msg.(*Call).Payload
+					}
+					if !reflect.DeepEqual(got, want) {
+						t.Errorf("%s %s: %s came back as %s", proto, action, raw, mustMarshal(t, got))
+					}
+				}
+			}
+		}
+	}
+}
+
+// fill returns a value of typ with every field, element and pointer set to
+// a non-zero value, depth stops recursive types
+func fill(typ reflect.Type, depth int) reflect.Value {
+	v := reflect.New(typ).Elem()
+	if depth > 8 {
+		return v
+	}
+	switch typ.Kind() {
+	case reflect.String:
+		v.SetString("2022-10-18T10:00:00Z")
+	case reflect.Int:
+		v.SetInt(7)
+	case reflect.Float64:
+		v.SetFloat(1.5)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Interface:
+		v.Set(reflect.ValueOf(map[string]interface{}{"vendor": "data", "n": 1.5}))
+	case reflect.Ptr:
+		v.Set(fill(typ.Elem(), depth+1).Addr())
+	case reflect.Slice:
+		v.Set(reflect.Append(reflect.MakeSlice(typ, 0, 1), fill(typ.Elem(), depth+1)))
+	case reflect.Struct:
+		for i := 0; i < typ.NumField(); i++ {
+			v.Field(i).Set(fill(typ.Field(i).Type, depth+1))
+		}
+	}
+	return v
+}
+
+func sortedActions(m map[string]func(json.RawMessage) (Payload, error)) []string {
+	actions := make([]string, 0, len(m))
+	for action := range m {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+	return actions
+}
+
+func requestPayload(tb testing.TB, proto, action string) Payload {
+	return mustUnmarshal(tb, requestMap(proto)[action], []byte(`{}`))
+}
+
+func mustUnmarshal(tb testing.TB, unmarshal func(json.RawMessage) (Payload, error), raw []byte) Payload {
+	tb.Helper()
+	p, err := unmarshal(raw)
+	if err != nil {
+		tb.Fatalf("%s: %v", raw, err)
+	}
+	return p
+}
+
+func mustMarshal(tb testing.TB, v interface{}) []byte {
+	tb.Helper()
+	raw, err := json.Marshal(v)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return raw
+}
+
+func validId(tb testing.TB, id string) bool {
+	_, reason := uniqueId([]json.RawMessage{nil, mustMarshal(tb, id)})
+	return reason == ""
+}
+
+func jsonRoundTrip(tb testing.TB, m map[string]interface{}) map[string]interface{} {
+	tb.Helper()
+	var out map[string]interface{}
+	if err := json.Unmarshal(mustMarshal(tb, m), &out); err != nil {
+		tb.Fatal(err)
+	}
+	if out == nil {
+		out = map[string]interface{}{}
+	}
+	return out
+}
+
+// TestSeedCorpus checks that the frames under testdata/fuzz/FuzzUnpack, as
+// sent by chargers and central systems in the field, unpack without error
+func TestSeedCorpus(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "fuzz", "FuzzUnpack", "*"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no seed corpus: %v", err)
+	}
+	for _, name := range files {
+		raw, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines := strings.SplitN(strings.TrimSpace(string(raw)), "\n", 2)
+		if len(lines) != 2 || lines[0] != "go test fuzz v1" ||
+			!strings.HasPrefix(lines[1], "[]byte(") || !strings.HasSuffix(lines[1], ")") {
+			t.Fatalf("%s is not a corpus file", name)
+		}
+		data, err := strconv.Unquote(strings.TrimSuffix(strings.TrimPrefix(lines[1], "[]byte("), ")"))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		proto := ocppV16
+		if strings.Contains(filepath.Base(name), "201") {
+			proto = ocppV201
+		}
+		if _, err := unpack([]byte(data), proto); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
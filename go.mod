@@ -7,6 +7,7 @@ retract v1.0.0
 require (
 	github.com/google/uuid v1.3.0
 	github.com/gorilla/websocket v1.5.0
+	github.com/santhosh-tekuri/jsonschema/v5 v5.3.0
 	gopkg.in/go-playground/validator.v9 v9.31.0
 )
 
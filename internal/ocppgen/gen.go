@@ -0,0 +1,329 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// goStruct is a generated struct type
+type goStruct struct {
+	name   string
+	doc    string
+	fields []goField
+}
+
+type goField struct {
+	name string
+	typ  string
+	json string
+	tag  string
+	doc  string
+}
+
+// signature is used to detect two different inline objects of the same name
+func (s *goStruct) signature() string {
+	var b strings.Builder
+	for _, f := range s.fields {
+		fmt.Fprintf(&b, "%s %s %q %q;", f.name, f.typ, f.json, f.tag)
+	}
+	return b.String()
+}
+
+// goEnum is a generated string type with a constant for each value
+type goEnum struct {
+	name   string
+	prefix string
+	doc    string
+	values []string
+}
+
+// message is the request or response of an action
+type message struct {
+	action   string
+	response bool
+	body     *goStruct
+}
+
+// generator turns the schemas of one OCPP version into Go types
+type generator struct {
+	v *version
+
+	structs map[string]*goStruct
+	enums   map[string]*goEnum
+	msgs    []*message
+
+	// defs and file are the definitions and name of the schema being read
+	defs map[string]*schema
+	file string
+}
+
+func newGenerator(v *version) *generator {
+	return &generator{
+		v:       v,
+		structs: make(map[string]*goStruct),
+		enums:   make(map[string]*goEnum),
+	}
+}
+
+// addMessage generates the payload type of a request or response schema
+func (g *generator) addMessage(file, action string, response bool, s *schema) error {
+	g.file, g.defs = file, s.Definitions
+	name := action + g.v.reqSuffix
+	if response {
+		name = action + g.v.resSuffix
+	}
+	body, err := g.buildStruct(name, s)
+	if err != nil {
+		return fmt.Errorf("%s: %w", file, err)
+	}
+	g.msgs = append(g.msgs, &message{action: action, response: response, body: body})
+	return nil
+}
+
+func (g *generator) buildStruct(name string, s *schema) (*goStruct, error) {
+	st := &goStruct{name: name, doc: s.Description}
+	for _, p := range s.Properties {
+		f, err := g.field(name, p, s.Required[p.Name])
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", name, p.Name, err)
+		}
+		st.fields = append(st.fields, f)
+	}
+	return st, nil
+}
+
+// addStruct registers a shared type, types of the same name must be equal
+func (g *generator) addStruct(name string, s *schema) error {
+	st, err := g.buildStruct(name, s)
+	if err != nil {
+		return err
+	}
+	if prev, ok := g.structs[name]; ok {
+		if prev.signature() != st.signature() {
+			return fmt.Errorf("%s: type %s is defined differently elsewhere", g.file, name)
+		}
+		return nil
+	}
+	g.structs[name] = st
+	return nil
+}
+
+// addEnum registers an enum type, enums of the same name must be equal
+func (g *generator) addEnum(name string, s *schema) error {
+	if prev, ok := g.enums[name]; ok {
+		if strings.Join(prev.values, ",") != strings.Join(s.Enum, ",") {
+			return fmt.Errorf("%s: enum %s is defined differently elsewhere", g.file, name)
+		}
+		return nil
+	}
+	g.enums[name] = &goEnum{name: name, doc: s.Description, values: s.Enum}
+	return nil
+}
+
+// valueType describes the Go type of a schema and the validator rules of
+// its values
+type valueType struct {
+	typ   string
+	kind  string // "string", "scalar", "object" or "any"
+	rules []string
+}
+
+func (g *generator) valueType(owner, prop string, s *schema) (valueType, error) {
+	if s.Ref != "" {
+		name, err := refName(s.Ref)
+		if err != nil {
+			return valueType{}, err
+		}
+		def, ok := g.defs[name]
+		if !ok {
+			return valueType{}, fmt.Errorf("undefined %s", s.Ref)
+		}
+		if len(def.Enum) > 0 {
+			if err := g.addEnum(name, def); err != nil {
+				return valueType{}, err
+			}
+			return valueType{typ: name, kind: "string", rules: []string{name}}, nil
+		}
+		if err := g.addStruct(name, def); err != nil {
+			return valueType{}, err
+		}
+		return valueType{typ: name, kind: "object"}, nil
+	}
+	if len(s.Enum) > 0 {
+		name := g.v.enumName(owner, prop)
+		if err := g.addEnum(name, s); err != nil {
+			return valueType{}, err
+		}
+		return valueType{typ: name, kind: "string", rules: []string{name}}, nil
+	}
+	switch s.Type {
+	case "string":
+		vt := valueType{typ: "string", kind: "string"}
+		switch s.Format {
+		case "date-time":
+			vt.rules = append(vt.rules, "ISO8601date")
+		case "uri":
+			vt.rules = append(vt.rules, "uri")
+		}
+		if s.MaxLength > 0 {
+			vt.rules = append(vt.rules, "max="+strconv.Itoa(s.MaxLength))
+		}
+		return vt, nil
+	case "integer":
+		return valueType{typ: "int", kind: "scalar"}, nil
+	case "number":
+		return valueType{typ: "float64", kind: "scalar"}, nil
+	case "boolean":
+		return valueType{typ: "bool", kind: "scalar"}, nil
+	case "object":
+		name := g.v.structName(prop)
+		if err := g.addStruct(name, s); err != nil {
+			return valueType{}, err
+		}
+		return valueType{typ: name, kind: "object"}, nil
+	case "":
+		return valueType{typ: "interface{}", kind: "any"}, nil
+	}
+	return valueType{}, fmt.Errorf("unsupported type %q", s.Type)
+}
+
+func (g *generator) field(owner string, p property, required bool) (goField, error) {
+	f := goField{name: exported(p.Name), json: p.Name, doc: p.Schema.Description}
+	var rules []string
+	if p.Schema.Type == "array" {
+		if p.Schema.Items == nil {
+			return f, fmt.Errorf("array without items")
+		}
+		item, err := g.valueType(owner, p.Name, p.Schema.Items)
+		if err != nil {
+			return f, err
+		}
+		f.typ = "[]" + item.typ
+		if p.Schema.MinItems > 0 {
+			rules = append(rules, "min="+strconv.Itoa(p.Schema.MinItems))
+		}
+		if p.Schema.MaxItems > 0 {
+			rules = append(rules, "max="+strconv.Itoa(p.Schema.MaxItems))
+		}
+		if len(item.rules) > 0 || item.kind == "object" {
+			rules = append(rules, "dive")
+			rules = append(rules, item.rules...)
+		}
+		if required {
+			rules = append([]string{"required"}, rules...)
+		} else if len(rules) > 0 {
+			rules = append([]string{"omitempty"}, rules...)
+		}
+	} else {
+		vt, err := g.valueType(owner, p.Name, p.Schema)
+		if err != nil {
+			return f, err
+		}
+		f.typ = vt.typ
+		switch {
+		case vt.kind == "string" && required:
+			rules = append([]string{"required"}, vt.rules...)
+		case vt.kind == "string" && len(vt.rules) > 0:
+			rules = append([]string{"omitempty"}, vt.rules...)
+		case vt.kind == "scalar" && required:
+			// zero values are valid, so a missing value must be nil
+			// to be told apart from them
+			f.typ = "*" + f.typ
+			rules = []string{"required"}
+		case (vt.kind == "scalar" || vt.kind == "object") && !required:
+			// zero values are valid, absent ones are nil
+			f.typ = "*" + f.typ
+		}
+	}
+	if !required {
+		f.json += ",omitempty"
+	}
+	f.tag = strings.Join(rules, ",")
+	return f, nil
+}
+
+// exported returns the Go name of a JSON property
+func exported(name string) string {
+	r := []rune(name)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+// constName returns the Go name of an enum value, e.g. EnergyActiveImportRegister
+// for "Energy.Active.Import.Register" and S3091P16A for "s309-1P-16A"
+func constName(value string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range value {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// resolveEnumPrefixes names the constants of the enums after their type
+// without the EnumType suffix, unless that is the name of another type
+func (g *generator) resolveEnumPrefixes() error {
+	seen := make(map[string]string)
+	for _, name := range sortedKeys(g.enums) {
+		e := g.enums[name]
+		e.prefix = strings.TrimSuffix(name, "EnumType")
+		if _, ok := g.enums[e.prefix]; ok && e.prefix != name {
+			e.prefix = name
+		}
+		for _, v := range e.values {
+			c := e.prefix + constName(v)
+			if other, ok := seen[c]; ok {
+				return fmt.Errorf("constant %s of %s collides with %s", c, name, other)
+			}
+			if _, ok := g.structs[c]; ok {
+				return fmt.Errorf("constant %s of %s collides with a type", c, name)
+			}
+			seen[c] = name
+		}
+	}
+	return nil
+}
+
+func (g *generator) messages(response bool) []*goStruct {
+	var out []*goStruct
+	for _, m := range g.msgs {
+		if m.response == response {
+			out = append(out, m.body)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].name < out[j].name })
+	return out
+}
+
+// actions returns the actions with a request or a response schema, actions
+// without response are only sent in SEND frames
+func (g *generator) actions(response bool) []string {
+	var out []string
+	for _, m := range g.msgs {
+		if m.response == response {
+			out = append(out, m.action)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
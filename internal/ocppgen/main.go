@@ -0,0 +1,261 @@
+// Command ocppgen generates the payload types of the v16, v201 and v21 packages,
+// their enum constants and validator registrations, and the tables mapping
+// actions to payload types of the ocpp package from the OCPP JSON schemas.
+//
+// It is run from the root of the module by go generate:
+//
+//	go run ./internal/ocppgen [-schemas schemas] [-out .]
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const modulePath = "github.com/aliml92/ocpp"
+
+const header = "// Code generated by ocppgen from the OCPP JSON schemas. DO NOT EDIT.\n\n"
+
+// version describes the schemas and the package of one OCPP version
+type version struct {
+	inlineNames
+	proto     string
+	pkg       string
+	reqSuffix string
+	resSuffix string
+}
+
+var versions = []*version{
+	{
+		inlineNames: inlineNames{types: typeNamesV16, enums: enumNamesV16, suffixes: []string{"Req", "Conf"}},
+		proto:       "ocpp1.6",
+		pkg:         "v16",
+		reqSuffix:   "Req",
+		resSuffix:   "Conf",
+	},
+	{
+		inlineNames: inlineNames{suffixes: []string{"Req", "Res"}},
+		proto:       "ocpp2.0.1",
+		pkg:         "v201",
+		reqSuffix:   "Req",
+		resSuffix:   "Res",
+	},
+	{
+		inlineNames: inlineNames{suffixes: []string{"Req", "Res"}},
+		proto:       "ocpp2.1",
+		pkg:         "v21",
+		reqSuffix:   "Req",
+		resSuffix:   "Res",
+	},
+}
+
+func main() {
+	schemas := flag.String("schemas", "schemas", "directory with a subdirectory of schema files per subprotocol")
+	out := flag.String("out", ".", "root directory of the module")
+	flag.Parse()
+
+	files, err := generate(os.DirFS(*schemas))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name, src := range files {
+		if err := os.MkdirAll(filepath.Join(*out, filepath.Dir(filepath.FromSlash(name))), 0o755); err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(*out, filepath.FromSlash(name)), src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// generate returns the generated files by their path relative to the module
+func generate(schemas fs.FS) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	var gens []*generator
+	for _, v := range versions {
+		g, err := load(v, schemas)
+		if err != nil {
+			return nil, err
+		}
+		gens = append(gens, g)
+		for name, src := range map[string]string{
+			"datatypes.go":           g.renderStructs(g.sortedStructs()),
+			"call.go":                g.renderStructs(g.messages(false)),
+			"call_result.go":         g.renderStructs(g.messages(true)),
+			"enums.go":               g.renderEnums(),
+			"validation_register.go": g.renderRegistrations(),
+		} {
+			out, err := formatSource(v.pkg+"/"+name, src)
+			if err != nil {
+				return nil, err
+			}
+			files[v.pkg+"/"+name] = out
+		}
+	}
+	out, err := formatSource("payloads.go", renderPayloadMaps(gens))
+	if err != nil {
+		return nil, err
+	}
+	files["payloads.go"] = out
+	return files, nil
+}
+
+// load reads the schemas of v, requests are named Action.json (1.6) or
+// ActionRequest.json (2.0.1), responses ActionResponse.json
+func load(v *version, schemas fs.FS) (*generator, error) {
+	names, err := fs.Glob(schemas, v.proto+"/*.json")
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no schemas found for %s", v.proto)
+	}
+	g := newGenerator(v)
+	for _, name := range names {
+		data, err := fs.ReadFile(schemas, name)
+		if err != nil {
+			return nil, err
+		}
+		s, err := parseSchema(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		action := strings.TrimSuffix(path.Base(name), ".json")
+		response := strings.HasSuffix(action, "Response")
+		action = strings.TrimSuffix(strings.TrimSuffix(action, "Response"), "Request")
+		if err := g.addMessage(name, action, response, s); err != nil {
+			return nil, err
+		}
+	}
+	if err := g.resolveEnumPrefixes(); err != nil {
+		return nil, fmt.Errorf("%s: %w", v.proto, err)
+	}
+	return g, nil
+}
+
+func (g *generator) sortedStructs() []*goStruct {
+	var out []*goStruct
+	for _, name := range sortedKeys(g.structs) {
+		out = append(out, g.structs[name])
+	}
+	return out
+}
+
+func (g *generator) renderStructs(structs []*goStruct) string {
+	var b bytes.Buffer
+	b.WriteString(header + "package " + g.v.pkg + "\n")
+	for _, s := range structs {
+		b.WriteString("\n")
+		writeDoc(&b, s.doc, "")
+		if len(s.fields) == 0 {
+			fmt.Fprintf(&b, "type %s struct{}\n", s.name)
+			continue
+		}
+		fmt.Fprintf(&b, "type %s struct {\n", s.name)
+		for _, f := range s.fields {
+			writeDoc(&b, f.doc, "\t")
+			tag := fmt.Sprintf("json:%q", f.json)
+			if f.tag != "" {
+				tag += fmt.Sprintf(" validate:%q", f.tag)
+			}
+			fmt.Fprintf(&b, "\t%s %s `%s`\n", f.name, f.typ, tag)
+		}
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+func (g *generator) renderEnums() string {
+	var b bytes.Buffer
+	b.WriteString(header + "package " + g.v.pkg + "\n")
+	for _, name := range sortedKeys(g.enums) {
+		e := g.enums[name]
+		b.WriteString("\n")
+		writeDoc(&b, e.doc, "")
+		fmt.Fprintf(&b, "type %s string\n\nconst (\n", e.name)
+		consts := make([]string, len(e.values))
+		for i, v := range e.values {
+			consts[i] = e.prefix + constName(v)
+			fmt.Fprintf(&b, "\t%s %s = %q\n", consts[i], e.name, v)
+		}
+		fmt.Fprintf(&b, ")\n\n// IsValid reports whether e is one of the %s values\n", e.name)
+		fmt.Fprintf(&b, "func (e %s) IsValid() bool {\n\tswitch e {\n\tcase %s:\n\t\treturn true\n\t}\n\treturn false\n}\n",
+			e.name, strings.Join(consts, ",\n\t\t"))
+	}
+	return b.String()
+}
+
+func (g *generator) renderRegistrations() string {
+	var b bytes.Buffer
+	b.WriteString(header + "package " + g.v.pkg + "\n\n")
+	fmt.Fprintf(&b, "import (\n\t%q\n\t\"gopkg.in/go-playground/validator.v9\"\n)\n\n", modulePath+"/internal/validation")
+	b.WriteString("// Validate checks payloads against their validator tags\nvar Validate = validation.New()\n\n")
+	b.WriteString("func IsISO8601Date(fl validator.FieldLevel) bool {\n\treturn validation.IsISO8601Date(fl)\n}\n\n")
+	b.WriteString("// register the enum types as validator tags of the same name\nfunc init() {\n")
+	for _, name := range sortedKeys(g.enums) {
+		fmt.Fprintf(&b, "\tValidate.RegisterValidation(%q, validation.Enum(%s.IsValid))\n", name, name)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func renderPayloadMaps(gens []*generator) string {
+	var b bytes.Buffer
+	b.WriteString(header + "package ocpp\n\nimport (\n\t\"encoding/json\"\n\n")
+	for _, g := range gens {
+		fmt.Fprintf(&b, "\t%q\n", modulePath+"/"+g.v.pkg)
+	}
+	b.WriteString(")\n\n// payload types of the requests and responses by action\nvar (\n")
+	for _, g := range gens {
+		for _, m := range []struct {
+			kind     string
+			response bool
+		}{{"req", false}, {"res", true}} {
+			suffix := g.v.reqSuffix
+			if m.response {
+				suffix = g.v.resSuffix
+			}
+			fmt.Fprintf(&b, "\t%smap%s = map[string]func(json.RawMessage) (Payload, error){\n", m.kind, g.v.pkg)
+			for _, action := range g.actions(m.response) {
+				fmt.Fprintf(&b, "\t\t%q: unmarshalPayload[%s.%s],\n", action, g.v.pkg, action+suffix)
+			}
+			b.WriteString("\t}\n")
+		}
+	}
+	b.WriteString(")\n")
+	return b.String()
+}
+
+// writeDoc writes a description of the schema as a comment wrapped at 80
+// columns
+func writeDoc(b *bytes.Buffer, doc, indent string) {
+	words := strings.Fields(doc)
+	if len(words) == 0 {
+		return
+	}
+	line := indent + "//"
+	for _, w := range words {
+		if len(line)+1+len(w) > 80 && line != indent+"//" {
+			b.WriteString(line + "\n")
+			line = indent + "//"
+		}
+		line += " " + w
+	}
+	b.WriteString(line + "\n")
+}
+
+func formatSource(name, src string) ([]byte, error) {
+	out, err := format.Source([]byte(src))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return out, nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestGeneratedFilesUpToDate fails when the checked in files differ from
+// the output of go generate
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	root := filepath.Join("..", "..")
+	files, err := generate(os.DirFS(filepath.Join(root, "schemas")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range files {
+		got, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date, run go generate", name)
+		}
+	}
+}
+
+func TestConstName(t *testing.T) {
+	cases := map[string]string{
+		"Energy.Active.Import.Register": "EnergyActiveImportRegister",
+		"s309-1P-16A":                   "S3091P16A",
+		"ISO15118":                      "ISO15118",
+		"Celcius":                       "Celcius",
+	}
+	for in, want := range cases {
+		if got := constName(in); got != want {
+			t.Errorf("constName(%q) = %s want %s", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package main
+
+import "strings"
+
+// OCPP 1.6 schemas declare objects and enums inline, their Go names are the
+// names of the types in the specification
+
+// typeNamesV16 maps properties to the names of their inline object types,
+// other objects are named after their property
+var typeNamesV16 = map[string]string{
+	"configurationKey":       "KeyValue",
+	"csChargingProfiles":     "ChargingProfile",
+	"localAuthorizationList": "AuthorizationData",
+	"transactionData":        "MeterValue",
+	"certificateHashData":    "CertificateHashDataType",
+	"firmware":               "FirmwareType",
+	"log":                    "LogParametersType",
+}
+
+// enumNamesV16 maps "Type.property" to the names of inline enum types
+var enumNamesV16 = map[string]string{
+	"IdTagInfo.status":                               "AuthorizationStatus",
+	"BootNotificationConf.status":                    "RegistrationStatus",
+	"CancelReservationConf.status":                   "CancelReservationStatus",
+	"ChangeAvailabilityReq.type":                     "AvailabilityType",
+	"ChangeAvailabilityConf.status":                  "AvailabilityStatus",
+	"ChangeConfigurationConf.status":                 "ConfigurationStatus",
+	"ClearCacheConf.status":                          "ClearCacheStatus",
+	"ClearChargingProfileReq.chargingProfilePurpose": "ChargingProfilePurposeType",
+	"ClearChargingProfileConf.status":                "ClearChargingProfileStatus",
+	"DataTransferConf.status":                        "DataTransferStatus",
+	"DiagnosticsStatusNotificationReq.status":        "DiagnosticsStatus",
+	"FirmwareStatusNotificationReq.status":           "FirmwareStatus",
+	"GetCompositeScheduleReq.chargingRateUnit":       "ChargingRateUnitType",
+	"GetCompositeScheduleConf.status":                "GetCompositeScheduleStatus",
+	"ChargingSchedule.chargingRateUnit":              "ChargingRateUnitType",
+	"SampledValue.context":                           "ReadingContext",
+	"SampledValue.format":                            "ValueFormat",
+	"SampledValue.measurand":                         "Measurand",
+	"SampledValue.phase":                             "Phase",
+	"SampledValue.location":                          "Location",
+	"SampledValue.unit":                              "UnitOfMeasure",
+	"ChargingProfile.chargingProfilePurpose":         "ChargingProfilePurposeType",
+	"ChargingProfile.chargingProfileKind":            "ChargingProfileKindType",
+	"ChargingProfile.recurrencyKind":                 "RecurrencyKindType",
+	"RemoteStartTransactionConf.status":              "RemoteStartStopStatus",
+	"RemoteStopTransactionConf.status":               "RemoteStartStopStatus",
+	"ReserveNowConf.status":                          "ReservationStatus",
+	"ResetReq.type":                                  "ResetType",
+	"ResetConf.status":                               "ResetStatus",
+	"SendLocalListReq.updateType":                    "UpdateType",
+	"SendLocalListConf.status":                       "UpdateStatus",
+	"SetChargingProfileConf.status":                  "ChargingProfileStatus",
+	"StatusNotificationReq.errorCode":                "ChargePointErrorCode",
+	"StatusNotificationReq.status":                   "ChargePointStatus",
+	"StopTransactionReq.reason":                      "Reason",
+	"TriggerMessageReq.requestedMessage":             "MessageTrigger",
+	"TriggerMessageConf.status":                      "TriggerMessageStatus",
+	"UnlockConnectorConf.status":                     "UnlockStatus",
+
+	// Security Whitepaper Edition 2
+	"CertificateSignedConf.status":                  "CertificateSignedStatusEnumType",
+	"DeleteCertificateConf.status":                  "DeleteCertificateStatusEnumType",
+	"CertificateHashDataType.hashAlgorithm":         "HashAlgorithmEnumType",
+	"ExtendedTriggerMessageReq.requestedMessage":    "MessageTriggerEnumType",
+	"ExtendedTriggerMessageConf.status":             "TriggerMessageStatusEnumType",
+	"GetInstalledCertificateIdsReq.certificateType": "CertificateUseEnumType",
+	"GetInstalledCertificateIdsConf.status":         "GetInstalledCertificateStatusEnumType",
+	"GetLogReq.logType":                             "LogEnumType",
+	"GetLogConf.status":                             "LogStatusEnumType",
+	"InstallCertificateReq.certificateType":         "CertificateUseEnumType",
+	"InstallCertificateConf.status":                 "InstallCertificateStatusEnumType",
+	"LogStatusNotificationReq.status":               "UploadLogStatusEnumType",
+	"SignCertificateConf.status":                    "GenericStatusEnumType",
+	"SignedFirmwareStatusNotificationReq.status":    "FirmwareStatusEnumType",
+	"SignedUpdateFirmwareConf.status":               "UpdateFirmwareStatusEnumType",
+}
+
+// inlineNames names inline objects and enums from the maps above, names
+// missing there are derived from the owning type and the property
+type inlineNames struct {
+	types map[string]string
+	enums map[string]string
+	// suffixes of message types stripped from derived enum names
+	suffixes []string
+}
+
+func (n inlineNames) structName(prop string) string {
+	if name, ok := n.types[prop]; ok {
+		return name
+	}
+	return exported(prop)
+}
+
+func (n inlineNames) enumName(owner, prop string) string {
+	if name, ok := n.enums[owner+"."+prop]; ok {
+		return name
+	}
+	for _, s := range n.suffixes {
+		owner = strings.TrimSuffix(owner, s)
+	}
+	return owner + exported(prop)
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// schema is the subset of JSON schema used by the OCPP schema files
+type schema struct {
+	Ref         string
+	Type        string
+	Format      string
+	Description string
+	Enum        []string
+	MaxLength   int
+	MinItems    int
+	MaxItems    int
+	Items       *schema
+	Properties  []property
+	Required    map[string]bool
+	Definitions map[string]*schema
+}
+
+type property struct {
+	Name   string
+	Schema *schema
+}
+
+// object is a JSON object that keeps the order of its keys, the order of
+// properties in the schema files is the order of fields in the structs
+type object struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func parseSchema(data []byte) (*schema, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	v, err := decodeValue(d)
+	if err != nil {
+		return nil, err
+	}
+	obj, ok := v.(*object)
+	if !ok {
+		return nil, fmt.Errorf("schema is not a JSON object")
+	}
+	return toSchema(obj)
+}
+
+func decodeValue(d *json.Decoder) (interface{}, error) {
+	t, err := d.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t {
+	case json.Delim('{'):
+		obj := &object{values: make(map[string]interface{})}
+		for d.More() {
+			k, err := d.Token()
+			if err != nil {
+				return nil, err
+			}
+			key := k.(string)
+			v, err := decodeValue(d)
+			if err != nil {
+				return nil, err
+			}
+			obj.keys = append(obj.keys, key)
+			obj.values[key] = v
+		}
+		_, err := d.Token()
+		return obj, err
+	case json.Delim('['):
+		var arr []interface{}
+		for d.More() {
+			v, err := decodeValue(d)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+		_, err := d.Token()
+		return arr, err
+	}
+	return t, nil
+}
+
+func toSchema(obj *object) (*schema, error) {
+	s := &schema{Required: make(map[string]bool)}
+	for _, key := range obj.keys {
+		v := obj.values[key]
+		var err error
+		switch key {
+		case "$ref":
+			s.Ref, err = str(key, v)
+		case "type":
+			s.Type, err = str(key, v)
+		case "format":
+			s.Format, err = str(key, v)
+		case "description":
+			s.Description, err = str(key, v)
+		case "maxLength":
+			s.MaxLength, err = integer(key, v)
+		case "minItems":
+			s.MinItems, err = integer(key, v)
+		case "maxItems":
+			s.MaxItems, err = integer(key, v)
+		case "enum":
+			s.Enum, err = strs(key, v)
+		case "required":
+			var names []string
+			names, err = strs(key, v)
+			for _, n := range names {
+				s.Required[n] = true
+			}
+		case "items":
+			s.Items, err = subSchema(key, v)
+		case "properties":
+			props, ok := v.(*object)
+			if !ok {
+				return nil, fmt.Errorf("properties is not an object")
+			}
+			for _, name := range props.keys {
+				p, err := subSchema(name, props.values[name])
+				if err != nil {
+					return nil, err
+				}
+				s.Properties = append(s.Properties, property{name, p})
+			}
+		case "definitions":
+			defs, ok := v.(*object)
+			if !ok {
+				return nil, fmt.Errorf("definitions is not an object")
+			}
+			s.Definitions = make(map[string]*schema)
+			for _, name := range defs.keys {
+				if s.Definitions[name], err = subSchema(name, defs.values[name]); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func subSchema(key string, v interface{}) (*schema, error) {
+	obj, ok := v.(*object)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a schema", key)
+	}
+	return toSchema(obj)
+}
+
+func str(key string, v interface{}) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%s is not a string", key)
+	}
+	return s, nil
+}
+
+func strs(key string, v interface{}) ([]string, error) {
+	arr, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s is not an array", key)
+	}
+	out := make([]string, len(arr))
+	for i, e := range arr {
+		s, err := str(key, e)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = s
+	}
+	return out, nil
+}
+
+func integer(key string, v interface{}) (int, error) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("%s is not a number", key)
+	}
+	i, err := n.Int64()
+	return int(i), err
+}
+
+// refName returns X of "#/definitions/X"
+func refName(ref string) (string, error) {
+	const prefix = "#/definitions/"
+	if !strings.HasPrefix(ref, prefix) {
+		return "", fmt.Errorf("unsupported $ref %s", ref)
+	}
+	return strings.TrimPrefix(ref, prefix), nil
+}
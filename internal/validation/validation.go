@@ -0,0 +1,41 @@
+// Package validation holds the validator setup shared by the payload
+// packages v16, v201 and v21
+package validation
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+
+	"gopkg.in/go-playground/validator.v9"
+)
+
+var iso8601DateRegex = regexp.MustCompile(`^(?:[1-9]\d{3}-(?:(?:0[1-9]|1[0-2])-(?:0[1-9]|1\d|2[0-8])|(?:0[13-9]|1[0-2])-(?:29|30)|(?:0[13578]|1[02])-31)|(?:[1-9]\d(?:0[48]|[2468][048]|[13579][26])|(?:[2468][048]|[13579][26])00)-02-29)T(?:[01]\d|2[0-3]):[0-5]\d:[0-5]\d(?:\.\d{1,9})?(?:Z|[+-][01]\d:[0-5]\d)$`)
+
+// New returns a validator naming fields by their json tags,
+// with the ISO8601date tag registered
+func New() *validator.Validate {
+	v := validator.New()
+	// register function to get tag name from json tags.
+	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	v.RegisterValidation("ISO8601date", IsISO8601Date)
+	return v
+}
+
+func IsISO8601Date(fl validator.FieldLevel) bool {
+	return iso8601DateRegex.MatchString(fl.Field().String())
+}
+
+// Enum returns the validator of the enum type whose values are
+// accepted by isValid
+func Enum[T ~string](isValid func(T) bool) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		return isValid(T(fl.Field().String()))
+	}
+}
@@ -1,47 +1,157 @@
 package ocpp
 
 import (
-	"reflect"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
 	"testing"
-)
-
-type RawMessage struct {
-	data []byte
-	proto string
-}
+	"time"
 
+	"github.com/gorilla/websocket"
+)
 
-// Add more test cases
 func TestUnpack(t *testing.T) {
-	cases := []struct{
-		name 	string
-		rawMsg  RawMessage
-		want1   OcppMessage
-		want2   error    
+	longId := strings.Repeat("x", 37)
+	cases := []struct {
+		name  string
+		proto string
+		frame string
+		// want is the type of the returned message, "" for nil
+		want     string
+		code     ErrorCode
+		id       string
+		reply    bool
+		frameErr bool
 	}{
-		{	"non array json", 
-		  	RawMessage{ []byte(`{"some": "data"}`),"ocppv16",}, 
-			nil,
-			&ocppError{
-				id:    "-1",
-				code:  "ProtocolError",
-				cause: "Invalid JSON format",
-			},
-		},
-		
+		{"non array json", ocppV16, `{"some": "data"}`, "", RpcFrameworkError, "-1", true, true},
+		{"invalid json", ocppV16, `[2,"1",`, "", RpcFrameworkError, "-1", true, true},
+		{"null", ocppV16, `null`, "", RpcFrameworkError, "-1", true, true},
+		{"empty array", ocppV16, `[]`, "", RpcFrameworkError, "-1", true, true},
+		{"only MessageTypeId", ocppV16, `[2]`, "", RpcFrameworkError, "-1", true, true},
+		{"Call without action", ocppV16, `[2,"1"]`, "", RpcFrameworkError, "1", true, true},
+		{"Call without payload", ocppV16, `[2,"1","Heartbeat"]`, "", RpcFrameworkError, "1", true, true},
+		{"Call with extra element", ocppV16, `[2,"1","Heartbeat",{},{}]`, "", RpcFrameworkError, "1", true, true},
+		{"MessageTypeId is a string", ocppV201, `["2","1","Heartbeat",{}]`, "", RpcFrameworkError, "1", true, true},
+		{"MessageId is a number", ocppV201, `[2,1,"Heartbeat",{}]`, "", RpcFrameworkError, "-1", true, true},
+		{"MessageId too long", ocppV201, `[2,"` + longId + `","Heartbeat",{}]`, "", RpcFrameworkError, "-1", true, true},
+		{"action is a number", ocppV201, `[2,"1",7,{}]`, "", RpcFrameworkError, "1", true, true},
+		{"unknown MessageTypeId", ocppV201, `[9,"1",{}]`, "", MessageTypeNotSupported, "1", true, true},
+		{"negative MessageTypeId", ocppV201, `[-1,"1",{}]`, "", MessageTypeNotSupported, "1", true, true},
+		{"Send on ocpp1.6", ocppV16, `[6,"1","Heartbeat",{}]`, "", MessageTypeNotSupported, "1", true, true},
+		{"CallResult without MessageId", ocppV16, `[3]`, "", RpcFrameworkError, "-1", false, true},
+		{"CallResult without payload", ocppV16, `[3,"1"]`, "", ProtocolError, "1", false, true},
+		{"CallError with numeric MessageId", ocppV16, `[4,5]`, "", RpcFrameworkError, "-1", false, true},
+		{"Send without action", ocppV21, `[6,"1"]`, "", RpcFrameworkError, "1", false, true},
+		{"payload is not an object", ocppV16, `[2,"1","Heartbeat",[]]`, "*ocpp.Call", FormatViolation, "1", false, false},
+		{"unknown action", ocppV16, `[2,"1","Foo",[]]`, "*ocpp.Call", NotImplemented, "1", false, false},
+		{"invalid payload", ocppV16, `[2,"1","Authorize",{"idTag":1}]`, "*ocpp.Call", TypeConstraintViolation, "1", false, false},
+		{"Call", ocppV16, `[2,"1","Heartbeat",{}]`, "*ocpp.Call", "", "", false, false},
+		{"CallResult", ocppV16, `[3,"1",{}]`, "*ocpp.CallResult", "", "", false, false},
+		{"incomplete CallError", ocppV16, `[4,"1"]`, "*ocpp.CallError", "", "", false, false},
 	}
-
-	for _, v := range cases {
-		t.Run(v.name, func(t *testing.T) {
-			got1, got2 := unpack(v.rawMsg.data, v.rawMsg.proto)
-			
-			if got1 != v.want1{
-				t.Errorf("got %v want %v", got1, v.want1)
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			msg, err := unpack([]byte(c.frame), c.proto)
+			if got := fmt.Sprintf("%T", msg); msg != nil && got != c.want || msg == nil && c.want != "" {
+				t.Errorf("got message %T want %s", msg, c.want)
 			}
-
-			if !reflect.DeepEqual(got2, v.want2) {
-				t.Errorf("got %v want %v", got2, v.want2)
+			if c.code == "" {
+				if err != nil {
+					t.Errorf("got %v want nil", err)
+				}
+				return
+			}
+			var e *ocppError
+			if !errors.As(err, &e) {
+				t.Fatalf("got %v want %s", err, c.code)
+			}
+			if e.code != c.code || e.id != c.id {
+				t.Errorf("got %s for %q want %s for %q", e.code, e.id, c.code, c.id)
+			}
+			var fe *frameError
+			if errors.As(err, &fe) != c.frameErr {
+				t.Fatalf("got %T want frameError %v", err, c.frameErr)
+			}
+			if fe != nil && fe.reply != c.reply {
+				t.Errorf("got reply %v want %v", fe.reply, c.reply)
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestMalformedFrames(t *testing.T) {
+	_, ts := newTestServer(t, 10)
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+"/ws/cp1",
+		http.Header{"Sec-WebSocket-Protocol": {ocppV16}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	frames := []struct {
+		frame string
+		reply string
+	}{
+		{`[2,"1"]`, `[4,"1","GenericError",`},
+		{`[3,"2"]`, ""},
+		{`[4]`, ""},
+		{`{"not":"a frame"}`, `[4,"-1","GenericError",`},
+		{`[2,"3","Heartbeat",[]]`, `[4,"3","FormationViolation",`},
+	}
+	for _, f := range frames {
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(f.frame)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the connection survives and the replies arrive in order, frames that
+	// are not answered are skipped
+	if err := conn.WriteMessage(websocket.TextMessage, []byte(`[2,"4","Heartbeat",{}]`)); err != nil {
+		t.Fatal(err)
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for _, f := range frames {
+		if f.reply == "" {
+			continue
+		}
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(string(msg), f.reply) {
+			t.Errorf("%s: got %s want %s...", f.frame, msg, f.reply)
+		}
+	}
+	_, msg, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(msg), `[4,"4","NotSupported"`) {
+		t.Errorf("got %s want the NotSupported CallError of Heartbeat", msg)
+	}
+}
+
+func TestUnpackOCPP21Frames(t *testing.T) {
+	send := []byte(`[6,"1","NotifyDERAlarm",{"controlType":"HFMustTrip","timestamp":"2025-01-01T00:00:00Z"}]`)
+	msg, err := unpack(send, ocppV21)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if call, ok := msg.(*Call); !ok || call.MessageTypeId != MessageTypeIdSend || call.Action != "NotifyDERAlarm" {
+		t.Errorf("got %+v want a Send", msg)
+	}
+	callResultError := []byte(`[5,"2","FormatViolation","bad result",{}]`)
+	msg, err = unpack(callResultError, ocppV21)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ce, ok := msg.(*CallError); !ok || ce.MessageTypeId != MessageTypeIdCallResultError || ce.ErrorCode != FormatViolation {
+		t.Errorf("got %+v want a CallResultError", msg)
+	}
+	for _, frame := range [][]byte{send, callResultError} {
+		_, err := unpack(frame, ocppV201)
+		var e *ocppError
+		if !errors.As(err, &e) || e.code != MessageTypeNotSupported {
+			t.Errorf("%s on ocpp2.0.1: got %v want MessageTypeNotSupported", frame, err)
+		}
+	}
+}
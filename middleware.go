@@ -0,0 +1,68 @@
+package ocpp
+
+import (
+	"context"
+	"fmt"
+)
+
+// HandlerFunc handles an incoming Call. A returned error is sent to the peer
+// as a CallError, errors other than *CallError are sent as GenericError
+type HandlerFunc func(ctx context.Context, cp *ChargePoint, call *Call) (Payload, error)
+
+// Middleware wraps the dispatch of incoming Calls to action handlers, it may
+// inspect or change the Call and the response, or return an error without
+// calling next to reject the Call
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// CallFunc sends a Call to the peer and returns the response
+type CallFunc func(ctx context.Context, cp *ChargePoint, action string, p Payload) (Payload, error)
+
+// CallInterceptor wraps outgoing Calls made with ChargePoint.Call,
+// including the ones delivered from the outbox
+type CallInterceptor func(next CallFunc) CallFunc
+
+// FrameInterceptor inspects or changes a raw outgoing Call, CallResult,
+// CallError, Send or CallResultError frame right before it is written, it
+// returns the frame to send
+type FrameInterceptor func(cp *ChargePoint, frame []byte) []byte
+
+// interceptors are registered on a Server or Client and shared by
+// all of its charge points
+type interceptors struct {
+	middleware []Middleware
+	calls      []CallInterceptor
+	frames     []FrameInterceptor
+}
+
+// dispatchCall runs the middleware chain of peer ending in the action handler
+func (cp *ChargePoint) dispatchCall(ctx context.Context, peer Peer, call *Call) (Payload, error) {
+	var h HandlerFunc = func(ctx context.Context, cp *ChargePoint, call *Call) (Payload, error) {
+		handler := peer.getHandler(call.Action)
+		if handler == nil {
+			log.Errorf("No handler for action %s", call.Action)
+			return nil, &ocppError{
+				id:    call.UniqueId,
+				code:  NotSupported,
+				cause: fmt.Sprintf("Action %s is not supported", call.Action),
+			}
+		}
+		res := handler(ctx, cp, call.Payload)
+		if callErr, ok := res.(*CallError); ok {
+			return nil, callErr
+		}
+		return res, nil
+	}
+	mw := peer.getInterceptors().middleware
+	for i := len(mw) - 1; i >= 0; i-- {
+		h = mw[i](h)
+	}
+	return h(ctx, cp, call)
+}
+
+// interceptFrame passes an outgoing frame through the frame interceptors
+func (cp *ChargePoint) interceptFrame(frame []byte) []byte {
+	for _, f := range cp.peer.getInterceptors().frames {
+		frame = f(cp, frame)
+	}
+	return frame
+}
@@ -0,0 +1,96 @@
+package ocpp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aliml92/ocpp/v16"
+)
+
+func TestMiddleware(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+	var mu sync.Mutex
+	var audit []string
+	booted := make(chan struct{})
+	csms.Use(func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cp *ChargePoint, call *Call) (Payload, error) {
+			mu.Lock()
+			audit = append(audit, call.Action)
+			mu.Unlock()
+			return next(ctx, cp, call)
+		}
+	}, func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cp *ChargePoint, call *Call) (Payload, error) {
+			select {
+			case <-booted:
+			default:
+				if call.Action != "BootNotification" {
+					return nil, &CallError{ErrorCode: "SecurityError", ErrorDescription: "boot pending"}
+				}
+			}
+			return next(ctx, cp, call)
+		}
+	})
+	csms.After("BootNotification", func(cp *ChargePoint, p Payload) {
+		close(booted)
+	})
+	csms.On("Heartbeat", func(cp *ChargePoint, p Payload) Payload {
+		return &v16.HeartbeatConf{CurrentTime: "2022-01-01T00:00:00.000Z"}
+	})
+	cp := newTestClient(t, "cp1", ts.URL)
+
+	_, err := cp.Call("Heartbeat", &v16.HeartbeatReq{})
+	var callErr *CallError
+	if !errors.As(err, &callErr) || callErr.ErrorCode != "SecurityError" {
+		t.Fatalf("got %v want SecurityError before boot", err)
+	}
+	bootNotification(t, cp)
+	<-booted
+	if _, err := cp.Call("Heartbeat", &v16.HeartbeatReq{}); err != nil {
+		t.Fatalf("Heartbeat after boot: %v", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(audit) != 3 {
+		t.Errorf("got audit log %v want 3 Calls", audit)
+	}
+}
+
+func TestOutboundInterceptors(t *testing.T) {
+	csms, ts := newTestServer(t, 42)
+	frames := make(chan []byte, 1)
+	csms.UseFrame(func(cp *ChargePoint, frame []byte) []byte {
+		frames <- frame
+		return bytes.Replace(frame, []byte(`"interval":42`), []byte(`"interval":60`), 1)
+	})
+	c := NewClient()
+	c.SetID("cp1")
+	c.AddSubProtocol(ocppV16)
+	c.SetCallQueueSize(8)
+	var actions []string
+	c.UseCall(func(next CallFunc) CallFunc {
+		return func(ctx context.Context, cp *ChargePoint, action string, p Payload) (Payload, error) {
+			actions = append(actions, action)
+			return next(ctx, cp, action, p)
+		}
+	})
+	cp, err := c.Start("ws"+strings.TrimPrefix(ts.URL, "http"), "/ws")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cp.Shutdown()
+
+	if got := *bootNotification(t, cp).Interval; got != 60 {
+		t.Errorf("got interval %d want the rewritten 60", got)
+	}
+	if frame := <-frames; !bytes.Contains(frame, []byte(`"interval":42`)) {
+		t.Errorf("interceptor got frame %s", frame)
+	}
+	if len(actions) != 1 || actions[0] != "BootNotification" {
+		t.Errorf("got intercepted calls %v", actions)
+	}
+}
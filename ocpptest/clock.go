@@ -0,0 +1,103 @@
+package ocpptest
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock is a fake ocpp.Clock whose time only moves forward with Advance
+type Clock struct {
+	mu sync.Mutex
+	// changed is broadcast when a timer is added or removed
+	changed *sync.Cond
+	now     time.Time
+	timers  []*timer
+}
+
+type timer struct {
+	at time.Time
+	c  chan time.Time
+}
+
+// NewClock creates a Clock starting at now
+func NewClock(now time.Time) *Clock {
+	c := &Clock{now: now}
+	c.changed = sync.NewCond(&c.mu)
+	return c
+}
+
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// NewTimer returns a timer firing once the clock has been advanced by d
+func (c *Clock) NewTimer(d time.Duration) (<-chan time.Time, func() bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := &timer{at: c.now.Add(d), c: make(chan time.Time, 1)}
+	if d <= 0 {
+		t.c <- c.now
+		return t.c, func() bool { return false }
+	}
+	c.timers = append(c.timers, t)
+	c.changed.Broadcast()
+	return t.c, func() bool {
+		return c.remove(t)
+	}
+}
+
+// Advance moves the time forward by d and fires the timers that are due,
+// in the order of their deadlines
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	sort.SliceStable(c.timers, func(i, j int) bool {
+		return c.timers[i].at.Before(c.timers[j].at)
+	})
+	n := 0
+	for _, t := range c.timers {
+		if t.at.After(c.now) {
+			break
+		}
+		t.c <- t.at
+		n++
+	}
+	if n > 0 {
+		c.timers = c.timers[n:]
+		c.changed.Broadcast()
+	}
+}
+
+// BlockUntil waits until at least n timers are waiting to fire, e.g. until
+// a Call is waiting for its response, so that Advance fires it
+func (c *Clock) BlockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.timers) < n {
+		c.changed.Wait()
+	}
+}
+
+// Timers returns the number of timers waiting to fire
+func (c *Clock) Timers() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.timers)
+}
+
+func (c *Clock) remove(t *timer) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, other := range c.timers {
+		if other == t {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			c.changed.Broadcast()
+			return true
+		}
+	}
+	return false
+}
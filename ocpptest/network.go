@@ -0,0 +1,151 @@
+package ocpptest
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+)
+
+// ErrOffline is returned by DialContext while the Network is offline
+var ErrOffline = errors.New("ocpptest: network is offline")
+
+// Network is an in-memory net.Listener, connections opened with DialContext
+// are accepted by the Server serving it without opening any socket
+type Network struct {
+	accept    chan net.Conn
+	done      chan struct{}
+	closeOnce sync.Once
+
+	mu sync.Mutex
+	// conns are both ends of every open connection
+	conns   map[*conn]struct{}
+	latency time.Duration
+	offline bool
+}
+
+func NewNetwork() *Network {
+	return &Network{
+		accept: make(chan net.Conn),
+		done:   make(chan struct{}),
+		conns:  make(map[*conn]struct{}),
+	}
+}
+
+// Accept waits for the next connection dialed with DialContext
+func (n *Network) Accept() (net.Conn, error) {
+	select {
+	case c := <-n.accept:
+		return c, nil
+	case <-n.done:
+		return nil, net.ErrClosed
+	}
+}
+
+// Close stops accepting connections and closes the open ones
+func (n *Network) Close() error {
+	n.closeOnce.Do(func() {
+		close(n.done)
+	})
+	n.Disconnect()
+	return nil
+}
+
+func (n *Network) Addr() net.Addr {
+	return addr{}
+}
+
+// DialContext opens a connection to the Server serving the Network, it can
+// be passed to Client.SetNetDialContext
+func (n *Network) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	n.mu.Lock()
+	offline := n.offline
+	n.mu.Unlock()
+	if offline {
+		return nil, ErrOffline
+	}
+	client, server := net.Pipe()
+	c, s := n.track(client), n.track(server)
+	select {
+	case n.accept <- s:
+		return c, nil
+	case <-ctx.Done():
+		_ = c.Close()
+		_ = s.Close()
+		return nil, ctx.Err()
+	case <-n.done:
+		_ = c.Close()
+		_ = s.Close()
+		return nil, net.ErrClosed
+	}
+}
+
+// SetLatency delays every write on the Network by d, 0 disables the delay.
+// Unlike the Clock the delay is real time
+func (n *Network) SetLatency(d time.Duration) {
+	n.mu.Lock()
+	n.latency = d
+	n.mu.Unlock()
+}
+
+// SetOffline makes DialContext fail with ErrOffline until it is called
+// again with false, open connections are not affected
+func (n *Network) SetOffline(offline bool) {
+	n.mu.Lock()
+	n.offline = offline
+	n.mu.Unlock()
+}
+
+// Disconnect abruptly closes every open connection, as if the network
+// dropped, without a websocket closing handshake
+func (n *Network) Disconnect() {
+	n.mu.Lock()
+	conns := make([]*conn, 0, len(n.conns))
+	for c := range n.conns {
+		conns = append(conns, c)
+	}
+	n.mu.Unlock()
+	for _, c := range conns {
+		_ = c.Close()
+	}
+}
+
+func (n *Network) track(c net.Conn) *conn {
+	tc := &conn{Conn: c, n: n}
+	n.mu.Lock()
+	n.conns[tc] = struct{}{}
+	n.mu.Unlock()
+	return tc
+}
+
+func (n *Network) getLatency() time.Duration {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.latency
+}
+
+// conn is one end of a connection of a Network
+type conn struct {
+	net.Conn
+	n *Network
+}
+
+func (c *conn) Write(b []byte) (int, error) {
+	if d := c.n.getLatency(); d > 0 {
+		time.Sleep(d)
+	}
+	return c.Conn.Write(b)
+}
+
+func (c *conn) Close() error {
+	c.n.mu.Lock()
+	delete(c.n.conns, c)
+	c.n.mu.Unlock()
+	return c.Conn.Close()
+}
+
+type addr struct{}
+
+func (addr) Network() string { return "ocpptest" }
+func (addr) String() string  { return "ocpptest" }
@@ -0,0 +1,208 @@
+// Package ocpptest links an ocpp.Server and an ocpp.Client through an
+// in-memory network, so CSMS and charging station logic can be tested end
+// to end without sockets, with scripted Calls, recorded frames, simulated
+// disconnects and a fake clock
+package ocpptest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aliml92/ocpp"
+)
+
+// Side is the sender of a frame or the receiver of a scripted Call
+type Side int
+
+const (
+	CSMS Side = iota
+	Station
+)
+
+func (s Side) String() string {
+	if s == CSMS {
+		return "csms"
+	}
+	return "station"
+}
+
+// Frame is a frame written by the CSMS or the station
+type Frame struct {
+	From          Side
+	MessageTypeId int
+	UniqueId      string
+	// Action is set for Call and Send frames
+	Action string
+	Raw    []byte
+}
+
+// Harness connects the Client to the Server over a Network. The Server and
+// the Client use the Clock of the Harness, so Call timeouts, reconnection
+// delays and outbox retries only expire when it is advanced
+type Harness struct {
+	Server  *ocpp.Server
+	Client  *ocpp.Client
+	Network *Network
+	Clock   *Clock
+	// CSMS and Station script the answers to the Calls received by the
+	// Server and the Client
+	CSMS    *Script
+	Station *Script
+	// Timeout bounds the waits of the Harness in real time
+	Timeout time.Duration
+
+	t  testing.TB
+	hs *http.Server
+
+	mu       sync.Mutex
+	frames   []Frame
+	newFrame chan struct{}
+	stations []*ocpp.ChargePoint
+}
+
+// New creates a Harness for csms and client, which must be configured with
+// the same subprotocol. It installs the scripts as the first middleware and
+// records frames before the frame interceptors registered afterwards. The
+// connections are closed and unmet expectations reported when the test ends
+func New(t testing.TB, csms *ocpp.Server, client *ocpp.Client) *Harness {
+	h := &Harness{
+		Server:   csms,
+		Client:   client,
+		Network:  NewNetwork(),
+		Clock:    NewClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Timeout:  5 * time.Second,
+		t:        t,
+		newFrame: make(chan struct{}),
+	}
+	h.CSMS = newScript(h, CSMS)
+	h.Station = newScript(h, Station)
+	csms.SetClock(h.Clock)
+	client.SetClock(h.Clock)
+	client.SetNetDialContext(h.Network.DialContext)
+	csms.Use(h.CSMS.middleware)
+	client.Use(h.Station.middleware)
+	csms.UseFrame(h.recorder(CSMS))
+	client.UseFrame(h.recorder(Station))
+
+	h.hs = &http.Server{Handler: csms}
+	go func() {
+		_ = h.hs.Serve(h.Network)
+	}()
+	t.Cleanup(h.close)
+	return h
+}
+
+// Connect connects the Client, whose Id must be set, and returns the station
+// side ChargePoint and its counterpart on the Server
+func (h *Harness) Connect() (station, csms *ocpp.ChargePoint) {
+	h.t.Helper()
+	// localhost is never proxied, the address is not dialed anyway
+	station, err := h.Client.Start("ws://localhost", "/ocpp")
+	if err != nil {
+		h.t.Fatalf("connect %s: %v", h.Client.Id, err)
+	}
+	h.mu.Lock()
+	h.stations = append(h.stations, station)
+	h.mu.Unlock()
+	return station, h.WaitConnected(h.Client.Id)
+}
+
+// WaitConnected waits until the Server has a connected ChargePoint with the
+// given id, e.g. after a reconnection, and returns it
+func (h *Harness) WaitConnected(id string) *ocpp.ChargePoint {
+	h.t.Helper()
+	deadline := time.Now().Add(h.Timeout)
+	for time.Now().Before(deadline) {
+		if cp, ok := h.Server.Load(id); ok && cp.IsConnected() {
+			return cp
+		}
+		time.Sleep(time.Millisecond)
+	}
+	h.t.Fatalf("%s did not connect within %s", id, h.Timeout)
+	return nil
+}
+
+// Disconnect drops every connection without a closing handshake
+func (h *Harness) Disconnect() {
+	h.Network.Disconnect()
+}
+
+// Frames returns the frames written so far
+func (h *Harness) Frames() []Frame {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]Frame(nil), h.frames...)
+}
+
+// WaitFrame returns the first frame, already written or written within the
+// Timeout, for which match returns true, otherwise it fails the test
+func (h *Harness) WaitFrame(match func(Frame) bool) Frame {
+	h.t.Helper()
+	timeout := time.After(h.Timeout)
+	seen := 0
+	for {
+		h.mu.Lock()
+		frames, newFrame := h.frames[seen:], h.newFrame
+		h.mu.Unlock()
+		for _, f := range frames {
+			if match(f) {
+				return f
+			}
+		}
+		seen += len(frames)
+		select {
+		case <-newFrame:
+		case <-timeout:
+			h.t.Fatalf("no matching frame within %s", h.Timeout)
+			return Frame{}
+		}
+	}
+}
+
+// WaitCall is WaitFrame for a Call of action sent by from
+func (h *Harness) WaitCall(from Side, action string) Frame {
+	h.t.Helper()
+	return h.WaitFrame(func(f Frame) bool {
+		return f.From == from && f.MessageTypeId == ocpp.MessageTypeIdCall && f.Action == action
+	})
+}
+
+func (h *Harness) recorder(from Side) ocpp.FrameInterceptor {
+	return func(cp *ocpp.ChargePoint, raw []byte) []byte {
+		f := Frame{From: from, Raw: append([]byte(nil), raw...)}
+		var rm []json.RawMessage
+		if json.Unmarshal(raw, &rm) == nil && len(rm) > 2 {
+			_ = json.Unmarshal(rm[0], &f.MessageTypeId)
+			_ = json.Unmarshal(rm[1], &f.UniqueId)
+			if f.MessageTypeId == ocpp.MessageTypeIdCall || f.MessageTypeId == ocpp.MessageTypeIdSend {
+				_ = json.Unmarshal(rm[2], &f.Action)
+			}
+		}
+		h.mu.Lock()
+		h.frames = append(h.frames, f)
+		close(h.newFrame)
+		h.newFrame = make(chan struct{})
+		h.mu.Unlock()
+		return raw
+	}
+}
+
+func (h *Harness) close() {
+	h.CSMS.verify()
+	h.Station.verify()
+	h.mu.Lock()
+	stations := h.stations
+	h.mu.Unlock()
+	for _, cp := range stations {
+		cp.Shutdown()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), h.Timeout)
+	defer cancel()
+	_ = h.Server.Shutdown(ctx)
+	_ = h.hs.Close()
+	_ = h.Network.Close()
+}
@@ -0,0 +1,160 @@
+package ocpptest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aliml92/ocpp"
+	"github.com/aliml92/ocpp/v16"
+)
+
+func newPair(t *testing.T) *Harness {
+	csms := ocpp.NewServer()
+	csms.AddSubProtocol("ocpp1.6")
+	csms.SetCallQueueSize(8)
+	client := ocpp.NewClient()
+	client.SetID("cp1")
+	client.AddSubProtocol("ocpp1.6")
+	client.SetCallQueueSize(8)
+	return New(t, csms, client)
+}
+
+func TestHandlersAndFrames(t *testing.T) {
+	h := newPair(t)
+	interval := 60
+	h.Server.On("BootNotification", func(cp *ocpp.ChargePoint, p ocpp.Payload) ocpp.Payload {
+		return &v16.BootNotificationConf{
+			CurrentTime: h.Clock.Now().Format(time.RFC3339),
+			Interval:    &interval,
+			Status:      v16.RegistrationStatusAccepted,
+		}
+	})
+	station, _ := h.Connect()
+	res, err := station.Call("BootNotification", &v16.BootNotificationReq{
+		ChargePointModel:  "model",
+		ChargePointVendor: "vendor",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := res.(*v16.BootNotificationConf).CurrentTime; got != "2024-01-01T00:00:00Z" {
+		t.Errorf("got CurrentTime %s", got)
+	}
+	call := h.WaitCall(Station, "BootNotification")
+	result := h.WaitFrame(func(f Frame) bool {
+		return f.From == CSMS && f.UniqueId == call.UniqueId
+	})
+	if result.MessageTypeId != ocpp.MessageTypeIdCallResult {
+		t.Errorf("got %s", result.Raw)
+	}
+}
+
+func TestScript(t *testing.T) {
+	h := newPair(t)
+	_, csms := h.Connect()
+	h.Station.Expect("RemoteStartTransaction").Reply(&v16.RemoteStartTransactionConf{
+		Status: v16.RemoteStartStopStatusAccepted,
+	})
+	h.Station.Expect("RemoteStopTransaction").ReplyError(ocpp.NotSupported, "")
+
+	res, err := csms.Call("RemoteStartTransaction", &v16.RemoteStartTransactionReq{IdTag: "tag"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.(*v16.RemoteStartTransactionConf).Status != v16.RemoteStartStopStatusAccepted {
+		t.Errorf("got %+v", res)
+	}
+	transactionId := 1
+	_, err = csms.Call("RemoteStopTransaction", &v16.RemoteStopTransactionReq{TransactionId: &transactionId})
+	var callErr *ocpp.CallError
+	if !errors.As(err, &callErr) || callErr.ErrorCode != ocpp.NotSupported {
+		t.Errorf("got %v", err)
+	}
+	// Calls without expectations go to the handlers, there are none here
+	_, err = csms.Call("ClearCache", &v16.ClearCacheReq{})
+	if !errors.As(err, &callErr) {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestCallTimeout(t *testing.T) {
+	h := newPair(t)
+	_, csms := h.Connect()
+	e := h.Station.Expect("Reset").
+		Reply(&v16.ResetConf{Status: v16.ResetStatusAccepted}).
+		Delay(time.Minute)
+	errC := make(chan error, 1)
+	go func() {
+		_, err := csms.Call("Reset", &v16.ResetReq{Type: v16.ResetTypeSoft})
+		errC <- err
+	}()
+	if p := e.Wait(); p.(*v16.ResetReq).Type != v16.ResetTypeSoft {
+		t.Errorf("got %+v", p)
+	}
+	// the response timeout of the Call and the delay of the reply
+	h.Clock.BlockUntil(2)
+	h.Clock.Advance(20 * time.Second)
+	var timeoutErr *ocpp.TimeoutError
+	if err := <-errC; !errors.As(err, &timeoutErr) {
+		t.Errorf("got %v", err)
+	}
+	h.Clock.Advance(time.Minute)
+}
+
+func TestReconnect(t *testing.T) {
+	h := newPair(t)
+	h.Client.EnableReconnect(ocpp.ReconnectConfig{InitialDelay: time.Second})
+	reconnected := make(chan struct{}, 1)
+	h.Client.OnReconnect(func(cp *ocpp.ChargePoint) {
+		reconnected <- struct{}{}
+	})
+	_, first := h.Connect()
+
+	h.Network.SetOffline(true)
+	h.Disconnect()
+	h.Clock.BlockUntil(1)
+	h.Clock.Advance(time.Second)
+	// the dial fails, the second attempt waits twice as long
+	h.Clock.BlockUntil(1)
+	h.Network.SetOffline(false)
+	h.Clock.Advance(2 * time.Second)
+	select {
+	case <-reconnected:
+	case <-time.After(h.Timeout):
+		t.Fatal("not reconnected")
+	}
+	if cp := h.WaitConnected("cp1"); cp == first {
+		t.Error("got the previous connection")
+	}
+}
+
+func TestClock(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewClock(start)
+	late, _ := c.NewTimer(2 * time.Second)
+	early, _ := c.NewTimer(time.Second)
+	_, stop := c.NewTimer(time.Second)
+	if !stop() || c.Timers() != 2 {
+		t.Fatalf("got %d timers", c.Timers())
+	}
+	c.Advance(time.Second)
+	select {
+	case at := <-early:
+		if !at.Equal(start.Add(time.Second)) {
+			t.Errorf("fired at %s", at)
+		}
+	default:
+		t.Error("timer did not fire")
+	}
+	select {
+	case <-late:
+		t.Error("timer fired early")
+	default:
+	}
+	c.Advance(time.Second)
+	<-late
+	if !c.Now().Equal(start.Add(2 * time.Second)) {
+		t.Errorf("got %s", c.Now())
+	}
+}
@@ -0,0 +1,151 @@
+package ocpptest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aliml92/ocpp"
+)
+
+// Script answers the Calls received by one side of a Harness. Calls of an
+// action with pending expectations are matched to them in order, other
+// Calls go to the registered handlers
+type Script struct {
+	h    *Harness
+	side Side
+
+	mu       sync.Mutex
+	expected map[string][]*Expectation
+	all      []*Expectation
+}
+
+// Expectation is a Call expected by a Script and its scripted answer
+type Expectation struct {
+	s      *Script
+	action string
+
+	mu    sync.Mutex
+	res   ocpp.Payload
+	err   error
+	reply bool
+	delay time.Duration
+
+	// received carries the payload of the matched Call
+	received chan ocpp.Payload
+	done     bool
+}
+
+func newScript(h *Harness, side Side) *Script {
+	return &Script{
+		h:        h,
+		side:     side,
+		expected: make(map[string][]*Expectation),
+	}
+}
+
+// Expect expects a Call of action, it is answered by the registered handler
+// unless Reply or ReplyError is used
+func (s *Script) Expect(action string) *Expectation {
+	e := &Expectation{
+		s:        s,
+		action:   action,
+		received: make(chan ocpp.Payload, 1),
+	}
+	s.mu.Lock()
+	s.expected[action] = append(s.expected[action], e)
+	s.all = append(s.all, e)
+	s.mu.Unlock()
+	return e
+}
+
+// Reply answers the Call with res
+func (e *Expectation) Reply(res ocpp.Payload) *Expectation {
+	e.mu.Lock()
+	e.res, e.err, e.reply = res, nil, true
+	e.mu.Unlock()
+	return e
+}
+
+// ReplyError answers the Call with a CallError
+func (e *Expectation) ReplyError(code ocpp.ErrorCode, description string) *Expectation {
+	e.mu.Lock()
+	e.res, e.err, e.reply = nil, ocpp.NewCallError(code, description, nil), true
+	e.mu.Unlock()
+	return e
+}
+
+// Delay holds the answer until the Clock of the Harness has been advanced by d
+func (e *Expectation) Delay(d time.Duration) *Expectation {
+	e.mu.Lock()
+	e.delay = d
+	e.mu.Unlock()
+	return e
+}
+
+// Wait waits for the Call and returns its payload, it fails the test if the
+// Call is not received within the Timeout of the Harness
+func (e *Expectation) Wait() ocpp.Payload {
+	e.s.h.t.Helper()
+	select {
+	case p := <-e.received:
+		e.received <- p
+		return p
+	case <-time.After(e.s.h.Timeout):
+		e.s.h.t.Fatalf("%s did not receive %s within %s", e.s.side, e.action, e.s.h.Timeout)
+		return nil
+	}
+}
+
+// verify reports the expectations that were never matched
+func (s *Script) verify() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range s.all {
+		e.mu.Lock()
+		done := e.done
+		e.mu.Unlock()
+		if !done {
+			s.h.t.Errorf("%s did not receive expected %s", s.side, e.action)
+		}
+	}
+}
+
+// next removes and returns the first pending expectation of action
+func (s *Script) next(action string) *Expectation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pending := s.expected[action]
+	if len(pending) == 0 {
+		return nil
+	}
+	s.expected[action] = pending[1:]
+	return pending[0]
+}
+
+func (s *Script) middleware(next ocpp.HandlerFunc) ocpp.HandlerFunc {
+	return func(ctx context.Context, cp *ocpp.ChargePoint, call *ocpp.Call) (ocpp.Payload, error) {
+		e := s.next(call.Action)
+		if e == nil {
+			return next(ctx, cp, call)
+		}
+		e.mu.Lock()
+		e.done = true
+		res, err, reply, delay := e.res, e.err, e.reply, e.delay
+		e.mu.Unlock()
+		e.received <- call.Payload
+		if delay > 0 {
+			c, stop := s.h.Clock.NewTimer(delay)
+			select {
+			case <-c:
+			case <-ctx.Done():
+				stop()
+				return nil, ctx.Err()
+			}
+		}
+		if !reply {
+			return next(ctx, cp, call)
+		}
+		return res, err
+	}
+}
@@ -0,0 +1,347 @@
+package ocpp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+	"time"
+)
+
+var ErrMessageQueued = errors.New("message queued for delivery")
+
+// QueuedMessage is a transaction-related Call waiting in the outbox
+type QueuedMessage struct {
+	Action  string          `json:"action"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// OutboxStore keeps queued messages in order. Implementations must be safe
+// for concurrent use
+type OutboxStore interface {
+	// Append adds a message to the end of the queue
+	Append(m QueuedMessage) error
+
+	// Front returns the oldest message, ok is false if the queue is empty
+	Front() (m QueuedMessage, ok bool, err error)
+
+	// Remove deletes the oldest message
+	Remove() error
+}
+
+// minTransientRetryInterval is the shortest delay before a queued message
+// is sent again after a timeout
+const minTransientRetryInterval = time.Second
+
+// OutboxConfig configures delivery of queued messages, it mirrors the
+// TransactionMessageAttempts and TransactionMessageRetryInterval
+// configuration keys
+type OutboxConfig struct {
+	// how many times a message rejected with a CallError or an invalid
+	// response is sent before it is dropped
+	Attempts int
+
+	// delay before resending a rejected message, it is multiplied by
+	// the number of attempts already made
+	RetryInterval time.Duration
+
+	// actions that go through the outbox, defaults to StartTransaction,
+	// StopTransaction, MeterValues and TransactionEvent
+	Actions []string
+}
+
+var defaultOutboxActions = []string{"StartTransaction", "StopTransaction", "MeterValues", "TransactionEvent"}
+
+// MemoryStore is an in-memory OutboxStore
+type MemoryStore struct {
+	mu       sync.Mutex
+	messages []QueuedMessage
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+func (s *MemoryStore) Append(m QueuedMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = append(s.messages, m)
+	return nil
+}
+
+func (s *MemoryStore) Front() (QueuedMessage, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.messages) == 0 {
+		return QueuedMessage{}, false, nil
+	}
+	return s.messages[0], true, nil
+}
+
+func (s *MemoryStore) Remove() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.messages) > 0 {
+		s.messages = s.messages[1:]
+	}
+	return nil
+}
+
+// FileStore is an OutboxStore persisted to a file as JSON lines,
+// so queued messages survive a restart of the charging station
+type FileStore struct {
+	mu       sync.Mutex
+	path     string
+	messages []QueuedMessage
+}
+
+// NewFileStore opens the store at path, loading messages queued earlier
+func NewFileStore(path string) (*FileStore, error) {
+	s := &FileStore{path: path}
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var m QueuedMessage
+		if err := json.Unmarshal(scanner.Bytes(), &m); err != nil {
+			return nil, err
+		}
+		s.messages = append(s.messages, m)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *FileStore) Append(m QueuedMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	line, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	s.messages = append(s.messages, m)
+	return nil
+}
+
+func (s *FileStore) Front() (QueuedMessage, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.messages) == 0 {
+		return QueuedMessage{}, false, nil
+	}
+	return s.messages[0], true, nil
+}
+
+// Remove deletes the oldest message and rewrites the file
+func (s *FileStore) Remove() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.messages) == 0 {
+		return nil
+	}
+	tmp := s.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	for _, m := range s.messages[1:] {
+		line, _ := json.Marshal(m)
+		w.Write(line)
+		w.WriteByte('\n')
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return err
+	}
+	s.messages = s.messages[1:]
+	return nil
+}
+
+// outbox delivers queued messages of a client-side ChargePoint in order
+type outbox struct {
+	store    OutboxStore
+	config   OutboxConfig
+	actions  map[string]bool
+	onResult func(cp *ChargePoint, action string, res Payload, err error)
+
+	// wake signals the delivery loop that a message was queued
+	// or the connection was reestablished
+	wake chan struct{}
+}
+
+func newOutbox(store OutboxStore, config OutboxConfig) *outbox {
+	if len(config.Actions) == 0 {
+		config.Actions = defaultOutboxActions
+	}
+	if config.Attempts <= 0 {
+		config.Attempts = 1
+	}
+	ob := &outbox{
+		store:   store,
+		config:  config,
+		actions: make(map[string]bool),
+		wake:    make(chan struct{}, 1),
+	}
+	for _, a := range config.Actions {
+		ob.actions[a] = true
+	}
+	return ob
+}
+
+func (ob *outbox) notify() {
+	select {
+	case ob.wake <- struct{}{}:
+	default:
+	}
+}
+
+// enqueue validates and stores a message for later delivery
+func (cp *ChargePoint) enqueue(action string, p Payload) error {
+	if e := cp.checkRequest(action, Outgoing, p, nil); e != nil {
+		return e
+	}
+	raw, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	ob := cp.outbox
+	if err := ob.store.Append(QueuedMessage{Action: action, Payload: raw}); err != nil {
+		return err
+	}
+	ob.notify()
+	return nil
+}
+
+// deliverOutbox runs as a goroutine on a client-side ChargePoint with an outbox
+// and sends queued messages whenever the ChargePoint is connected
+func (cp *ChargePoint) deliverOutbox() {
+	ob := cp.outbox
+	attempts := 0
+	for {
+		<-ob.wake
+		for {
+			if cp.isShutdown() {
+				return
+			}
+			if !cp.IsConnected() {
+				break
+			}
+			m, ok, err := ob.store.Front()
+			if err != nil {
+				log.Error(err)
+				break
+			}
+			if !ok {
+				break
+			}
+			req, err := unmarshalRequestPayload(m.Action, m.Payload, cp.proto)
+			if err != nil {
+				// a message that cannot be decoded never succeeds
+				log.Errorf("dropping queued %s: %v", m.Action, err)
+				cp.outboxDone(m.Action, nil, err)
+				attempts = 0
+				continue
+			}
+			res, err := cp.callTransaction(context.Background(), m.Action, req, &attempts)
+			switch {
+			case err == nil:
+				cp.outboxDone(m.Action, res, nil)
+				attempts = 0
+			case !transient(err):
+				log.Errorf("dropping queued %s after %d attempts: %v", m.Action, attempts, err)
+				cp.outboxDone(m.Action, nil, err)
+				attempts = 0
+			default:
+				// timeout or lost connection, retry the same message
+				// without counting it as an attempt
+				log.Debugf("queued %s not delivered: %v", m.Action, err)
+				if cp.IsConnected() {
+					delay := ob.config.RetryInterval
+					if delay < minTransientRetryInterval {
+						delay = minTransientRetryInterval
+					}
+					sleep(cp.clock, delay)
+				}
+			}
+		}
+	}
+}
+
+// callTransaction sends a transaction message and sends it again after every
+// error but a transient one until it was attempted config.Attempts times.
+// attempts counts the attempts made, the caller keeps it across retries
+// after transient errors
+func (cp *ChargePoint) callTransaction(ctx context.Context, action string, p Payload, attempts *int) (Payload, error) {
+	ob := cp.outbox
+	for {
+		res, err := cp.call(ctx, action, p)
+		if err == nil || transient(err) || ctx.Err() != nil {
+			return res, err
+		}
+		*attempts++
+		if *attempts >= ob.config.Attempts {
+			return nil, err
+		}
+		if err := sleepContext(ctx, cp.clock, ob.config.RetryInterval*time.Duration(*attempts)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// transient reports whether sending a message failed because no response
+// arrived or the connection was lost, rather than because of the message or
+// its response, so sending it again may succeed
+func transient(err error) bool {
+	var timeoutErr *TimeoutError
+	return errors.As(err, &timeoutErr) ||
+		errors.Is(err, ErrChargePointNotConnected) ||
+		errors.Is(err, ErrChargePointDisconnected) ||
+		errors.Is(err, ErrChargePointClosing)
+}
+
+// outboxDone removes the front message and reports its result
+func (cp *ChargePoint) outboxDone(action string, res Payload, err error) {
+	if rmErr := cp.outbox.store.Remove(); rmErr != nil {
+		log.Error(rmErr)
+	}
+	if f := cp.outbox.onResult; f != nil {
+		f(cp, action, res, err)
+	}
+}
@@ -0,0 +1,212 @@
+package ocpp
+
+import (
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aliml92/ocpp/v16"
+)
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "outbox.jsonl")
+	s, err := NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, a := range []string{"StartTransaction", "MeterValues", "StopTransaction"} {
+		if err := s.Append(QueuedMessage{Action: a, Payload: json.RawMessage(`{}`)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.Remove(); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err = NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"MeterValues", "StopTransaction"} {
+		m, ok, err := s.Front()
+		if err != nil || !ok {
+			t.Fatalf("Front: %v %v", ok, err)
+		}
+		if m.Action != want {
+			t.Errorf("got %s want %s", m.Action, want)
+		}
+		if err := s.Remove(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, ok, _ := s.Front(); ok {
+		t.Error("store must be empty")
+	}
+}
+
+func TestOutboxDeliversInOrderAfterReconnect(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+	var mu sync.Mutex
+	var received []int
+	csms.On("MeterValues", func(cp *ChargePoint, p Payload) Payload {
+		mu.Lock()
+		received = append(received, *p.(*v16.MeterValuesReq).TransactionId)
+		mu.Unlock()
+		return &v16.MeterValuesConf{}
+	})
+
+	connected := onConnect(csms)
+
+	c := NewClient()
+	c.SetID("cp1")
+	c.AddSubProtocol(ocppV16)
+	c.SetCallQueueSize(8)
+	c.EnableReconnect(ReconnectConfig{InitialDelay: 300 * time.Millisecond})
+	c.EnableOutbox(NewMemoryStore(), OutboxConfig{Attempts: 3})
+	delivered := make(chan error, 3)
+	c.OnOutboxResult(func(cp *ChargePoint, action string, res Payload, err error) {
+		delivered <- err
+	})
+	cp, err := c.Start("ws"+strings.TrimPrefix(ts.URL, "http"), "/ws")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cp.Shutdown()
+
+	waitConnect(t, connected).Shutdown()
+	for cp.IsConnected() {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	for i := 1; i <= 3; i++ {
+		transactionId := i
+		_, err := cp.Call("MeterValues", &v16.MeterValuesReq{
+			ConnectorId:   ptr(1),
+			TransactionId: &transactionId,
+			MeterValue: []v16.MeterValue{{
+				Timestamp:    "2022-10-18T10:00:00Z",
+				SampledValue: []v16.SampledValue{{Value: "10"}},
+			}},
+		})
+		if !errors.Is(err, ErrMessageQueued) {
+			t.Fatalf("got %v want ErrMessageQueued", err)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		select {
+		case err := <-delivered:
+			if err != nil {
+				t.Errorf("delivery failed: %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("queued messages were not delivered")
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(received) != 3 || received[0] != 1 || received[1] != 2 || received[2] != 3 {
+		t.Errorf("got %v want [1 2 3]", received)
+	}
+}
+
+func TestOutboxRetriesDirectCall(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+	var mu sync.Mutex
+	calls := 0
+	csms.On("MeterValues", func(cp *ChargePoint, p Payload) Payload {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if calls != 3 {
+			return NewCallError(InternalError, "", nil)
+		}
+		return &v16.MeterValuesConf{}
+	})
+
+	c := NewClient()
+	c.SetID("cp1")
+	c.AddSubProtocol(ocppV16)
+	c.SetCallQueueSize(8)
+	c.EnableOutbox(NewMemoryStore(), OutboxConfig{Attempts: 3, RetryInterval: 10 * time.Millisecond})
+	cp, err := c.Start("ws"+strings.TrimPrefix(ts.URL, "http"), "/ws")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cp.Shutdown()
+
+	transactionId := 1
+	req := &v16.MeterValuesReq{
+		ConnectorId:   ptr(1),
+		TransactionId: &transactionId,
+		MeterValue: []v16.MeterValue{{
+			Timestamp:    "2022-10-18T10:00:00Z",
+			SampledValue: []v16.SampledValue{{Value: "10"}},
+		}},
+	}
+	if _, err := cp.Call("MeterValues", req); err != nil {
+		t.Fatalf("got %v want success on the third attempt", err)
+	}
+	var callErr *CallError
+	if _, err := cp.Call("MeterValues", req); !errors.As(err, &callErr) || callErr.ErrorCode != InternalError {
+		t.Errorf("got %v want InternalError after the last attempt", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if calls != 6 {
+		t.Errorf("got %d attempts want 6", calls)
+	}
+}
+
+func TestOutboxDropsInvalidResponse(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+	var mu sync.Mutex
+	sends := 0
+	csms.On("StartTransaction", func(cp *ChargePoint, p Payload) Payload {
+		mu.Lock()
+		sends++
+		mu.Unlock()
+		return &v16.StartTransactionConf{IdTagInfo: v16.IdTagInfo{Status: "Bogus"}, TransactionId: ptr(1)}
+	})
+	csms.SetValidationMode("StartTransaction", Outgoing, ValidationOff)
+
+	store := NewMemoryStore()
+	raw, _ := json.Marshal(&v16.StartTransactionReq{ConnectorId: ptr(1), IdTag: "tag", MeterStart: ptr(0), Timestamp: "2022-10-18T10:00:00Z"})
+	if err := store.Append(QueuedMessage{Action: "StartTransaction", Payload: raw}); err != nil {
+		t.Fatal(err)
+	}
+	c := NewClient()
+	c.SetID("cp1")
+	c.AddSubProtocol(ocppV16)
+	c.SetCallQueueSize(8)
+	c.EnableOutbox(store, OutboxConfig{Attempts: 3})
+	delivered := make(chan error, 1)
+	c.OnOutboxResult(func(cp *ChargePoint, action string, res Payload, err error) {
+		delivered <- err
+	})
+	cp, err := c.Start("ws"+strings.TrimPrefix(ts.URL, "http"), "/ws")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cp.Shutdown()
+
+	select {
+	case err := <-delivered:
+		if err == nil {
+			t.Error("invalid response reported as delivered")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("message with an invalid response was not dropped")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if sends != 3 {
+		t.Errorf("got %d sends want 3", sends)
+	}
+	if _, ok, _ := store.Front(); ok {
+		t.Error("dropped message is still queued")
+	}
+}
@@ -0,0 +1,414 @@
+// Code generated by ocppgen from the OCPP JSON schemas. DO NOT EDIT.
+
+package ocpp
+
+import (
+	"encoding/json"
+
+	"github.com/aliml92/ocpp/v16"
+	"github.com/aliml92/ocpp/v201"
+	"github.com/aliml92/ocpp/v21"
+)
+
+// payload types of the requests and responses by action
+var (
+	reqmapv16 = map[string]func(json.RawMessage) (Payload, error){
+		"Authorize":                        unmarshalPayload[v16.AuthorizeReq],
+		"BootNotification":                 unmarshalPayload[v16.BootNotificationReq],
+		"CancelReservation":                unmarshalPayload[v16.CancelReservationReq],
+		"CertificateSigned":                unmarshalPayload[v16.CertificateSignedReq],
+		"ChangeAvailability":               unmarshalPayload[v16.ChangeAvailabilityReq],
+		"ChangeConfiguration":              unmarshalPayload[v16.ChangeConfigurationReq],
+		"ClearCache":                       unmarshalPayload[v16.ClearCacheReq],
+		"ClearChargingProfile":             unmarshalPayload[v16.ClearChargingProfileReq],
+		"DataTransfer":                     unmarshalPayload[v16.DataTransferReq],
+		"DeleteCertificate":                unmarshalPayload[v16.DeleteCertificateReq],
+		"DiagnosticsStatusNotification":    unmarshalPayload[v16.DiagnosticsStatusNotificationReq],
+		"ExtendedTriggerMessage":           unmarshalPayload[v16.ExtendedTriggerMessageReq],
+		"FirmwareStatusNotification":       unmarshalPayload[v16.FirmwareStatusNotificationReq],
+		"GetCompositeSchedule":             unmarshalPayload[v16.GetCompositeScheduleReq],
+		"GetConfiguration":                 unmarshalPayload[v16.GetConfigurationReq],
+		"GetDiagnostics":                   unmarshalPayload[v16.GetDiagnosticsReq],
+		"GetInstalledCertificateIds":       unmarshalPayload[v16.GetInstalledCertificateIdsReq],
+		"GetLocalListVersion":              unmarshalPayload[v16.GetLocalListVersionReq],
+		"GetLog":                           unmarshalPayload[v16.GetLogReq],
+		"Heartbeat":                        unmarshalPayload[v16.HeartbeatReq],
+		"InstallCertificate":               unmarshalPayload[v16.InstallCertificateReq],
+		"LogStatusNotification":            unmarshalPayload[v16.LogStatusNotificationReq],
+		"MeterValues":                      unmarshalPayload[v16.MeterValuesReq],
+		"RemoteStartTransaction":           unmarshalPayload[v16.RemoteStartTransactionReq],
+		"RemoteStopTransaction":            unmarshalPayload[v16.RemoteStopTransactionReq],
+		"ReserveNow":                       unmarshalPayload[v16.ReserveNowReq],
+		"Reset":                            unmarshalPayload[v16.ResetReq],
+		"SecurityEventNotification":        unmarshalPayload[v16.SecurityEventNotificationReq],
+		"SendLocalList":                    unmarshalPayload[v16.SendLocalListReq],
+		"SetChargingProfile":               unmarshalPayload[v16.SetChargingProfileReq],
+		"SignCertificate":                  unmarshalPayload[v16.SignCertificateReq],
+		"SignedFirmwareStatusNotification": unmarshalPayload[v16.SignedFirmwareStatusNotificationReq],
+		"SignedUpdateFirmware":             unmarshalPayload[v16.SignedUpdateFirmwareReq],
+		"StartTransaction":                 unmarshalPayload[v16.StartTransactionReq],
+		"StatusNotification":               unmarshalPayload[v16.StatusNotificationReq],
+		"StopTransaction":                  unmarshalPayload[v16.StopTransactionReq],
+		"TriggerMessage":                   unmarshalPayload[v16.TriggerMessageReq],
+		"UnlockConnector":                  unmarshalPayload[v16.UnlockConnectorReq],
+		"UpdateFirmware":                   unmarshalPayload[v16.UpdateFirmwareReq],
+	}
+	resmapv16 = map[string]func(json.RawMessage) (Payload, error){
+		"Authorize":                        unmarshalPayload[v16.AuthorizeConf],
+		"BootNotification":                 unmarshalPayload[v16.BootNotificationConf],
+		"CancelReservation":                unmarshalPayload[v16.CancelReservationConf],
+		"CertificateSigned":                unmarshalPayload[v16.CertificateSignedConf],
+		"ChangeAvailability":               unmarshalPayload[v16.ChangeAvailabilityConf],
+		"ChangeConfiguration":              unmarshalPayload[v16.ChangeConfigurationConf],
+		"ClearCache":                       unmarshalPayload[v16.ClearCacheConf],
+		"ClearChargingProfile":             unmarshalPayload[v16.ClearChargingProfileConf],
+		"DataTransfer":                     unmarshalPayload[v16.DataTransferConf],
+		"DeleteCertificate":                unmarshalPayload[v16.DeleteCertificateConf],
+		"DiagnosticsStatusNotification":    unmarshalPayload[v16.DiagnosticsStatusNotificationConf],
+		"ExtendedTriggerMessage":           unmarshalPayload[v16.ExtendedTriggerMessageConf],
+		"FirmwareStatusNotification":       unmarshalPayload[v16.FirmwareStatusNotificationConf],
+		"GetCompositeSchedule":             unmarshalPayload[v16.GetCompositeScheduleConf],
+		"GetConfiguration":                 unmarshalPayload[v16.GetConfigurationConf],
+		"GetDiagnostics":                   unmarshalPayload[v16.GetDiagnosticsConf],
+		"GetInstalledCertificateIds":       unmarshalPayload[v16.GetInstalledCertificateIdsConf],
+		"GetLocalListVersion":              unmarshalPayload[v16.GetLocalListVersionConf],
+		"GetLog":                           unmarshalPayload[v16.GetLogConf],
+		"Heartbeat":                        unmarshalPayload[v16.HeartbeatConf],
+		"InstallCertificate":               unmarshalPayload[v16.InstallCertificateConf],
+		"LogStatusNotification":            unmarshalPayload[v16.LogStatusNotificationConf],
+		"MeterValues":                      unmarshalPayload[v16.MeterValuesConf],
+		"RemoteStartTransaction":           unmarshalPayload[v16.RemoteStartTransactionConf],
+		"RemoteStopTransaction":            unmarshalPayload[v16.RemoteStopTransactionConf],
+		"ReserveNow":                       unmarshalPayload[v16.ReserveNowConf],
+		"Reset":                            unmarshalPayload[v16.ResetConf],
+		"SecurityEventNotification":        unmarshalPayload[v16.SecurityEventNotificationConf],
+		"SendLocalList":                    unmarshalPayload[v16.SendLocalListConf],
+		"SetChargingProfile":               unmarshalPayload[v16.SetChargingProfileConf],
+		"SignCertificate":                  unmarshalPayload[v16.SignCertificateConf],
+		"SignedFirmwareStatusNotification": unmarshalPayload[v16.SignedFirmwareStatusNotificationConf],
+		"SignedUpdateFirmware":             unmarshalPayload[v16.SignedUpdateFirmwareConf],
+		"StartTransaction":                 unmarshalPayload[v16.StartTransactionConf],
+		"StatusNotification":               unmarshalPayload[v16.StatusNotificationConf],
+		"StopTransaction":                  unmarshalPayload[v16.StopTransactionConf],
+		"TriggerMessage":                   unmarshalPayload[v16.TriggerMessageConf],
+		"UnlockConnector":                  unmarshalPayload[v16.UnlockConnectorConf],
+		"UpdateFirmware":                   unmarshalPayload[v16.UpdateFirmwareConf],
+	}
+	reqmapv201 = map[string]func(json.RawMessage) (Payload, error){
+		"Authorize":                         unmarshalPayload[v201.AuthorizeReq],
+		"BootNotification":                  unmarshalPayload[v201.BootNotificationReq],
+		"CancelReservation":                 unmarshalPayload[v201.CancelReservationReq],
+		"CertificateSigned":                 unmarshalPayload[v201.CertificateSignedReq],
+		"ChangeAvailability":                unmarshalPayload[v201.ChangeAvailabilityReq],
+		"ClearCache":                        unmarshalPayload[v201.ClearCacheReq],
+		"ClearChargingProfile":              unmarshalPayload[v201.ClearChargingProfileReq],
+		"ClearDisplayMessage":               unmarshalPayload[v201.ClearDisplayMessageReq],
+		"ClearVariableMonitoring":           unmarshalPayload[v201.ClearVariableMonitoringReq],
+		"ClearedChargingLimit":              unmarshalPayload[v201.ClearedChargingLimitReq],
+		"CostUpdated":                       unmarshalPayload[v201.CostUpdatedReq],
+		"CustomerInformation":               unmarshalPayload[v201.CustomerInformationReq],
+		"DataTransfer":                      unmarshalPayload[v201.DataTransferReq],
+		"DeleteCertificate":                 unmarshalPayload[v201.DeleteCertificateReq],
+		"FirmwareStatusNotification":        unmarshalPayload[v201.FirmwareStatusNotificationReq],
+		"Get15118EVCertificate":             unmarshalPayload[v201.Get15118EVCertificateReq],
+		"GetBaseReport":                     unmarshalPayload[v201.GetBaseReportReq],
+		"GetCertificateStatus":              unmarshalPayload[v201.GetCertificateStatusReq],
+		"GetChargingProfiles":               unmarshalPayload[v201.GetChargingProfilesReq],
+		"GetCompositeSchedule":              unmarshalPayload[v201.GetCompositeScheduleReq],
+		"GetDisplayMessages":                unmarshalPayload[v201.GetDisplayMessagesReq],
+		"GetInstalledCertificateIds":        unmarshalPayload[v201.GetInstalledCertificateIdsReq],
+		"GetLocalListVersion":               unmarshalPayload[v201.GetLocalListVersionReq],
+		"GetLog":                            unmarshalPayload[v201.GetLogReq],
+		"GetMonitoringReport":               unmarshalPayload[v201.GetMonitoringReportReq],
+		"GetReport":                         unmarshalPayload[v201.GetReportReq],
+		"GetTransactionStatus":              unmarshalPayload[v201.GetTransactionStatusReq],
+		"GetVariables":                      unmarshalPayload[v201.GetVariablesReq],
+		"Heartbeat":                         unmarshalPayload[v201.HeartbeatReq],
+		"InstallCertificate":                unmarshalPayload[v201.InstallCertificateReq],
+		"LogStatusNotification":             unmarshalPayload[v201.LogStatusNotificationReq],
+		"MeterValues":                       unmarshalPayload[v201.MeterValuesReq],
+		"NotifyChargingLimit":               unmarshalPayload[v201.NotifyChargingLimitReq],
+		"NotifyCustomerInformation":         unmarshalPayload[v201.NotifyCustomerInformationReq],
+		"NotifyDisplayMessages":             unmarshalPayload[v201.NotifyDisplayMessagesReq],
+		"NotifyEVChargingNeeds":             unmarshalPayload[v201.NotifyEVChargingNeedsReq],
+		"NotifyEVChargingSchedule":          unmarshalPayload[v201.NotifyEVChargingScheduleReq],
+		"NotifyEvent":                       unmarshalPayload[v201.NotifyEventReq],
+		"NotifyMonitoringReport":            unmarshalPayload[v201.NotifyMonitoringReportReq],
+		"NotifyReport":                      unmarshalPayload[v201.NotifyReportReq],
+		"PublishFirmware":                   unmarshalPayload[v201.PublishFirmwareReq],
+		"PublishFirmwareStatusNotification": unmarshalPayload[v201.PublishFirmwareStatusNotificationReq],
+		"ReportChargingProfiles":            unmarshalPayload[v201.ReportChargingProfilesReq],
+		"RequestStartTransaction":           unmarshalPayload[v201.RequestStartTransactionReq],
+		"RequestStopTransaction":            unmarshalPayload[v201.RequestStopTransactionReq],
+		"ReservationStatusUpdate":           unmarshalPayload[v201.ReservationStatusUpdateReq],
+		"ReserveNow":                        unmarshalPayload[v201.ReserveNowReq],
+		"Reset":                             unmarshalPayload[v201.ResetReq],
+		"SecurityEventNotification":         unmarshalPayload[v201.SecurityEventNotificationReq],
+		"SendLocalList":                     unmarshalPayload[v201.SendLocalListReq],
+		"SetChargingProfile":                unmarshalPayload[v201.SetChargingProfileReq],
+		"SetDisplayMessage":                 unmarshalPayload[v201.SetDisplayMessageReq],
+		"SetMonitoringBase":                 unmarshalPayload[v201.SetMonitoringBaseReq],
+		"SetMonitoringLevel":                unmarshalPayload[v201.SetMonitoringLevelReq],
+		"SetNetworkProfile":                 unmarshalPayload[v201.SetNetworkProfileReq],
+		"SetVariableMonitoring":             unmarshalPayload[v201.SetVariableMonitoringReq],
+		"SetVariables":                      unmarshalPayload[v201.SetVariablesReq],
+		"SignCertificate":                   unmarshalPayload[v201.SignCertificateReq],
+		"StatusNotification":                unmarshalPayload[v201.StatusNotificationReq],
+		"TransactionEvent":                  unmarshalPayload[v201.TransactionEventReq],
+		"TriggerMessage":                    unmarshalPayload[v201.TriggerMessageReq],
+		"UnlockConnector":                   unmarshalPayload[v201.UnlockConnectorReq],
+		"UnpublishFirmware":                 unmarshalPayload[v201.UnpublishFirmwareReq],
+		"UpdateFirmware":                    unmarshalPayload[v201.UpdateFirmwareReq],
+	}
+	resmapv201 = map[string]func(json.RawMessage) (Payload, error){
+		"Authorize":                         unmarshalPayload[v201.AuthorizeRes],
+		"BootNotification":                  unmarshalPayload[v201.BootNotificationRes],
+		"CancelReservation":                 unmarshalPayload[v201.CancelReservationRes],
+		"CertificateSigned":                 unmarshalPayload[v201.CertificateSignedRes],
+		"ChangeAvailability":                unmarshalPayload[v201.ChangeAvailabilityRes],
+		"ClearCache":                        unmarshalPayload[v201.ClearCacheRes],
+		"ClearChargingProfile":              unmarshalPayload[v201.ClearChargingProfileRes],
+		"ClearDisplayMessage":               unmarshalPayload[v201.ClearDisplayMessageRes],
+		"ClearVariableMonitoring":           unmarshalPayload[v201.ClearVariableMonitoringRes],
+		"ClearedChargingLimit":              unmarshalPayload[v201.ClearedChargingLimitRes],
+		"CostUpdated":                       unmarshalPayload[v201.CostUpdatedRes],
+		"CustomerInformation":               unmarshalPayload[v201.CustomerInformationRes],
+		"DataTransfer":                      unmarshalPayload[v201.DataTransferRes],
+		"DeleteCertificate":                 unmarshalPayload[v201.DeleteCertificateRes],
+		"FirmwareStatusNotification":        unmarshalPayload[v201.FirmwareStatusNotificationRes],
+		"Get15118EVCertificate":             unmarshalPayload[v201.Get15118EVCertificateRes],
+		"GetBaseReport":                     unmarshalPayload[v201.GetBaseReportRes],
+		"GetCertificateStatus":              unmarshalPayload[v201.GetCertificateStatusRes],
+		"GetChargingProfiles":               unmarshalPayload[v201.GetChargingProfilesRes],
+		"GetCompositeSchedule":              unmarshalPayload[v201.GetCompositeScheduleRes],
+		"GetDisplayMessages":                unmarshalPayload[v201.GetDisplayMessagesRes],
+		"GetInstalledCertificateIds":        unmarshalPayload[v201.GetInstalledCertificateIdsRes],
+		"GetLocalListVersion":               unmarshalPayload[v201.GetLocalListVersionRes],
+		"GetLog":                            unmarshalPayload[v201.GetLogRes],
+		"GetMonitoringReport":               unmarshalPayload[v201.GetMonitoringReportRes],
+		"GetReport":                         unmarshalPayload[v201.GetReportRes],
+		"GetTransactionStatus":              unmarshalPayload[v201.GetTransactionStatusRes],
+		"GetVariables":                      unmarshalPayload[v201.GetVariablesRes],
+		"Heartbeat":                         unmarshalPayload[v201.HeartbeatRes],
+		"InstallCertificate":                unmarshalPayload[v201.InstallCertificateRes],
+		"LogStatusNotification":             unmarshalPayload[v201.LogStatusNotificationRes],
+		"MeterValues":                       unmarshalPayload[v201.MeterValuesRes],
+		"NotifyChargingLimit":               unmarshalPayload[v201.NotifyChargingLimitRes],
+		"NotifyCustomerInformation":         unmarshalPayload[v201.NotifyCustomerInformationRes],
+		"NotifyDisplayMessages":             unmarshalPayload[v201.NotifyDisplayMessagesRes],
+		"NotifyEVChargingNeeds":             unmarshalPayload[v201.NotifyEVChargingNeedsRes],
+		"NotifyEVChargingSchedule":          unmarshalPayload[v201.NotifyEVChargingScheduleRes],
+		"NotifyEvent":                       unmarshalPayload[v201.NotifyEventRes],
+		"NotifyMonitoringReport":            unmarshalPayload[v201.NotifyMonitoringReportRes],
+		"NotifyReport":                      unmarshalPayload[v201.NotifyReportRes],
+		"PublishFirmware":                   unmarshalPayload[v201.PublishFirmwareRes],
+		"PublishFirmwareStatusNotification": unmarshalPayload[v201.PublishFirmwareStatusNotificationRes],
+		"ReportChargingProfiles":            unmarshalPayload[v201.ReportChargingProfilesRes],
+		"RequestStartTransaction":           unmarshalPayload[v201.RequestStartTransactionRes],
+		"RequestStopTransaction":            unmarshalPayload[v201.RequestStopTransactionRes],
+		"ReservationStatusUpdate":           unmarshalPayload[v201.ReservationStatusUpdateRes],
+		"ReserveNow":                        unmarshalPayload[v201.ReserveNowRes],
+		"Reset":                             unmarshalPayload[v201.ResetRes],
+		"SecurityEventNotification":         unmarshalPayload[v201.SecurityEventNotificationRes],
+		"SendLocalList":                     unmarshalPayload[v201.SendLocalListRes],
+		"SetChargingProfile":                unmarshalPayload[v201.SetChargingProfileRes],
+		"SetDisplayMessage":                 unmarshalPayload[v201.SetDisplayMessageRes],
+		"SetMonitoringBase":                 unmarshalPayload[v201.SetMonitoringBaseRes],
+		"SetMonitoringLevel":                unmarshalPayload[v201.SetMonitoringLevelRes],
+		"SetNetworkProfile":                 unmarshalPayload[v201.SetNetworkProfileRes],
+		"SetVariableMonitoring":             unmarshalPayload[v201.SetVariableMonitoringRes],
+		"SetVariables":                      unmarshalPayload[v201.SetVariablesRes],
+		"SignCertificate":                   unmarshalPayload[v201.SignCertificateRes],
+		"StatusNotification":                unmarshalPayload[v201.StatusNotificationRes],
+		"TransactionEvent":                  unmarshalPayload[v201.TransactionEventRes],
+		"TriggerMessage":                    unmarshalPayload[v201.TriggerMessageRes],
+		"UnlockConnector":                   unmarshalPayload[v201.UnlockConnectorRes],
+		"UnpublishFirmware":                 unmarshalPayload[v201.UnpublishFirmwareRes],
+		"UpdateFirmware":                    unmarshalPayload[v201.UpdateFirmwareRes],
+	}
+	reqmapv21 = map[string]func(json.RawMessage) (Payload, error){
+		"AFRRSignal":                        unmarshalPayload[v21.AFRRSignalReq],
+		"AdjustPeriodicEventStream":         unmarshalPayload[v21.AdjustPeriodicEventStreamReq],
+		"Authorize":                         unmarshalPayload[v21.AuthorizeReq],
+		"BatterySwap":                       unmarshalPayload[v21.BatterySwapReq],
+		"BootNotification":                  unmarshalPayload[v21.BootNotificationReq],
+		"CancelReservation":                 unmarshalPayload[v21.CancelReservationReq],
+		"CertificateSigned":                 unmarshalPayload[v21.CertificateSignedReq],
+		"ChangeAvailability":                unmarshalPayload[v21.ChangeAvailabilityReq],
+		"ChangeTransactionTariff":           unmarshalPayload[v21.ChangeTransactionTariffReq],
+		"ClearCache":                        unmarshalPayload[v21.ClearCacheReq],
+		"ClearChargingProfile":              unmarshalPayload[v21.ClearChargingProfileReq],
+		"ClearDERControl":                   unmarshalPayload[v21.ClearDERControlReq],
+		"ClearDisplayMessage":               unmarshalPayload[v21.ClearDisplayMessageReq],
+		"ClearTariffs":                      unmarshalPayload[v21.ClearTariffsReq],
+		"ClearVariableMonitoring":           unmarshalPayload[v21.ClearVariableMonitoringReq],
+		"ClearedChargingLimit":              unmarshalPayload[v21.ClearedChargingLimitReq],
+		"ClosePeriodicEventStream":          unmarshalPayload[v21.ClosePeriodicEventStreamReq],
+		"CostUpdated":                       unmarshalPayload[v21.CostUpdatedReq],
+		"CustomerInformation":               unmarshalPayload[v21.CustomerInformationReq],
+		"DataTransfer":                      unmarshalPayload[v21.DataTransferReq],
+		"DeleteCertificate":                 unmarshalPayload[v21.DeleteCertificateReq],
+		"FirmwareStatusNotification":        unmarshalPayload[v21.FirmwareStatusNotificationReq],
+		"Get15118EVCertificate":             unmarshalPayload[v21.Get15118EVCertificateReq],
+		"GetBaseReport":                     unmarshalPayload[v21.GetBaseReportReq],
+		"GetCertificateChainStatus":         unmarshalPayload[v21.GetCertificateChainStatusReq],
+		"GetCertificateStatus":              unmarshalPayload[v21.GetCertificateStatusReq],
+		"GetChargingProfiles":               unmarshalPayload[v21.GetChargingProfilesReq],
+		"GetCompositeSchedule":              unmarshalPayload[v21.GetCompositeScheduleReq],
+		"GetDERControl":                     unmarshalPayload[v21.GetDERControlReq],
+		"GetDisplayMessages":                unmarshalPayload[v21.GetDisplayMessagesReq],
+		"GetInstalledCertificateIds":        unmarshalPayload[v21.GetInstalledCertificateIdsReq],
+		"GetLocalListVersion":               unmarshalPayload[v21.GetLocalListVersionReq],
+		"GetLog":                            unmarshalPayload[v21.GetLogReq],
+		"GetMonitoringReport":               unmarshalPayload[v21.GetMonitoringReportReq],
+		"GetPeriodicEventStream":            unmarshalPayload[v21.GetPeriodicEventStreamReq],
+		"GetReport":                         unmarshalPayload[v21.GetReportReq],
+		"GetTariffs":                        unmarshalPayload[v21.GetTariffsReq],
+		"GetTransactionStatus":              unmarshalPayload[v21.GetTransactionStatusReq],
+		"GetVariables":                      unmarshalPayload[v21.GetVariablesReq],
+		"Heartbeat":                         unmarshalPayload[v21.HeartbeatReq],
+		"InstallCertificate":                unmarshalPayload[v21.InstallCertificateReq],
+		"LogStatusNotification":             unmarshalPayload[v21.LogStatusNotificationReq],
+		"MeterValues":                       unmarshalPayload[v21.MeterValuesReq],
+		"NotifyAllowedEnergyTransfer":       unmarshalPayload[v21.NotifyAllowedEnergyTransferReq],
+		"NotifyChargingLimit":               unmarshalPayload[v21.NotifyChargingLimitReq],
+		"NotifyCustomerInformation":         unmarshalPayload[v21.NotifyCustomerInformationReq],
+		"NotifyDERAlarm":                    unmarshalPayload[v21.NotifyDERAlarmReq],
+		"NotifyDERStartStop":                unmarshalPayload[v21.NotifyDERStartStopReq],
+		"NotifyDisplayMessages":             unmarshalPayload[v21.NotifyDisplayMessagesReq],
+		"NotifyEVChargingNeeds":             unmarshalPayload[v21.NotifyEVChargingNeedsReq],
+		"NotifyEVChargingSchedule":          unmarshalPayload[v21.NotifyEVChargingScheduleReq],
+		"NotifyEvent":                       unmarshalPayload[v21.NotifyEventReq],
+		"NotifyMonitoringReport":            unmarshalPayload[v21.NotifyMonitoringReportReq],
+		"NotifyPeriodicEventStream":         unmarshalPayload[v21.NotifyPeriodicEventStreamReq],
+		"NotifyPriorityCharging":            unmarshalPayload[v21.NotifyPriorityChargingReq],
+		"NotifyReport":                      unmarshalPayload[v21.NotifyReportReq],
+		"NotifySettlement":                  unmarshalPayload[v21.NotifySettlementReq],
+		"NotifyWebPaymentStarted":           unmarshalPayload[v21.NotifyWebPaymentStartedReq],
+		"OpenPeriodicEventStream":           unmarshalPayload[v21.OpenPeriodicEventStreamReq],
+		"PublishFirmware":                   unmarshalPayload[v21.PublishFirmwareReq],
+		"PublishFirmwareStatusNotification": unmarshalPayload[v21.PublishFirmwareStatusNotificationReq],
+		"PullDynamicScheduleUpdate":         unmarshalPayload[v21.PullDynamicScheduleUpdateReq],
+		"ReportChargingProfiles":            unmarshalPayload[v21.ReportChargingProfilesReq],
+		"ReportDERControl":                  unmarshalPayload[v21.ReportDERControlReq],
+		"RequestBatterySwap":                unmarshalPayload[v21.RequestBatterySwapReq],
+		"RequestStartTransaction":           unmarshalPayload[v21.RequestStartTransactionReq],
+		"RequestStopTransaction":            unmarshalPayload[v21.RequestStopTransactionReq],
+		"ReservationStatusUpdate":           unmarshalPayload[v21.ReservationStatusUpdateReq],
+		"ReserveNow":                        unmarshalPayload[v21.ReserveNowReq],
+		"Reset":                             unmarshalPayload[v21.ResetReq],
+		"SecurityEventNotification":         unmarshalPayload[v21.SecurityEventNotificationReq],
+		"SendLocalList":                     unmarshalPayload[v21.SendLocalListReq],
+		"SetChargingProfile":                unmarshalPayload[v21.SetChargingProfileReq],
+		"SetDERControl":                     unmarshalPayload[v21.SetDERControlReq],
+		"SetDefaultTariff":                  unmarshalPayload[v21.SetDefaultTariffReq],
+		"SetDisplayMessage":                 unmarshalPayload[v21.SetDisplayMessageReq],
+		"SetMonitoringBase":                 unmarshalPayload[v21.SetMonitoringBaseReq],
+		"SetMonitoringLevel":                unmarshalPayload[v21.SetMonitoringLevelReq],
+		"SetNetworkProfile":                 unmarshalPayload[v21.SetNetworkProfileReq],
+		"SetVariableMonitoring":             unmarshalPayload[v21.SetVariableMonitoringReq],
+		"SetVariables":                      unmarshalPayload[v21.SetVariablesReq],
+		"SignCertificate":                   unmarshalPayload[v21.SignCertificateReq],
+		"StatusNotification":                unmarshalPayload[v21.StatusNotificationReq],
+		"TransactionEvent":                  unmarshalPayload[v21.TransactionEventReq],
+		"TriggerMessage":                    unmarshalPayload[v21.TriggerMessageReq],
+		"UnlockConnector":                   unmarshalPayload[v21.UnlockConnectorReq],
+		"UnpublishFirmware":                 unmarshalPayload[v21.UnpublishFirmwareReq],
+		"UpdateDynamicSchedule":             unmarshalPayload[v21.UpdateDynamicScheduleReq],
+		"UpdateFirmware":                    unmarshalPayload[v21.UpdateFirmwareReq],
+		"UsePriorityCharging":               unmarshalPayload[v21.UsePriorityChargingReq],
+		"VatNumberValidation":               unmarshalPayload[v21.VatNumberValidationReq],
+	}
+	resmapv21 = map[string]func(json.RawMessage) (Payload, error){
+		"AFRRSignal":                        unmarshalPayload[v21.AFRRSignalRes],
+		"AdjustPeriodicEventStream":         unmarshalPayload[v21.AdjustPeriodicEventStreamRes],
+		"Authorize":                         unmarshalPayload[v21.AuthorizeRes],
+		"BatterySwap":                       unmarshalPayload[v21.BatterySwapRes],
+		"BootNotification":                  unmarshalPayload[v21.BootNotificationRes],
+		"CancelReservation":                 unmarshalPayload[v21.CancelReservationRes],
+		"CertificateSigned":                 unmarshalPayload[v21.CertificateSignedRes],
+		"ChangeAvailability":                unmarshalPayload[v21.ChangeAvailabilityRes],
+		"ChangeTransactionTariff":           unmarshalPayload[v21.ChangeTransactionTariffRes],
+		"ClearCache":                        unmarshalPayload[v21.ClearCacheRes],
+		"ClearChargingProfile":              unmarshalPayload[v21.ClearChargingProfileRes],
+		"ClearDERControl":                   unmarshalPayload[v21.ClearDERControlRes],
+		"ClearDisplayMessage":               unmarshalPayload[v21.ClearDisplayMessageRes],
+		"ClearTariffs":                      unmarshalPayload[v21.ClearTariffsRes],
+		"ClearVariableMonitoring":           unmarshalPayload[v21.ClearVariableMonitoringRes],
+		"ClearedChargingLimit":              unmarshalPayload[v21.ClearedChargingLimitRes],
+		"ClosePeriodicEventStream":          unmarshalPayload[v21.ClosePeriodicEventStreamRes],
+		"CostUpdated":                       unmarshalPayload[v21.CostUpdatedRes],
+		"CustomerInformation":               unmarshalPayload[v21.CustomerInformationRes],
+		"DataTransfer":                      unmarshalPayload[v21.DataTransferRes],
+		"DeleteCertificate":                 unmarshalPayload[v21.DeleteCertificateRes],
+		"FirmwareStatusNotification":        unmarshalPayload[v21.FirmwareStatusNotificationRes],
+		"Get15118EVCertificate":             unmarshalPayload[v21.Get15118EVCertificateRes],
+		"GetBaseReport":                     unmarshalPayload[v21.GetBaseReportRes],
+		"GetCertificateChainStatus":         unmarshalPayload[v21.GetCertificateChainStatusRes],
+		"GetCertificateStatus":              unmarshalPayload[v21.GetCertificateStatusRes],
+		"GetChargingProfiles":               unmarshalPayload[v21.GetChargingProfilesRes],
+		"GetCompositeSchedule":              unmarshalPayload[v21.GetCompositeScheduleRes],
+		"GetDERControl":                     unmarshalPayload[v21.GetDERControlRes],
+		"GetDisplayMessages":                unmarshalPayload[v21.GetDisplayMessagesRes],
+		"GetInstalledCertificateIds":        unmarshalPayload[v21.GetInstalledCertificateIdsRes],
+		"GetLocalListVersion":               unmarshalPayload[v21.GetLocalListVersionRes],
+		"GetLog":                            unmarshalPayload[v21.GetLogRes],
+		"GetMonitoringReport":               unmarshalPayload[v21.GetMonitoringReportRes],
+		"GetPeriodicEventStream":            unmarshalPayload[v21.GetPeriodicEventStreamRes],
+		"GetReport":                         unmarshalPayload[v21.GetReportRes],
+		"GetTariffs":                        unmarshalPayload[v21.GetTariffsRes],
+		"GetTransactionStatus":              unmarshalPayload[v21.GetTransactionStatusRes],
+		"GetVariables":                      unmarshalPayload[v21.GetVariablesRes],
+		"Heartbeat":                         unmarshalPayload[v21.HeartbeatRes],
+		"InstallCertificate":                unmarshalPayload[v21.InstallCertificateRes],
+		"LogStatusNotification":             unmarshalPayload[v21.LogStatusNotificationRes],
+		"MeterValues":                       unmarshalPayload[v21.MeterValuesRes],
+		"NotifyAllowedEnergyTransfer":       unmarshalPayload[v21.NotifyAllowedEnergyTransferRes],
+		"NotifyChargingLimit":               unmarshalPayload[v21.NotifyChargingLimitRes],
+		"NotifyCustomerInformation":         unmarshalPayload[v21.NotifyCustomerInformationRes],
+		"NotifyDERAlarm":                    unmarshalPayload[v21.NotifyDERAlarmRes],
+		"NotifyDERStartStop":                unmarshalPayload[v21.NotifyDERStartStopRes],
+		"NotifyDisplayMessages":             unmarshalPayload[v21.NotifyDisplayMessagesRes],
+		"NotifyEVChargingNeeds":             unmarshalPayload[v21.NotifyEVChargingNeedsRes],
+		"NotifyEVChargingSchedule":          unmarshalPayload[v21.NotifyEVChargingScheduleRes],
+		"NotifyEvent":                       unmarshalPayload[v21.NotifyEventRes],
+		"NotifyMonitoringReport":            unmarshalPayload[v21.NotifyMonitoringReportRes],
+		"NotifyPriorityCharging":            unmarshalPayload[v21.NotifyPriorityChargingRes],
+		"NotifyReport":                      unmarshalPayload[v21.NotifyReportRes],
+		"NotifySettlement":                  unmarshalPayload[v21.NotifySettlementRes],
+		"NotifyWebPaymentStarted":           unmarshalPayload[v21.NotifyWebPaymentStartedRes],
+		"OpenPeriodicEventStream":           unmarshalPayload[v21.OpenPeriodicEventStreamRes],
+		"PublishFirmware":                   unmarshalPayload[v21.PublishFirmwareRes],
+		"PublishFirmwareStatusNotification": unmarshalPayload[v21.PublishFirmwareStatusNotificationRes],
+		"PullDynamicScheduleUpdate":         unmarshalPayload[v21.PullDynamicScheduleUpdateRes],
+		"ReportChargingProfiles":            unmarshalPayload[v21.ReportChargingProfilesRes],
+		"ReportDERControl":                  unmarshalPayload[v21.ReportDERControlRes],
+		"RequestBatterySwap":                unmarshalPayload[v21.RequestBatterySwapRes],
+		"RequestStartTransaction":           unmarshalPayload[v21.RequestStartTransactionRes],
+		"RequestStopTransaction":            unmarshalPayload[v21.RequestStopTransactionRes],
+		"ReservationStatusUpdate":           unmarshalPayload[v21.ReservationStatusUpdateRes],
+		"ReserveNow":                        unmarshalPayload[v21.ReserveNowRes],
+		"Reset":                             unmarshalPayload[v21.ResetRes],
+		"SecurityEventNotification":         unmarshalPayload[v21.SecurityEventNotificationRes],
+		"SendLocalList":                     unmarshalPayload[v21.SendLocalListRes],
+		"SetChargingProfile":                unmarshalPayload[v21.SetChargingProfileRes],
+		"SetDERControl":                     unmarshalPayload[v21.SetDERControlRes],
+		"SetDefaultTariff":                  unmarshalPayload[v21.SetDefaultTariffRes],
+		"SetDisplayMessage":                 unmarshalPayload[v21.SetDisplayMessageRes],
+		"SetMonitoringBase":                 unmarshalPayload[v21.SetMonitoringBaseRes],
+		"SetMonitoringLevel":                unmarshalPayload[v21.SetMonitoringLevelRes],
+		"SetNetworkProfile":                 unmarshalPayload[v21.SetNetworkProfileRes],
+		"SetVariableMonitoring":             unmarshalPayload[v21.SetVariableMonitoringRes],
+		"SetVariables":                      unmarshalPayload[v21.SetVariablesRes],
+		"SignCertificate":                   unmarshalPayload[v21.SignCertificateRes],
+		"StatusNotification":                unmarshalPayload[v21.StatusNotificationRes],
+		"TransactionEvent":                  unmarshalPayload[v21.TransactionEventRes],
+		"TriggerMessage":                    unmarshalPayload[v21.TriggerMessageRes],
+		"UnlockConnector":                   unmarshalPayload[v21.UnlockConnectorRes],
+		"UnpublishFirmware":                 unmarshalPayload[v21.UnpublishFirmwareRes],
+		"UpdateDynamicSchedule":             unmarshalPayload[v21.UpdateDynamicScheduleRes],
+		"UpdateFirmware":                    unmarshalPayload[v21.UpdateFirmwareRes],
+		"UsePriorityCharging":               unmarshalPayload[v21.UsePriorityChargingRes],
+		"VatNumberValidation":               unmarshalPayload[v21.VatNumberValidationRes],
+	}
+)
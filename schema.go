@@ -0,0 +1,225 @@
+package ocpp
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// embeddedSchemas holds the JSON schemas of OCPP 1.6 (including the Security
+// Whitepaper Edition 2 messages, draft-04), OCPP 2.0.1 and OCPP 2.1 (draft-06)
+//
+//go:embed schemas/ocpp1.6/*.json schemas/ocpp2.0.1/*.json schemas/ocpp2.1/*.json
+var embeddedSchemas embed.FS
+
+// ValidationBackend selects what payloads are validated against
+type ValidationBackend int
+
+const (
+	// ValidationTags validates decoded payloads against the validator.v9 tags
+	// of the v16, v201 and v21 types, it is the default
+	ValidationTags ValidationBackend = iota
+	// ValidationSchema validates raw payloads against the OCPP JSON schemas
+	ValidationSchema
+)
+
+var (
+	schemaSetsMu sync.Mutex
+	schemaSets   = map[string]*schemaSet{
+		ocppV16:  newSchemaSet(ocppV16, subFS(embeddedSchemas, "schemas/ocpp1.6")),
+		ocppV201: newSchemaSet(ocppV201, subFS(embeddedSchemas, "schemas/ocpp2.0.1")),
+		ocppV21:  newSchemaSet(ocppV21, subFS(embeddedSchemas, "schemas/ocpp2.1")),
+	}
+)
+
+func subFS(fsys fs.FS, dir string) fs.FS {
+	sub, err := fs.Sub(fsys, dir)
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
+
+// LoadSchemas replaces the embedded JSON schemas of proto by the ones in the
+// root of fsys, e.g. the official schema files of the Open Charge Alliance.
+// The files are named like the official ones: "BootNotification.json" and
+// "BootNotificationResponse.json" for ocpp1.6, "BootNotificationRequest.json"
+// and "BootNotificationResponse.json" for ocpp2.0.1
+func LoadSchemas(proto string, fsys fs.FS) error {
+	if proto != ocppV16 && proto != ocppV201 && proto != ocppV21 {
+		return fmt.Errorf("ocpp: no schemas for subprotocol %q", proto)
+	}
+	set := newSchemaSet(proto, fsys)
+	names, err := fs.Glob(fsys, "*.json")
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("ocpp: no schema files found for %s", proto)
+	}
+	for _, name := range names {
+		if _, err := set.compile(name); err != nil {
+			return err
+		}
+	}
+	schemaSetsMu.Lock()
+	schemaSets[proto] = set
+	schemaSetsMu.Unlock()
+	return nil
+}
+
+func getSchemaSet(proto string) *schemaSet {
+	schemaSetsMu.Lock()
+	defer schemaSetsMu.Unlock()
+	return schemaSets[proto]
+}
+
+// schemaSet compiles the schemas of a subprotocol on first use
+type schemaSet struct {
+	proto string
+	fsys  fs.FS
+
+	mu       sync.Mutex
+	compiled map[string]*jsonschema.Schema
+}
+
+func newSchemaSet(proto string, fsys fs.FS) *schemaSet {
+	return &schemaSet{
+		proto:    proto,
+		fsys:     fsys,
+		compiled: make(map[string]*jsonschema.Schema),
+	}
+}
+
+// fileName returns the name of the schema file of the request or response
+// of action
+func (s *schemaSet) fileName(action string, response bool) string {
+	switch {
+	case response:
+		return action + "Response.json"
+	case s.proto == ocppV16:
+		return action + ".json"
+	}
+	return action + "Request.json"
+}
+
+func (s *schemaSet) compile(name string) (*jsonschema.Schema, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if schema, ok := s.compiled[name]; ok {
+		return schema, nil
+	}
+	data, err := fs.ReadFile(s.fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	c := jsonschema.NewCompiler()
+	// the OCPP schemas are self-contained, never fetch anything
+	c.LoadURL = func(u string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("ocpp: schema %s is not available", u)
+	}
+	url := "ocpp:" + s.proto + "/" + path.Base(name)
+	if err := c.AddResource(url, bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	schema, err := c.Compile(url)
+	if err != nil {
+		return nil, err
+	}
+	s.compiled[name] = schema
+	return schema, nil
+}
+
+// validate checks raw against the schema of the request or response of
+// action, payloads of actions without a schema are not checked
+func (s *schemaSet) validate(action string, response bool, raw json.RawMessage) error {
+	schema, err := s.compile(s.fileName(action, response))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return err
+	}
+	return schema.Validate(v)
+}
+
+// schemaError converts a jsonschema.ValidationError into an ocppError whose
+// cause and details name the failing JSON fields like payloadError does
+func schemaError(err error) *ocppError {
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return payloadError(err)
+	}
+	var fields []string
+	var code ErrorCode
+	for _, leaf := range schemaErrorLeaves(validationErr, nil) {
+		fields = append(fields, instancePath(leaf.InstanceLocation)+": "+leaf.Message)
+		if code == "" {
+			code = schemaErrorCode(leaf.KeywordLocation)
+		}
+	}
+	return &ocppError{
+		code:    code,
+		cause:   "Payload is not valid: " + strings.Join(fields, ", "),
+		details: map[string]interface{}{"errors": fields},
+	}
+}
+
+func schemaErrorLeaves(e *jsonschema.ValidationError, leaves []*jsonschema.ValidationError) []*jsonschema.ValidationError {
+	if len(e.Causes) == 0 {
+		return append(leaves, e)
+	}
+	for _, c := range e.Causes {
+		leaves = schemaErrorLeaves(c, leaves)
+	}
+	return leaves
+}
+
+// instancePath converts a JSON pointer like "/meterValue/0/sampledValue" to
+// "meterValue[0].sampledValue"
+func instancePath(pointer string) string {
+	if pointer == "" {
+		return "payload"
+	}
+	var b strings.Builder
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		if _, err := strconv.Atoi(token); err == nil {
+			b.WriteString("[" + token + "]")
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(token)
+	}
+	return b.String()
+}
+
+// schemaErrorCode maps the failing schema keyword to an error code the same
+// way validationErrorCode does for validator tags
+func schemaErrorCode(keywordLocation string) ErrorCode {
+	switch path.Base(keywordLocation) {
+	case "required", "minItems", "maxItems":
+		return OccurrenceConstraintViolation
+	case "type":
+		return TypeConstraintViolation
+	}
+	return PropertyConstraintViolation
+}
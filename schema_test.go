@@ -0,0 +1,168 @@
+package ocpp
+
+import (
+	"errors"
+	"io/fs"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/aliml92/ocpp/v16"
+)
+
+func TestEmbeddedSchemasCompile(t *testing.T) {
+	for _, proto := range []string{ocppV16, ocppV201, ocppV21} {
+		set := getSchemaSet(proto)
+		names, err := fs.Glob(set.fsys, "*.json")
+		if err != nil || len(names) == 0 {
+			t.Fatalf("%s: no schemas: %v", proto, err)
+		}
+		for _, name := range names {
+			if _, err := set.compile(name); err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	}
+}
+
+func TestSchemaError(t *testing.T) {
+	cases := []struct {
+		name     string
+		proto    string
+		action   string
+		response bool
+		payload  string
+		code     ErrorCode
+		fields   []string
+	}{
+		{
+			"valid",
+			ocppV16, "SetChargingProfile", false,
+			`{"connectorId":1,"csChargingProfiles":{"chargingProfileId":1,"stackLevel":0,"chargingProfilePurpose":"TxDefaultProfile","chargingProfileKind":"Absolute","chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16.5}]}}}`,
+			"", nil,
+		},
+		{
+			"startPeriod is an integer",
+			ocppV16, "SetChargingProfile", false,
+			`{"connectorId":1,"csChargingProfiles":{"chargingProfileId":1,"stackLevel":0,"chargingProfilePurpose":"TxDefaultProfile","chargingProfileKind":"Absolute","chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":"2022-01-01T00:00:00Z","limit":16}]}}}`,
+			TypeConstraintViolation,
+			[]string{"csChargingProfiles.chargingSchedule.chargingSchedulePeriod[0].startPeriod: expected integer, but got string"},
+		},
+		{
+			"missing required field",
+			ocppV16, "Authorize", false,
+			`{}`,
+			OccurrenceConstraintViolation,
+			[]string{"payload: missing properties: 'idTag'"},
+		},
+		{
+			"wrong response enum value",
+			ocppV16, "Authorize", true,
+			`{"idTagInfo":{"status":"Bogus"}}`,
+			PropertyConstraintViolation,
+			[]string{`idTagInfo.status: value must be one of "Accepted", "Blocked", "Expired", "Invalid", "ConcurrentTx"`},
+		},
+		{
+			"unknown field",
+			ocppV201, "Heartbeat", false,
+			`{"foo":1}`,
+			PropertyConstraintViolation,
+			[]string{"payload: additionalProperties 'foo' not allowed"},
+		},
+		{
+			"invalid date-time",
+			ocppV201, "Heartbeat", true,
+			`{"currentTime":"yesterday"}`,
+			PropertyConstraintViolation,
+			[]string{"currentTime: 'yesterday' is not valid 'date-time'"},
+		},
+		{
+			"too few array items",
+			ocppV201, "GetVariables", false,
+			`{"getVariableData":[]}`,
+			OccurrenceConstraintViolation,
+			[]string{"getVariableData: minimum 1 items required, but found 0 items"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := getSchemaSet(c.proto).validate(c.action, c.response, []byte(c.payload))
+			if c.code == "" {
+				if err != nil {
+					t.Fatalf("got %v want nil", err)
+				}
+				return
+			}
+			e := schemaError(err)
+			if e.code != c.code {
+				t.Errorf("got code %s want %s", e.code, c.code)
+			}
+			if got := e.details["errors"]; !reflect.DeepEqual(got, c.fields) {
+				t.Errorf("got fields %q want %q", got, c.fields)
+			}
+		})
+	}
+}
+
+func TestLoadSchemas(t *testing.T) {
+	defer func(set *schemaSet) {
+		schemaSetsMu.Lock()
+		schemaSets[ocppV16] = set
+		schemaSetsMu.Unlock()
+	}(getSchemaSet(ocppV16))
+
+	if err := LoadSchemas("ocpp1.5", fstest.MapFS{}); err == nil {
+		t.Error("unknown subprotocol: got nil error")
+	}
+	broken := fstest.MapFS{"Heartbeat.json": {Data: []byte(`{"type": 1}`)}}
+	if err := LoadSchemas(ocppV16, broken); err == nil {
+		t.Error("broken schema: got nil error")
+	}
+	strict := fstest.MapFS{"Heartbeat.json": {Data: []byte(`{
+		"$schema": "http://json-schema.org/draft-04/schema#",
+		"type": "object",
+		"required": ["foo"]
+	}`)}}
+	if err := LoadSchemas(ocppV16, strict); err != nil {
+		t.Fatal(err)
+	}
+	set := getSchemaSet(ocppV16)
+	if err := set.validate("Heartbeat", false, []byte(`{}`)); err == nil {
+		t.Error("loaded schema is not used")
+	}
+	if err := set.validate("Authorize", false, []byte(`{}`)); err != nil {
+		t.Errorf("action without schema: got %v", err)
+	}
+}
+
+func TestSchemaValidationBackend(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+	csms.SetValidationBackend(ValidationSchema)
+	csms.On("Heartbeat", func(cp *ChargePoint, p Payload) Payload {
+		return &v16.HeartbeatConf{CurrentTime: "yesterday"}
+	})
+	csms.On("Authorize", func(cp *ChargePoint, p Payload) Payload {
+		return &v16.AuthorizeConf{IdTagInfo: v16.IdTagInfo{Status: "Accepted"}}
+	})
+	c := NewClient()
+	c.SetID("cp1")
+	c.AddSubProtocol(ocppV16)
+	c.SetCallQueueSize(8)
+	c.SetValidationMode("Authorize", Outgoing, ValidationOff)
+	cp, err := c.Start("ws"+strings.TrimPrefix(ts.URL, "http"), "/ws")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cp.Shutdown()
+
+	_, err = cp.Call("Authorize", &v16.AuthorizeReq{IdTag: "0123456789012345678901"})
+	var callErr *CallError
+	if !errors.As(err, &callErr) || callErr.ErrorCode != PropertyConstraintViolation {
+		t.Errorf("incoming: got %v want PropertyConstraintViolation", err)
+	}
+	_, err = cp.Call("Heartbeat", &v16.HeartbeatReq{})
+	if !errors.As(err, &callErr) || callErr.ErrorCode != InternalError {
+		t.Errorf("outgoing: got %v want InternalError", err)
+	}
+}
@@ -1,8 +1,9 @@
 package ocpp
 
 import (
+	"context"
+	"crypto/tls"
 	"errors"
-	"fmt"
 	"net/http"
 	"strings"
 	"sync"
@@ -11,8 +12,6 @@ import (
 	"github.com/gorilla/websocket"
 )
 
-var server *Server
-
 type ServerTimeoutConfig struct {
 	// ocpp response timeout in seconds
 	OcppWait time.Duration
@@ -24,13 +23,39 @@ type ServerTimeoutConfig struct {
 	PingWait time.Duration
 }
 
+var ErrUpgradeRejected = errors.New("connection rejected by pre-upgrade handler")
+var ErrDuplicateChargePoint = errors.New("charge point with the same id is already connected")
+
+// DuplicatePolicy defines what happens when a ChargePoint connects
+// while another connection with the same id is open
+type DuplicatePolicy int
+
+const (
+	// DuplicateCloseOld closes the existing connection and keeps the new one
+	DuplicateCloseOld DuplicatePolicy = iota
+
+	// DuplicateRejectNew refuses the new connection
+	DuplicateRejectNew
+
+	// DuplicateAllowBoth keeps both connections open, Load returns the newest
+	// one still open
+	DuplicateAllowBoth
+)
+
 // Server type representes csms server
 type Server struct {
 	// keeps track of all connected ChargePoints
 	chargepoints map[string]*ChargePoint
 
+	// conns keeps every open connection, including the ones
+	// replaced in chargepoints by a duplicate, with the order it was stored in
+	conns   map[*ChargePoint]uint64
+	connSeq uint64
+
+	duplicatePolicy DuplicatePolicy
+
 	// register implemented action handler functions
-	actionHandlers map[string]func(*ChargePoint, Payload) Payload
+	actionHandlers map[string]func(context.Context, *ChargePoint, Payload) Payload
 
 	// register after-action habdler functions
 	afterHandlers map[string]func(*ChargePoint, Payload)
@@ -48,39 +73,116 @@ type Server struct {
 
 	preUpgradeHandler func(w http.ResponseWriter, r *http.Request) bool
 
-	returnError func(err error)
+	// connection lifecycle hooks
+	onConnect    func(*ChargePoint)
+	onDisconnect func(*ChargePoint, error)
+	onReject     func(*http.Request, error)
 
 	callQuequeSize int
+
+	// middleware and outbound interceptors
+	interceptors interceptors
+
+	// validation modes set by SetValidationMode
+	validationModes validationModes
+	// validationBackend set by SetValidationBackend
+	validationBackend ValidationBackend
+
+	// handlerTimeouts maps actions to handler deadlines, "" is the default
+	handlerTimeouts map[string]time.Duration
+
+	// handlers run on workers, it is started with the first incoming Call
+	workers        *workerPool
+	workerPoolSize int
+	unordered      bool
+
+	// httpServer is the listener created by Start
+	httpServer *http.Server
+
+	// shuttingDown is set by Shutdown, new upgrades are refused afterwards
+	shuttingDown bool
+
+	// tlsConfig is used by StartTLS
+	tlsConfig *tls.Config
+
+	// clock set by SetClock
+	clock Clock
 }
 
 // create new CSMS instance acting as main handler for ChargePoints
 func NewServer() *Server {
-	server = &Server{
+	return &Server{
 		chargepoints:   make(map[string]*ChargePoint),
-		actionHandlers: make(map[string]func(*ChargePoint, Payload) Payload),
+		conns:          make(map[*ChargePoint]uint64),
+		actionHandlers: make(map[string]func(context.Context, *ChargePoint, Payload) Payload),
 		afterHandlers:  make(map[string]func(*ChargePoint, Payload)),
 		ocppWait:       ocppWait,
 		writeWait:      writeWait,
 		pingWait:       pingWait,
+		workerPoolSize: defaultWorkerPoolSize,
 		upgrader: websocket.Upgrader{
 			Subprotocols: []string{},
 		},
 	}
-	return server
 }
 
 func (s *Server) SetTimeoutConfig(config ServerTimeoutConfig) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.ocppWait = config.OcppWait
 	s.writeWait = config.WriteWait
 	s.pingWait = config.PingWait
 }
 
-// register action handler function
+// register action handler function, the handler may return a *CallError
+// created by NewCallError instead of a response payload
 func (s *Server) On(action string, f func(*ChargePoint, Payload) Payload) *Server {
+	s.actionHandlers[action] = func(_ context.Context, cp *ChargePoint, p Payload) Payload {
+		return f(cp, p)
+	}
+	return s
+}
+
+// register action handler function that receives a context, it is
+// cancelled when the handler timeout set by SetHandlerTimeout expires
+func (s *Server) OnContext(action string, f func(context.Context, *ChargePoint, Payload) Payload) *Server {
 	s.actionHandlers[action] = f
 	return s
 }
 
+// Use adds middleware around the dispatch of incoming Calls, the first
+// middleware added is the outermost
+func (s *Server) Use(mw ...Middleware) *Server {
+	s.mu.Lock()
+	s.interceptors.middleware = append(s.interceptors.middleware, mw...)
+	s.mu.Unlock()
+	return s
+}
+
+// UseCall adds interceptors around outgoing Calls, the first
+// interceptor added is the outermost
+func (s *Server) UseCall(ic ...CallInterceptor) *Server {
+	s.mu.Lock()
+	s.interceptors.calls = append(s.interceptors.calls, ic...)
+	s.mu.Unlock()
+	return s
+}
+
+// UseFrame adds interceptors of raw outgoing Call, CallResult and CallError
+// frames, they run in the order they were added
+func (s *Server) UseFrame(ic ...FrameInterceptor) *Server {
+	s.mu.Lock()
+	s.interceptors.frames = append(s.interceptors.frames, ic...)
+	s.mu.Unlock()
+	return s
+}
+
+func (s *Server) getInterceptors() interceptors {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.interceptors
+}
+
 // register after-action handler function
 func (s *Server) After(action string, f func(*ChargePoint, Payload)) *Server {
 	s.afterHandlers[action] = f
@@ -88,13 +190,16 @@ func (s *Server) After(action string, f func(*ChargePoint, Payload)) *Server {
 }
 
 func (s *Server) IsConnected(id string) bool {
-	if cp, ok := s.chargepoints[id]; ok {
-		return cp.connected
+	s.mu.Lock()
+	cp, ok := s.chargepoints[id]
+	s.mu.Unlock()
+	if ok {
+		return cp.IsConnected()
 	}
 	return false
 }
 
-func (s *Server) getHandler(action string) func(*ChargePoint, Payload) Payload {
+func (s *Server) getHandler(action string) func(context.Context, *ChargePoint, Payload) Payload {
 	return s.actionHandlers[action]
 }
 
@@ -102,33 +207,99 @@ func (s *Server) getAfterHandler(action string) func(*ChargePoint, Payload) {
 	return s.afterHandlers[action]
 }
 
+func (s *Server) setHandler(action string, f func(context.Context, *ChargePoint, Payload) Payload) {
+	s.actionHandlers[action] = f
+}
+
 func (s *Server) Delete(id string) {
 	s.mu.Lock()
 	if cp, ok := s.chargepoints[id]; ok {
+		cp.mu.Lock()
 		cp.connected = false
+		cp.mu.Unlock()
+		delete(s.conns, cp)
 	}
 	delete(s.chargepoints, id)
 	s.mu.Unlock()
 }
 
+// remove deletes cp only if it is the exact instance stored,
+// so a closing duplicate never removes the live connection. If another
+// connection with the same id is still open, the newest one replaces cp
+func (s *Server) remove(cp *ChargePoint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cp.mu.Lock()
+	cp.connected = false
+	cp.mu.Unlock()
+	delete(s.conns, cp)
+	if s.chargepoints[cp.Id] != cp {
+		return
+	}
+	delete(s.chargepoints, cp.Id)
+	var newest uint64
+	for other, seq := range s.conns {
+		if other.Id == cp.Id && seq > newest && other.IsConnected() {
+			s.chargepoints[cp.Id] = other
+			newest = seq
+		}
+	}
+}
+
+// addConn records cp as an open connection, s.mu must be held
+func (s *Server) addConn(cp *ChargePoint) {
+	s.connSeq++
+	s.conns[cp] = s.connSeq
+}
+
 func (s *Server) Store(cp *ChargePoint) {
 	s.mu.Lock()
-	server.chargepoints[cp.Id] = cp
+	s.chargepoints[cp.Id] = cp
+	s.addConn(cp)
 	s.mu.Unlock()
 }
 
+// storeNew stores a newly connected ChargePoint according to the duplicate
+// policy. It returns the replaced connection that must be closed, or an
+// error if cp was not stored
+func (s *Server) storeNew(cp *ChargePoint) (*ChargePoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old, ok := s.chargepoints[cp.Id]
+	if ok && !old.IsConnected() {
+		old, ok = nil, false
+	}
+	if ok && s.duplicatePolicy == DuplicateRejectNew {
+		return nil, ErrDuplicateChargePoint
+	}
+	s.chargepoints[cp.Id] = cp
+	s.addConn(cp)
+	if ok && s.duplicatePolicy == DuplicateCloseOld {
+		return old, nil
+	}
+	return nil, nil
+}
+
 func (s *Server) Load(id string) (*ChargePoint, bool) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if cp, ok := s.chargepoints[id]; ok {
-		fmt.Printf("ChargePoint with id: %s exist\n", cp.Id)
+		log.Debugf("ChargePoint with id: %s exist", cp.Id)
 		return cp, true
 	}
 	return nil, false
 }
 
+// SetDuplicatePolicy sets how a connection with the id of an already
+// connected ChargePoint is handled, DuplicateCloseOld by default
+func (s *Server) SetDuplicatePolicy(policy DuplicatePolicy) {
+	s.mu.Lock()
+	s.duplicatePolicy = policy
+	s.mu.Unlock()
+}
+
 func (s *Server) AddSubProtocol(protocol string) {
-	for _, p := range server.upgrader.Subprotocols {
+	for _, p := range s.upgrader.Subprotocols {
 		if p == protocol {
 			return
 		}
@@ -144,43 +315,291 @@ func (s *Server) SetPreUpgradeHandler(f func(w http.ResponseWriter, r *http.Requ
 	s.preUpgradeHandler = f
 }
 
-// TODO: add more functionality
-func (s *Server) Start(addr string, path string, handler func(http.ResponseWriter, *http.Request)) {
+// Start listens on addr and serves websocket upgrades on path. If handler is
+// nil the Server itself is used as the handler. Start blocks until the
+// listener fails or Close is called; in the latter case http.ErrServerClosed
+// is returned
+func (s *Server) Start(addr string, path string, handler func(http.ResponseWriter, *http.Request)) error {
+	return s.newHTTPServer(addr, path, handler).ListenAndServe()
+}
+
+// StartTLS is like Start but serves wss:// connections using the certificate
+// and key files and the config set with SetTLSConfig. The files may be empty
+// if the config already contains certificates
+func (s *Server) StartTLS(addr, path, certFile, keyFile string, handler func(http.ResponseWriter, *http.Request)) error {
+	return s.newHTTPServer(addr, path, handler).ListenAndServeTLS(certFile, keyFile)
+}
+
+// SetTLSConfig sets the TLS configuration used by StartTLS. To require client
+// certificates (security profile 3) set ClientAuth to tls.RequireAndVerifyClientCert
+// and ClientCAs to the pool of trusted CAs
+func (s *Server) SetTLSConfig(config *tls.Config) {
+	s.mu.Lock()
+	s.tlsConfig = config
+	s.mu.Unlock()
+}
+
+func (s *Server) newHTTPServer(addr, path string, handler func(http.ResponseWriter, *http.Request)) *http.Server {
+	mux := http.NewServeMux()
 	if handler != nil {
-		http.HandleFunc(path, handler)
+		mux.HandleFunc(path, handler)
 	} else {
-		http.HandleFunc(path, defaultWebsocketHandler)
+		mux.Handle(path, s)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	hs := &http.Server{
+		Addr:      addr,
+		Handler:   mux,
+		TLSConfig: s.tlsConfig,
+	}
+	s.httpServer = hs
+	return hs
+}
+
+// Close immediately closes the listener created by Start
+func (s *Server) Close() error {
+	s.mu.Lock()
+	hs := s.httpServer
+	s.mu.Unlock()
+	if hs == nil {
+		return nil
+	}
+	return hs.Close()
+}
+
+// ServeHTTP upgrades the request to a websocket connection, so Server can be
+// mounted on any router, e.g. mux.Handle("/ws/", csms).
+// The last path segment is used as the ChargePoint id
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	shuttingDown := s.shuttingDown
+	s.mu.Unlock()
+	if shuttingDown {
+		http.Error(w, "server is shutting down", http.StatusServiceUnavailable)
+		return
+	}
+	s.defaultWebsocketHandler(w, r)
+}
+
+// Shutdown gracefully stops the Server. It stops accepting new websocket
+// upgrades, waits for in-flight Calls and handlers of every stored ChargePoint
+// to finish, sends a going-away close frame to each of them and waits for
+// the connections to be closed. If ctx expires first, the remaining
+// connections are closed forcibly and ctx.Err() is returned
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	s.shuttingDown = true
+	hs := s.httpServer
+	workers := s.workers
+	cps := make([]*ChargePoint, 0, len(s.conns))
+	for cp := range s.conns {
+		cps = append(cps, cp)
+	}
+	s.mu.Unlock()
+	if workers != nil {
+		// queued handlers still run, later Calls are rejected
+		defer workers.close()
+	}
+
+	if hs != nil {
+		if err := hs.Shutdown(ctx); err != nil {
+			s.closeAll(cps)
+			return err
+		}
 	}
-	http.ListenAndServe(addr, nil)
+	for _, cp := range cps {
+		if err := cp.drain(ctx); err != nil {
+			s.closeAll(cps)
+			return err
+		}
+	}
+	for _, cp := range cps {
+		cp.close(websocket.CloseGoingAway, "server shutting down")
+	}
+	for _, cp := range cps {
+		select {
+		case <-cp.readerDone:
+		case <-ctx.Done():
+			s.closeAll(cps)
+			return ctx.Err()
+		}
+	}
+	return nil
 }
 
-func defaultWebsocketHandler(w http.ResponseWriter, r *http.Request) {
-	preCheck := server.preUpgradeHandler
+// closeAll closes the underlying connections without a closing handshake
+func (s *Server) closeAll(cps []*ChargePoint) {
+	for _, cp := range cps {
+		_ = cp.conn.Close()
+	}
+}
+
+func (s *Server) defaultWebsocketHandler(w http.ResponseWriter, r *http.Request) {
+	preCheck := s.preUpgradeHandler
 	if preCheck != nil {
 		if preCheck(w, r) {
-			upgrade(w, r)
+			s.upgrade(w, r)
 		} else {
-			server.returnError(errors.New("cannot start server"))
+			s.rejected(r, ErrUpgradeRejected)
 		}
 	} else {
-		upgrade(w, r)
+		s.upgrade(w, r)
 	}
 }
 
-func upgrade(w http.ResponseWriter, r *http.Request) {
-	c, err := server.upgrader.Upgrade(w, r, nil)
+func (s *Server) upgrade(w http.ResponseWriter, r *http.Request) {
+	p := strings.Split(r.URL.Path, "/")
+	id := p[len(p)-1]
+	s.mu.Lock()
+	rejectNew := s.duplicatePolicy == DuplicateRejectNew
+	s.mu.Unlock()
+	if rejectNew && s.IsConnected(id) {
+		http.Error(w, ErrDuplicateChargePoint.Error(), http.StatusConflict)
+		s.rejected(r, ErrDuplicateChargePoint)
+		return
+	}
+	c, err := s.upgrader.Upgrade(w, r, nil)
 	if err != nil {
-		server.returnError(err)
+		s.rejected(r, err)
 		return
 	}
-	p := strings.Split(r.URL.Path, "/")
-	id := p[len(p)-1]
-	cp := NewChargePoint(c, id, c.Subprotocol(), true)
-	server.Store(cp)
+	cp := newChargePoint(c, id, c.Subprotocol(), s)
+	old, err := s.storeNew(cp)
+	if err != nil {
+		// cp was never accepted, close it without running the disconnect hook
+		b := websocket.FormatCloseMessage(websocket.ClosePolicyViolation, err.Error())
+		_ = c.WriteControl(websocket.CloseMessage, b, time.Now().Add(time.Second))
+		_ = c.Close()
+		s.rejected(r, err)
+		return
+	}
+	if old != nil {
+		log.Debugf("closing previous connection of %s", id)
+		old.close(websocket.ClosePolicyViolation, "replaced by a new connection")
+	}
+	s.mu.Lock()
+	shuttingDown := s.shuttingDown
+	onConnect := s.onConnect
+	s.mu.Unlock()
+	if shuttingDown {
+		// Shutdown started while upgrading and may have missed this ChargePoint
+		cp.close(websocket.CloseGoingAway, "server shutting down")
+	}
+	if onConnect != nil {
+		onConnect(cp)
+	}
+	// messages are read only once cp can be loaded and OnConnect has returned
+	cp.start()
+}
+
+// OnConnect registers a function called when a ChargePoint has connected,
+// before any of its messages are read. f must not wait for the result of
+// a Call, which is read only after f returns
+func (s *Server) OnConnect(f func(*ChargePoint)) *Server {
+	s.mu.Lock()
+	s.onConnect = f
+	s.mu.Unlock()
+	return s
+}
+
+// OnDisconnect registers a function called when the connection of a ChargePoint
+// is closed. err is the error that ended the connection, a *websocket.CloseError
+// carries the close code and reason sent by the charge point
+func (s *Server) OnDisconnect(f func(cp *ChargePoint, err error)) *Server {
+	s.mu.Lock()
+	s.onDisconnect = f
+	s.mu.Unlock()
+	return s
+}
+
+// OnReject registers a function called when a connection attempt fails,
+// err is ErrUpgradeRejected if the pre-upgrade handler refused it
+func (s *Server) OnReject(f func(r *http.Request, err error)) *Server {
+	s.mu.Lock()
+	s.onReject = f
+	s.mu.Unlock()
+	return s
+}
+
+func (s *Server) disconnected(cp *ChargePoint, err error) {
+	s.mu.Lock()
+	onDisconnect := s.onDisconnect
+	s.mu.Unlock()
+	if onDisconnect != nil {
+		onDisconnect(cp, err)
+	}
+}
+
+func (s *Server) rejected(r *http.Request, err error) {
+	log.Debugf("connection rejected: %v", err)
+	s.mu.Lock()
+	onReject := s.onReject
+	s.mu.Unlock()
+	if onReject != nil {
+		onReject(r, err)
+	}
+}
+
+// SetHandlerTimeout limits how long the handler of action may run, an empty
+// action sets the limit of all actions without their own. When it expires the
+// handler's context is cancelled and an InternalError CallError is sent.
+// A zero timeout means no limit
+func (s *Server) SetHandlerTimeout(action string, timeout time.Duration) {
+	s.mu.Lock()
+	if s.handlerTimeouts == nil {
+		s.handlerTimeouts = make(map[string]time.Duration)
+	}
+	s.handlerTimeouts[action] = timeout
+	s.mu.Unlock()
+}
+
+func (s *Server) getHandlerTimeout(action string) time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if timeout, ok := s.handlerTimeouts[action]; ok {
+		return timeout
+	}
+	return s.handlerTimeouts[""]
+}
+
+// SetValidationMode sets how payloads of action sent or received in dir are
+// validated, an empty action sets the mode of all actions without their own.
+// Invalid incoming Calls are answered with a CallError and our own invalid
+// responses are replaced by an InternalError CallError in strict mode
+func (s *Server) SetValidationMode(action string, dir Direction, mode ValidationMode) {
+	s.mu.Lock()
+	if s.validationModes == nil {
+		s.validationModes = make(validationModes)
+	}
+	s.validationModes.set(action, dir, mode)
+	s.mu.Unlock()
+}
+
+func (s *Server) getValidationMode(action string, dir Direction) ValidationMode {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.validationModes.get(action, dir)
+}
+
+// SetValidationBackend sets what payloads are validated against, either the
+// struct tags of the v16 and v201 types (the default) or the OCPP JSON schemas
+func (s *Server) SetValidationBackend(b ValidationBackend) {
+	s.mu.Lock()
+	s.validationBackend = b
+	s.mu.Unlock()
+}
+
+func (s *Server) getValidationBackend() ValidationBackend {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.validationBackend
 }
 
 func (s *Server) SetCallQueueSize(size int) {
+	s.mu.Lock()
 	s.callQuequeSize = size
+	s.mu.Unlock()
 }
 
 func (s *Server) getCallQueueSize() int {
@@ -189,3 +608,50 @@ func (s *Server) getCallQueueSize() int {
 	s.mu.Unlock()
 	return size
 }
+
+// SetWorkerPoolSize sets the number of goroutines running action handlers,
+// shared by all charge points. With size 0 handlers run on the reader
+// goroutine of each charge point. A Call is answered with an InternalError
+// CallError when 64 handlers per goroutine, or with strict ordering 64 Calls
+// of its charge point, are already queued. It must be called before the
+// first Call is received
+func (s *Server) SetWorkerPoolSize(size int) {
+	s.mu.Lock()
+	s.workerPoolSize = size
+	s.mu.Unlock()
+}
+
+// SetStrictOrdering controls whether Calls of the same charge point are
+// handled one at a time in the order they arrived, which is the default.
+// Disabling it lets handlers of a single charge point run concurrently
+func (s *Server) SetStrictOrdering(strict bool) {
+	s.mu.Lock()
+	s.unordered = !strict
+	s.mu.Unlock()
+}
+
+func (s *Server) getWorkerPool() (*workerPool, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.workers == nil && s.workerPoolSize > 0 && !s.shuttingDown {
+		s.workers = newWorkerPool(s.workerPoolSize)
+	}
+	return s.workers, !s.unordered
+}
+
+// SetClock replaces the clock used for Call timeouts of the charge points
+// connected afterwards
+func (s *Server) SetClock(clock Clock) {
+	s.mu.Lock()
+	s.clock = clock
+	s.mu.Unlock()
+}
+
+func (s *Server) getClock() Clock {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.clock == nil {
+		return RealClock{}
+	}
+	return s.clock
+}
@@ -0,0 +1,720 @@
+package ocpp
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aliml92/ocpp/v16"
+	"github.com/aliml92/ocpp/v21"
+	"github.com/gorilla/websocket"
+)
+
+func newTestServer(t *testing.T, interval int) (*Server, *httptest.Server) {
+	t.Helper()
+	csms := NewServer()
+	csms.AddSubProtocol(ocppV16)
+	csms.SetCallQueueSize(8)
+	csms.On("BootNotification", func(cp *ChargePoint, p Payload) Payload {
+		return &v16.BootNotificationConf{
+			CurrentTime: time.Now().UTC().Format("2006-01-02T15:04:05.000Z"),
+			Interval:    &interval,
+			Status:      "Accepted",
+		}
+	})
+	return csms, newHTTPTestServer(t, csms)
+}
+
+func newHTTPTestServer(t *testing.T, csms *Server) *httptest.Server {
+	t.Helper()
+	ts := httptest.NewServer(csms)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func newTestClient(t *testing.T, id string, url string) *ChargePoint {
+	t.Helper()
+	c := NewClient()
+	c.SetID(id)
+	c.AddSubProtocol(ocppV16)
+	c.SetCallQueueSize(8)
+	cp, err := c.Start("ws"+strings.TrimPrefix(url, "http"), "/ws")
+	if err != nil {
+		t.Fatalf("client %s: %v", id, err)
+	}
+	t.Cleanup(cp.Shutdown)
+	return cp
+}
+
+// onConnect returns a channel receiving every ChargePoint connecting to csms
+func onConnect(csms *Server) chan *ChargePoint {
+	ch := make(chan *ChargePoint, 4)
+	csms.OnConnect(func(cp *ChargePoint) {
+		ch <- cp
+	})
+	return ch
+}
+
+func waitConnect(t *testing.T, connected chan *ChargePoint) *ChargePoint {
+	t.Helper()
+	select {
+	case cp := <-connected:
+		return cp
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnConnect was not called")
+		return nil
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func bootNotification(t *testing.T, cp *ChargePoint) *v16.BootNotificationConf {
+	t.Helper()
+	res, err := cp.Call("BootNotification", &v16.BootNotificationReq{
+		ChargePointModel:  "model",
+		ChargePointVendor: "vendor",
+	})
+	if err != nil {
+		t.Fatalf("BootNotification: %v", err)
+	}
+	return res.(*v16.BootNotificationConf)
+}
+
+func TestMultipleServers(t *testing.T) {
+	csms1, ts1 := newTestServer(t, 10)
+	csms2, ts2 := newTestServer(t, 20)
+
+	cp1 := newTestClient(t, "cp1", ts1.URL)
+	cp2 := newTestClient(t, "cp2", ts2.URL)
+
+	if got := *bootNotification(t, cp1).Interval; got != 10 {
+		t.Errorf("cp1 got interval %d want 10", got)
+	}
+	if got := *bootNotification(t, cp2).Interval; got != 20 {
+		t.Errorf("cp2 got interval %d want 20", got)
+	}
+	if _, ok := csms1.Load("cp2"); ok {
+		t.Error("cp2 must not be stored on csms1")
+	}
+	if _, ok := csms2.Load("cp1"); ok {
+		t.Error("cp1 must not be stored on csms2")
+	}
+	if cp, ok := csms1.Load("cp1"); !ok || cp.Peer() != csms1 {
+		t.Error("cp1 must be stored on and owned by csms1")
+	}
+}
+
+func TestServerShutdown(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+	csms.On("Authorize", func(cp *ChargePoint, p Payload) Payload {
+		time.Sleep(300 * time.Millisecond)
+		return &v16.AuthorizeConf{IdTagInfo: v16.IdTagInfo{Status: "Accepted"}}
+	})
+	cp := newTestClient(t, "cp1", ts.URL)
+	bootNotification(t, cp)
+
+	errC := make(chan error, 1)
+	go func() {
+		_, err := cp.Call("Authorize", &v16.AuthorizeReq{IdTag: "tag"})
+		errC <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := csms.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+	if err := <-errC; err != nil {
+		t.Errorf("in-flight Authorize failed: %v", err)
+	}
+	if csms.IsConnected("cp1") {
+		t.Error("cp1 is still connected to the server")
+	}
+	<-cp.readerDone
+	if cp.IsConnected() {
+		t.Error("client side charge point is still connected")
+	}
+
+	_, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+"/ws/cp2", nil)
+	if err == nil {
+		t.Error("upgrade must be refused after Shutdown")
+	}
+}
+
+// newTestCert creates a certificate for cn signed by parent, or a self-signed
+// CA certificate if parent is nil
+func newTestCert(t *testing.T, cn string, parent *tls.Certificate) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	signer, signerKey := tmpl, interface{}(key)
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+	} else {
+		signer, signerKey = parent.Leaf, parent.PrivateKey
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+func TestMutualTLS(t *testing.T) {
+	ca := newTestCert(t, "ca", nil)
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.Leaf)
+
+	csms, _ := newTestServer(t, 10)
+	peerCN := make(chan string, 1)
+	csms.After("BootNotification", func(cp *ChargePoint, p Payload) {
+		peerCN <- cp.PeerCertificate().Subject.CommonName
+	})
+	ts := httptest.NewUnstartedServer(csms)
+	ts.TLS = &tls.Config{
+		Certificates: []tls.Certificate{newTestCert(t, "csms", &ca)},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pool,
+	}
+	ts.StartTLS()
+	defer ts.Close()
+	url := "wss" + strings.TrimPrefix(ts.URL, "https")
+
+	c := NewClient()
+	c.SetID("cp1")
+	c.AddSubProtocol(ocppV16)
+	c.SetCallQueueSize(8)
+	c.SetTLSConfig(&tls.Config{RootCAs: pool})
+	if _, err := c.Start(url, "/ws"); err == nil {
+		t.Fatal("connection without client certificate must fail")
+	}
+
+	c.SetTLSConfig(&tls.Config{
+		RootCAs:      pool,
+		Certificates: []tls.Certificate{newTestCert(t, "cp1", &ca)},
+	})
+	cp, err := c.Start(url, "/ws")
+	if err != nil {
+		t.Fatalf("client with certificate: %v", err)
+	}
+	defer cp.Shutdown()
+	if got := cp.PeerCertificate().Subject.CommonName; got != "csms" {
+		t.Errorf("got server certificate %q want csms", got)
+	}
+	bootNotification(t, cp)
+	if got := <-peerCN; got != "cp1" {
+		t.Errorf("got client certificate %q want cp1", got)
+	}
+}
+
+func TestServerLifecycleHooks(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+	connected := make(chan string, 1)
+	disconnected := make(chan error, 1)
+	rejected := make(chan error, 1)
+	csms.OnConnect(func(cp *ChargePoint) {
+		connected <- cp.Id
+	})
+	csms.OnDisconnect(func(cp *ChargePoint, err error) {
+		disconnected <- err
+	})
+	csms.OnReject(func(r *http.Request, err error) {
+		rejected <- err
+	})
+	csms.SetPreUpgradeHandler(func(w http.ResponseWriter, r *http.Request) bool {
+		if strings.HasSuffix(r.URL.Path, "/blocked") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return false
+		}
+		return true
+	})
+
+	cp := newTestClient(t, "cp1", ts.URL)
+	if id := <-connected; id != "cp1" {
+		t.Errorf("got connected id %s want cp1", id)
+	}
+	cp.Shutdown()
+	select {
+	case err := <-disconnected:
+		var closeErr *websocket.CloseError
+		if !errors.As(err, &closeErr) || closeErr.Code != websocket.CloseNormalClosure {
+			t.Errorf("got %v want normal closure", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnDisconnect was not called")
+	}
+
+	c := NewClient()
+	c.SetID("blocked")
+	if _, err := c.Start("ws"+strings.TrimPrefix(ts.URL, "http"), "/ws"); err == nil {
+		t.Fatal("connection must be rejected")
+	}
+	if err := <-rejected; !errors.Is(err, ErrUpgradeRejected) {
+		t.Errorf("got %v want ErrUpgradeRejected", err)
+	}
+}
+
+func TestConnectBeforeMessages(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+	var mu sync.Mutex
+	connected := false
+	csms.OnConnect(func(cp *ChargePoint) {
+		time.Sleep(100 * time.Millisecond)
+		mu.Lock()
+		connected = true
+		mu.Unlock()
+	})
+	csms.On("Heartbeat", func(cp *ChargePoint, p Payload) Payload {
+		mu.Lock()
+		defer mu.Unlock()
+		if !connected {
+			t.Error("message handled before OnConnect returned")
+		}
+		if _, ok := csms.Load(cp.Id); !ok {
+			t.Error("message handled before the ChargePoint was stored")
+		}
+		return &v16.HeartbeatConf{CurrentTime: time.Now().UTC().Format(time.RFC3339)}
+	})
+	cp := newTestClient(t, "cp1", ts.URL)
+	if _, err := cp.Call("Heartbeat", &v16.HeartbeatReq{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDuplicateCloseOld(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+	disconnected := make(chan *ChargePoint, 2)
+	csms.OnDisconnect(func(cp *ChargePoint, err error) {
+		disconnected <- cp
+	})
+	connected := onConnect(csms)
+	newTestClient(t, "cp1", ts.URL)
+	old := waitConnect(t, connected)
+	cp := newTestClient(t, "cp1", ts.URL)
+	current := waitConnect(t, connected)
+
+	select {
+	case got := <-disconnected:
+		if got != old {
+			t.Error("the new connection was closed")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("old connection was not closed")
+	}
+	if got, ok := csms.Load("cp1"); !ok || got != current {
+		t.Error("closing the old connection removed the new one")
+	}
+	bootNotification(t, cp)
+}
+
+func TestDuplicateAllowBoth(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+	csms.SetDuplicatePolicy(DuplicateAllowBoth)
+	disconnected := make(chan *ChargePoint, 2)
+	csms.OnDisconnect(func(cp *ChargePoint, err error) {
+		disconnected <- cp
+	})
+	connected := onConnect(csms)
+	first := newTestClient(t, "cp1", ts.URL)
+	old := waitConnect(t, connected)
+	newTestClient(t, "cp1", ts.URL).Shutdown()
+	newest := waitConnect(t, connected)
+
+	select {
+	case got := <-disconnected:
+		if got != newest {
+			t.Error("the old connection was closed")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("newest connection was not closed")
+	}
+	if got, ok := csms.Load("cp1"); !ok || got != old {
+		t.Error("closing the newest connection did not fall back to the old one")
+	}
+	bootNotification(t, first)
+}
+
+func TestDuplicateRejectNew(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+	csms.SetDuplicatePolicy(DuplicateRejectNew)
+	cp := newTestClient(t, "cp1", ts.URL)
+
+	c := NewClient()
+	c.SetID("cp1")
+	c.AddSubProtocol(ocppV16)
+	if _, err := c.Start("ws"+strings.TrimPrefix(ts.URL, "http"), "/ws"); err == nil {
+		t.Fatal("duplicate connection must be rejected")
+	}
+	bootNotification(t, cp)
+}
+
+func TestCallFromHandler(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+	csms.On("Authorize", func(cp *ChargePoint, p Payload) Payload {
+		// the response is read while this handler is still running
+		_, err := cp.Call("GetConfiguration", &v16.GetConfigurationReq{})
+		status := v16.AuthorizationStatusAccepted
+		if err != nil {
+			t.Errorf("GetConfiguration: %v", err)
+			status = v16.AuthorizationStatusInvalid
+		}
+		return &v16.AuthorizeConf{IdTagInfo: v16.IdTagInfo{Status: status}}
+	})
+	c := NewClient()
+	c.SetID("cp1")
+	c.AddSubProtocol(ocppV16)
+	c.SetCallQueueSize(8)
+	c.On("GetConfiguration", func(cp *ChargePoint, p Payload) Payload {
+		return &v16.GetConfigurationConf{}
+	})
+	cp, err := c.Start("ws"+strings.TrimPrefix(ts.URL, "http"), "/ws")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cp.Shutdown()
+	res, err := cp.Call("Authorize", &v16.AuthorizeReq{IdTag: "tag"})
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+	if got := res.(*v16.AuthorizeConf).IdTagInfo.Status; got != "Accepted" {
+		t.Errorf("got status %s want Accepted", got)
+	}
+}
+
+func TestStrictOrdering(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+	handled := make(chan string, 3)
+	csms.On("DataTransfer", func(cp *ChargePoint, p Payload) Payload {
+		req := p.(*v16.DataTransferReq)
+		// earlier Calls take longer
+		d, _ := time.ParseDuration(req.Data)
+		time.Sleep(d)
+		handled <- req.MessageId
+		return &v16.DataTransferConf{Status: "Accepted"}
+	})
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+"/ws/cp1",
+		http.Header{"Sec-WebSocket-Protocol": {ocppV16}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	for i, d := range []string{"200ms", "100ms", "0s"} {
+		msg := fmt.Sprintf(`[2,"%d","DataTransfer",{"vendorId":"v","messageId":"%d","data":"%s"}]`, i, i, d)
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		select {
+		case id := <-handled:
+			if want := fmt.Sprint(i); id != want {
+				t.Errorf("got Call %s handled at position %d", id, i)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Call was not handled")
+		}
+	}
+}
+
+func TestHandlerPanic(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+	csms.On("Authorize", func(cp *ChargePoint, p Payload) Payload {
+		_ = p.(*v16.BootNotificationReq)
+		return nil
+	})
+	cp := newTestClient(t, "cp1", ts.URL)
+	_, err := cp.Call("Authorize", &v16.AuthorizeReq{IdTag: "tag"})
+	var callErr *CallError
+	if !errors.As(err, &callErr) || callErr.ErrorCode != "InternalError" {
+		t.Fatalf("got %v want InternalError CallError", err)
+	}
+	bootNotification(t, cp)
+}
+
+func TestHandlerTimeout(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+	csms.SetHandlerTimeout("Authorize", 100*time.Millisecond)
+	cancelled := make(chan struct{})
+	csms.OnContext("Authorize", func(ctx context.Context, cp *ChargePoint, p Payload) Payload {
+		<-ctx.Done()
+		close(cancelled)
+		return &v16.AuthorizeConf{IdTagInfo: v16.IdTagInfo{Status: "Accepted"}}
+	})
+	cp := newTestClient(t, "cp1", ts.URL)
+	_, err := cp.Call("Authorize", &v16.AuthorizeReq{IdTag: "tag"})
+	var callErr *CallError
+	if !errors.As(err, &callErr) || callErr.ErrorCode != "InternalError" {
+		t.Fatalf("got %v want InternalError CallError", err)
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("handler context was not cancelled")
+	}
+}
+
+func TestShutdownWaitsForTimedOutHandler(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+	csms.SetHandlerTimeout("Authorize", 50*time.Millisecond)
+	returned := make(chan struct{})
+	csms.On("Authorize", func(cp *ChargePoint, p Payload) Payload {
+		time.Sleep(300 * time.Millisecond)
+		close(returned)
+		return &v16.AuthorizeConf{IdTagInfo: v16.IdTagInfo{Status: "Accepted"}}
+	})
+	cp := newTestClient(t, "cp1", ts.URL)
+	var callErr *CallError
+	if _, err := cp.Call("Authorize", &v16.AuthorizeReq{IdTag: "tag"}); !errors.As(err, &callErr) {
+		t.Fatalf("got %v want InternalError CallError", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := csms.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-returned:
+	default:
+		t.Error("Shutdown returned before the timed out handler")
+	}
+}
+
+func TestSecurityWhitepaperMessages(t *testing.T) {
+	requestId, retries := 7, 2
+	fromCP := []struct {
+		action string
+		req    Payload
+		res    Payload
+	}{
+		{"SecurityEventNotification", &v16.SecurityEventNotificationReq{Type: "FirmwareUpdated", Timestamp: "2022-10-18T10:00:00Z", TechInfo: "1.2.3"}, &v16.SecurityEventNotificationConf{}},
+		{"SignCertificate", &v16.SignCertificateReq{Csr: "-----BEGIN CERTIFICATE REQUEST-----"}, &v16.SignCertificateConf{Status: v16.GenericStatusAccepted}},
+		{"SignedFirmwareStatusNotification", &v16.SignedFirmwareStatusNotificationReq{Status: v16.FirmwareStatusEnumTypeInvalidSignature, RequestId: &requestId}, &v16.SignedFirmwareStatusNotificationConf{}},
+		{"LogStatusNotification", &v16.LogStatusNotificationReq{Status: v16.UploadLogStatusUploaded, RequestId: &requestId}, &v16.LogStatusNotificationConf{}},
+	}
+	fromCSMS := []struct {
+		action string
+		req    Payload
+		res    Payload
+	}{
+		{"CertificateSigned", &v16.CertificateSignedReq{CertificateChain: "-----BEGIN CERTIFICATE-----"}, &v16.CertificateSignedConf{Status: v16.CertificateSignedStatusAccepted}},
+		{"DeleteCertificate", &v16.DeleteCertificateReq{CertificateHashData: v16.CertificateHashDataType{
+			HashAlgorithm: v16.HashAlgorithmSHA256, IssuerNameHash: "a1", IssuerKeyHash: "b2", SerialNumber: "c3",
+		}}, &v16.DeleteCertificateConf{Status: v16.DeleteCertificateStatusNotFound}},
+		{"ExtendedTriggerMessage", &v16.ExtendedTriggerMessageReq{RequestedMessage: v16.MessageTriggerEnumTypeSignChargePointCertificate}, &v16.ExtendedTriggerMessageConf{Status: v16.TriggerMessageStatusEnumTypeAccepted}},
+		{"GetInstalledCertificateIds", &v16.GetInstalledCertificateIdsReq{CertificateType: v16.CertificateUseCentralSystemRootCertificate}, &v16.GetInstalledCertificateIdsConf{
+			Status: v16.GetInstalledCertificateStatusAccepted,
+			CertificateHashData: []v16.CertificateHashDataType{{
+				HashAlgorithm: v16.HashAlgorithmSHA256, IssuerNameHash: "a1", IssuerKeyHash: "b2", SerialNumber: "c3",
+			}},
+		}},
+		{"GetLog", &v16.GetLogReq{LogType: v16.LogSecurityLog, RequestId: &requestId, Retries: &retries, Log: v16.LogParametersType{
+			RemoteLocation: "ftp://logs.example.com/", OldestTimestamp: "2022-10-01T00:00:00Z",
+		}}, &v16.GetLogConf{Status: v16.LogStatusAccepted, Filename: "security.log"}},
+		{"InstallCertificate", &v16.InstallCertificateReq{CertificateType: v16.CertificateUseManufacturerRootCertificate, Certificate: "-----BEGIN CERTIFICATE-----"}, &v16.InstallCertificateConf{Status: v16.InstallCertificateStatusAccepted}},
+		{"SignedUpdateFirmware", &v16.SignedUpdateFirmwareReq{RequestId: &requestId, Firmware: v16.FirmwareType{
+			Location: "https://fw.example.com/1.2.3.bin", RetrieveDateTime: "2022-10-18T10:00:00Z",
+			SigningCertificate: "-----BEGIN CERTIFICATE-----", Signature: "c2lnbmF0dXJl",
+		}}, &v16.SignedUpdateFirmwareConf{Status: v16.UpdateFirmwareStatusAccepted}},
+	}
+	backends := []struct {
+		name    string
+		backend ValidationBackend
+	}{{"tags", ValidationTags}, {"schema", ValidationSchema}}
+	for _, b := range backends {
+		backend := b.backend
+		t.Run(b.name, func(t *testing.T) {
+			csms, ts := newTestServer(t, 10)
+			csms.SetValidationBackend(backend)
+			c := NewClient()
+			c.SetID("cp1")
+			c.AddSubProtocol(ocppV16)
+			c.SetCallQueueSize(8)
+			c.SetValidationBackend(backend)
+			for _, m := range fromCP {
+				res := m.res
+				csms.On(m.action, func(cp *ChargePoint, p Payload) Payload { return res })
+			}
+			for _, m := range fromCSMS {
+				res := m.res
+				c.On(m.action, func(cp *ChargePoint, p Payload) Payload { return res })
+			}
+			connected := onConnect(csms)
+			cp, err := c.Start("ws"+strings.TrimPrefix(ts.URL, "http"), "/ws")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer cp.Shutdown()
+			serverCp := waitConnect(t, connected)
+			for _, m := range fromCP {
+				res, err := cp.Call(m.action, m.req)
+				if err != nil {
+					t.Errorf("%s: %v", m.action, err)
+				} else if !reflect.DeepEqual(res, m.res) {
+					t.Errorf("%s: got %+v want %+v", m.action, res, m.res)
+				}
+			}
+			for _, m := range fromCSMS {
+				res, err := serverCp.Call(m.action, m.req)
+				if err != nil {
+					t.Errorf("%s: %v", m.action, err)
+				} else if !reflect.DeepEqual(res, m.res) {
+					t.Errorf("%s: got %+v want %+v", m.action, res, m.res)
+				}
+			}
+
+			// the nested LogParametersType is validated by the charge point
+			c.SetValidationMode("GetLog", Incoming, ValidationStrict)
+			csms.SetValidationMode("GetLog", Outgoing, ValidationOff)
+			_, err = serverCp.Call("GetLog", &v16.GetLogReq{LogType: v16.LogSecurityLog, RequestId: &requestId, Log: v16.LogParametersType{
+				RemoteLocation: "ftp://logs.example.com/", OldestTimestamp: "yesterday",
+			}})
+			var callErr *CallError
+			if !errors.As(err, &callErr) {
+				t.Errorf("GetLog with invalid oldestTimestamp: got %v want CallError", err)
+			}
+		})
+	}
+}
+
+func TestOCPP21(t *testing.T) {
+	csms := NewServer()
+	csms.AddSubProtocol(ocppV21)
+	csms.SetCallQueueSize(8)
+	csms.On("BootNotification", func(cp *ChargePoint, p Payload) Payload {
+		return &v21.BootNotificationRes{CurrentTime: "2025-01-01T00:00:00Z", Interval: ptr(60), Status: v21.RegistrationStatusAccepted}
+	})
+	streamed := make(chan *v21.NotifyPeriodicEventStreamReq, 1)
+	csms.On("NotifyPeriodicEventStream", func(cp *ChargePoint, p Payload) Payload {
+		streamed <- p.(*v21.NotifyPeriodicEventStreamReq)
+		return nil
+	})
+	frames := make(chan []byte, 16)
+	csms.UseFrame(func(cp *ChargePoint, frame []byte) []byte {
+		frames <- frame
+		return frame
+	})
+	ts := newHTTPTestServer(t, csms)
+
+	c := NewClient()
+	c.SetID("cs1")
+	c.AddSubProtocol(ocppV21)
+	c.SetCallQueueSize(8)
+	c.On("SetDefaultTariff", func(cp *ChargePoint, p Payload) Payload {
+		return &v21.SetDefaultTariffRes{Status: v21.TariffSetStatusAccepted}
+	})
+	c.On("GetTariffs", func(cp *ChargePoint, p Payload) Payload {
+		return &v21.GetTariffsRes{Status: "Bogus"}
+	})
+	c.SetValidationMode("GetTariffs", Outgoing, ValidationOff)
+	cp, err := c.Start("ws"+strings.TrimPrefix(ts.URL, "http"), "/ws")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cp.Shutdown()
+
+	res, err := cp.Call("BootNotification", &v21.BootNotificationReq{
+		Reason:          v21.BootReasonPowerUp,
+		ChargingStation: v21.ChargingStationType{Model: "model", VendorName: "vendor"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.(*v21.BootNotificationRes).Status != v21.RegistrationStatusAccepted {
+		t.Errorf("got %+v", res)
+	}
+
+	serverCp, ok := csms.Load("cs1")
+	if !ok {
+		t.Fatal("charge point is not connected")
+	}
+	res, err = serverCp.Call("SetDefaultTariff", &v21.SetDefaultTariffReq{
+		EvseId: ptr(1),
+		Tariff: v21.TariffType{TariffId: "t1", Currency: "EUR"},
+	})
+	if err != nil || res.(*v21.SetDefaultTariffRes).Status != v21.TariffSetStatusAccepted {
+		t.Errorf("SetDefaultTariff: got %+v, %v", res, err)
+	}
+
+	req := &v21.NotifyPeriodicEventStreamReq{
+		Id: ptr(1), Pending: ptr(0), Basetime: "2025-01-01T00:00:00Z",
+		Data: []v21.StreamDataElementType{{T: ptr(0.5), V: "230.1"}},
+	}
+	if _, err := cp.Call("NotifyPeriodicEventStream", req); err == nil {
+		t.Error("Call of an action without response: got nil error")
+	}
+	if err := cp.Send("NotifyPeriodicEventStream", req); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case got := <-streamed:
+		if !reflect.DeepEqual(got, req) {
+			t.Errorf("got %+v want %+v", got, req)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Send was not handled")
+	}
+
+	// an invalid CallResult is rejected with a CallResultError
+	for len(frames) > 0 {
+		<-frames
+	}
+	if _, err := serverCp.Call("GetTariffs", &v21.GetTariffsReq{EvseId: ptr(1)}); err == nil {
+		t.Error("invalid GetTariffs response: got nil error")
+	}
+	deadline := time.After(5 * time.Second)
+	for {
+		select {
+		case frame := <-frames:
+			if strings.HasPrefix(string(frame), "[5,") {
+				if !strings.Contains(string(frame), string(PropertyConstraintViolation)) {
+					t.Errorf("got %s want a PropertyConstraintViolation", frame)
+				}
+				return
+			}
+		case <-deadline:
+			t.Fatal("no CallResultError sent")
+		}
+	}
+}
+
+func TestSendRequiresOCPP21(t *testing.T) {
+	_, ts := newTestServer(t, 10)
+	cp := newTestClient(t, "cp1", ts.URL)
+	if err := cp.Send("Heartbeat", &v16.HeartbeatReq{}); err == nil {
+		t.Error("Send on ocpp1.6: got nil error")
+	}
+}
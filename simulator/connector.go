@@ -0,0 +1,97 @@
+package simulator
+
+import (
+	"context"
+	"time"
+)
+
+// Status is the status of a connector, named after the ChargePointStatus of
+// ocpp1.6. In ocpp2.0.1 Preparing, Charging, SuspendedEV, SuspendedEVSE and
+// Finishing are all reported as Occupied
+type Status string
+
+const (
+	Available     Status = "Available"
+	Preparing     Status = "Preparing"
+	Charging      Status = "Charging"
+	SuspendedEVSE Status = "SuspendedEVSE"
+	SuspendedEV   Status = "SuspendedEV"
+	Finishing     Status = "Finishing"
+	Reserved      Status = "Reserved"
+	Unavailable   Status = "Unavailable"
+	Faulted       Status = "Faulted"
+)
+
+// transitions are the status changes allowed by the ocpp1.6 specification
+var transitions = map[Status][]Status{
+	Available:     {Preparing, Charging, SuspendedEV, SuspendedEVSE, Reserved, Unavailable, Faulted},
+	Preparing:     {Available, Charging, SuspendedEV, SuspendedEVSE, Finishing, Unavailable, Faulted},
+	Charging:      {Available, SuspendedEV, SuspendedEVSE, Finishing, Unavailable, Faulted},
+	SuspendedEV:   {Available, Charging, SuspendedEVSE, Finishing, Unavailable, Faulted},
+	SuspendedEVSE: {Available, Charging, SuspendedEV, Finishing, Unavailable, Faulted},
+	Finishing:     {Available, Preparing, Unavailable, Faulted},
+	Reserved:      {Available, Preparing, Unavailable, Faulted},
+	Unavailable:   {Available, Preparing, Charging, SuspendedEV, SuspendedEVSE, Faulted},
+	Faulted:       {Available, Preparing, Charging, SuspendedEV, SuspendedEVSE, Finishing, Reserved, Unavailable},
+}
+
+// CanBecome reports whether a connector may change from s to next
+func (s Status) CanBecome(next Status) bool {
+	for _, to := range transitions[s] {
+		if to == next {
+			return true
+		}
+	}
+	return false
+}
+
+// connector is a connector of a Station, its fields are guarded by the
+// mutex of the Station
+type connector struct {
+	id     int
+	status Status
+	// inoperative is set by ChangeAvailability
+	inoperative bool
+	// scheduled is the availability to apply once the transaction ends
+	scheduled *bool
+	// starting is set while a transaction is being started
+	starting bool
+	tx       *transaction
+	// meterWh is the energy register of the connector
+	meterWh float64
+}
+
+// startable reports whether a transaction may start on c, the mutex of the
+// Station must be held
+func (c *connector) startable() bool {
+	return c.tx == nil && !c.starting && !c.inoperative && (c.status == Available || c.status == Preparing)
+}
+
+// transaction is a transaction running on a connector
+type transaction struct {
+	id            string
+	idTag         string
+	remoteStartId *int
+	startWh       float64
+	started       time.Time
+	// stopping is set once a stop has begun, guarded like connector
+	stopping bool
+	// seqNo is the sequence number of the next ocpp2.0.1 TransactionEvent
+	seqNo int
+	// stop ends the meter values loop, which closes done
+	stop context.CancelFunc
+	done chan struct{}
+}
+
+// stopReason is why a transaction is stopped, named after the Reason of
+// ocpp1.6
+type stopReason string
+
+const (
+	reasonLocal         stopReason = "Local"
+	reasonRemote        stopReason = "Remote"
+	reasonSoftReset     stopReason = "SoftReset"
+	reasonHardReset     stopReason = "HardReset"
+	reasonUnlockCommand stopReason = "UnlockCommand"
+	reasonDeAuthorized  stopReason = "DeAuthorized"
+)
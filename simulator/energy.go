@@ -0,0 +1,33 @@
+package simulator
+
+import (
+	"math"
+	"time"
+)
+
+// EnergyCurve returns the energy in Wh delivered by a transaction that has
+// been charging for elapsed
+type EnergyCurve func(elapsed time.Duration) float64
+
+// ConstantPower charges at watts for as long as the transaction lasts
+func ConstantPower(watts float64) EnergyCurve {
+	return func(elapsed time.Duration) float64 {
+		return watts * elapsed.Hours()
+	}
+}
+
+// Taper charges a battery of capacityWh at watts up to 80% of its capacity,
+// then the power decays exponentially as the battery fills up, like the
+// constant voltage phase of a real charge
+func Taper(watts, capacityWh float64) EnergyCurve {
+	bulk := 0.8 * capacityWh
+	bulkTime := bulk / watts
+	return func(elapsed time.Duration) float64 {
+		h := elapsed.Hours()
+		if h <= bulkTime {
+			return watts * h
+		}
+		rest := capacityWh - bulk
+		return capacityWh - rest*math.Exp(-(h-bulkTime)*watts/rest)
+	}
+}
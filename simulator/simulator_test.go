@@ -0,0 +1,498 @@
+package simulator_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aliml92/ocpp"
+	"github.com/aliml92/ocpp/ocpptest"
+	"github.com/aliml92/ocpp/simulator"
+	"github.com/aliml92/ocpp/v16"
+	"github.com/aliml92/ocpp/v201"
+)
+
+// clock records the deadlines of the timers of the station, so that a test
+// only advances the harness clock once the station is waiting
+type clock struct {
+	*ocpptest.Clock
+
+	mu        sync.Mutex
+	deadlines []time.Time
+}
+
+func (c *clock) NewTimer(d time.Duration) (<-chan time.Time, func() bool) {
+	c.mu.Lock()
+	c.deadlines = append(c.deadlines, c.Now().Add(d))
+	c.mu.Unlock()
+	return c.Clock.NewTimer(d)
+}
+
+// csms answers the Calls of the station and records them by action
+type csms struct {
+	t      *testing.T
+	h      *ocpptest.Harness
+	clock  *clock
+	calls  map[string]chan ocpp.Payload
+	server *ocpp.ChargePoint
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func newStation(t *testing.T, cfg simulator.Config, responses map[string]func(ocpp.Payload) ocpp.Payload) (*csms, *simulator.Station) {
+	if cfg.Protocol == "" {
+		cfg.Protocol = "ocpp1.6"
+	}
+	server := ocpp.NewServer()
+	server.AddSubProtocol(cfg.Protocol)
+	server.SetCallQueueSize(8)
+	client := ocpp.NewClient()
+	client.SetID("sim1")
+	client.SetCallQueueSize(8)
+	// the Call timeouts must not expire while the tests advance the clock
+	client.SetTimeoutConfig(ocpp.ClientTimeoutConfig{
+		OcppWait:   24 * time.Hour,
+		WriteWait:  10 * time.Second,
+		PongWait:   60 * time.Second,
+		PingPeriod: 54 * time.Second,
+	})
+	h := ocpptest.New(t, server, client)
+	c := &csms{t: t, h: h, clock: &clock{Clock: h.Clock}, calls: make(map[string]chan ocpp.Payload)}
+	for action, respond := range responses {
+		action, respond := action, respond
+		c.calls[action] = make(chan ocpp.Payload, 16)
+		server.On(action, func(cp *ocpp.ChargePoint, p ocpp.Payload) ocpp.Payload {
+			c.calls[action] <- p
+			return respond(p)
+		})
+	}
+	cfg.Clock = c.clock
+	cfg.Connectors = 2
+	cfg.SerialNumber = "sim1"
+	station, err := simulator.New(client, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(station.Stop)
+	_, c.server = h.Connect()
+	return c, station
+}
+
+// expect returns the next Call of action received by the CSMS
+func (c *csms) expect(action string) ocpp.Payload {
+	c.t.Helper()
+	select {
+	case p := <-c.calls[action]:
+		return p
+	case <-time.After(c.h.Timeout):
+		c.t.Fatalf("no %s within %s", action, c.h.Timeout)
+		return nil
+	}
+}
+
+// advance waits until the station has a timer due in d and advances the
+// clock to it
+func (c *csms) advance(d time.Duration) {
+	c.t.Helper()
+	at := c.clock.Now().Add(d)
+	deadline := time.Now().Add(c.h.Timeout)
+	for time.Now().Before(deadline) {
+		c.clock.mu.Lock()
+		found := false
+		for _, t := range c.clock.deadlines {
+			found = found || t.Equal(at)
+		}
+		c.clock.mu.Unlock()
+		if found {
+			c.clock.Advance(d)
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	c.t.Fatalf("the station has no timer due in %s", d)
+}
+
+func (c *csms) call(action string, p ocpp.Payload) ocpp.Payload {
+	c.t.Helper()
+	res, err := c.server.Call(action, p)
+	if err != nil {
+		c.t.Fatalf("%s: %v", action, err)
+	}
+	return res
+}
+
+func registered(t *testing.T, s *simulator.Station) {
+	t.Helper()
+	select {
+	case <-s.Registered():
+	case <-time.After(5 * time.Second):
+		t.Fatal("station is not registered")
+	}
+}
+
+func v16Responses(boot ...v16.RegistrationStatus) map[string]func(ocpp.Payload) ocpp.Payload {
+	var mu sync.Mutex
+	return map[string]func(ocpp.Payload) ocpp.Payload{
+		"BootNotification": func(ocpp.Payload) ocpp.Payload {
+			mu.Lock()
+			defer mu.Unlock()
+			status := v16.RegistrationStatusAccepted
+			if len(boot) > 0 {
+				status, boot = boot[0], boot[1:]
+			}
+			return &v16.BootNotificationConf{Status: status, CurrentTime: "2024-01-01T00:00:00Z", Interval: ptr(300)}
+		},
+		"StatusNotification": func(ocpp.Payload) ocpp.Payload { return &v16.StatusNotificationConf{} },
+		"Heartbeat": func(ocpp.Payload) ocpp.Payload {
+			return &v16.HeartbeatConf{CurrentTime: "2024-01-01T00:00:00Z"}
+		},
+		"Authorize": func(p ocpp.Payload) ocpp.Payload {
+			status := v16.AuthorizationStatusAccepted
+			if p.(*v16.AuthorizeReq).IdTag == "blocked" {
+				status = v16.AuthorizationStatusBlocked
+			}
+			return &v16.AuthorizeConf{IdTagInfo: v16.IdTagInfo{Status: status}}
+		},
+		"StartTransaction": func(ocpp.Payload) ocpp.Payload {
+			return &v16.StartTransactionConf{TransactionId: ptr(42), IdTagInfo: v16.IdTagInfo{Status: v16.AuthorizationStatusAccepted}}
+		},
+		"MeterValues":     func(ocpp.Payload) ocpp.Payload { return &v16.MeterValuesConf{} },
+		"StopTransaction": func(ocpp.Payload) ocpp.Payload { return &v16.StopTransactionConf{} },
+	}
+}
+
+// expectStatus16 waits for the StatusNotification of connector
+func (c *csms) expectStatus16(connector int, status v16.ChargePointStatus) {
+	c.t.Helper()
+	req := c.expect("StatusNotification").(*v16.StatusNotificationReq)
+	if *req.ConnectorId != connector || req.Status != status {
+		c.t.Fatalf("got status %s of connector %d, want %s of %d", req.Status, req.ConnectorId, status, connector)
+	}
+}
+
+// booted16 waits for the boot sequence of a station
+func (c *csms) booted16(station *simulator.Station) {
+	c.t.Helper()
+	c.expect("BootNotification")
+	registered(c.t, station)
+	for connector := 0; connector <= 2; connector++ {
+		c.expectStatus16(connector, v16.ChargePointStatusAvailable)
+	}
+}
+
+func TestBootRetryAndHeartbeat16(t *testing.T) {
+	c, station := newStation(t, simulator.Config{}, v16Responses(v16.RegistrationStatusPending, v16.RegistrationStatusRejected))
+
+	for i := 0; i < 2; i++ {
+		c.expect("BootNotification")
+		// the interval of a Pending or Rejected response is the retry delay
+		c.advance(300 * time.Second)
+	}
+	boot := c.expect("BootNotification").(*v16.BootNotificationReq)
+	if boot.ChargePointSerialNumber != "sim1" {
+		t.Errorf("got %+v", boot)
+	}
+	registered(t, station)
+	for connector := 0; connector <= 2; connector++ {
+		c.expectStatus16(connector, v16.ChargePointStatusAvailable)
+	}
+	c.advance(300 * time.Second)
+	c.expect("Heartbeat")
+	c.advance(300 * time.Second)
+	c.expect("Heartbeat")
+}
+
+func TestRemoteTransaction16(t *testing.T) {
+	c, station := newStation(t, simulator.Config{}, v16Responses())
+	c.booted16(station)
+
+	two := 2
+	res := c.call("RemoteStartTransaction", &v16.RemoteStartTransactionReq{IdTag: "tag", ConnectorId: &two})
+	if res.(*v16.RemoteStartTransactionConf).Status != v16.RemoteStartStopStatusAccepted {
+		t.Fatalf("got %+v", res)
+	}
+	c.expectStatus16(2, v16.ChargePointStatusPreparing)
+	start := c.expect("StartTransaction").(*v16.StartTransactionReq)
+	if *start.ConnectorId != 2 || start.IdTag != "tag" || *start.MeterStart != 0 {
+		t.Errorf("got %+v", start)
+	}
+	c.expectStatus16(2, v16.ChargePointStatusCharging)
+
+	// 11kW for a minute
+	c.advance(time.Minute)
+	meter := c.expect("MeterValues").(*v16.MeterValuesReq)
+	if meter.TransactionId == nil || *meter.TransactionId != 42 || meter.MeterValue[0].SampledValue[0].Value != "183" {
+		t.Errorf("got %+v", meter)
+	}
+	if wh := station.MeterWh(2); wh < 183 || wh > 184 {
+		t.Errorf("got %f Wh", wh)
+	}
+
+	res = c.call("RemoteStopTransaction", &v16.RemoteStopTransactionReq{TransactionId: ptr(7)})
+	if res.(*v16.RemoteStopTransactionConf).Status != v16.RemoteStartStopStatusRejected {
+		t.Errorf("unknown transaction: got %+v", res)
+	}
+	res = c.call("RemoteStopTransaction", &v16.RemoteStopTransactionReq{TransactionId: ptr(42)})
+	if res.(*v16.RemoteStopTransactionConf).Status != v16.RemoteStartStopStatusAccepted {
+		t.Fatalf("got %+v", res)
+	}
+	c.expectStatus16(2, v16.ChargePointStatusFinishing)
+	stop := c.expect("StopTransaction").(*v16.StopTransactionReq)
+	if *stop.TransactionId != 42 || *stop.MeterStop != 183 || stop.Reason != v16.ReasonRemote {
+		t.Errorf("got %+v", stop)
+	}
+	c.expectStatus16(2, v16.ChargePointStatusAvailable)
+	if id := station.TransactionId(2); id != "" {
+		t.Errorf("transaction %s still running", id)
+	}
+}
+
+func TestLocalTransaction16(t *testing.T) {
+	c, station := newStation(t, simulator.Config{}, v16Responses())
+	c.booted16(station)
+	ctx := context.Background()
+
+	if err := station.StartTransaction(ctx, 1, "blocked"); err != simulator.ErrNotAuthorized {
+		t.Errorf("got %v", err)
+	}
+	c.expectStatus16(1, v16.ChargePointStatusPreparing)
+	c.expect("Authorize")
+	c.expectStatus16(1, v16.ChargePointStatusAvailable)
+
+	if err := station.StartTransaction(ctx, 1, "tag"); err != nil {
+		t.Fatal(err)
+	}
+	if err := station.StartTransaction(ctx, 1, "tag"); err != simulator.ErrConnectorBusy {
+		t.Errorf("got %v", err)
+	}
+	if station.Status(1) != simulator.Charging || station.TransactionId(1) != "42" {
+		t.Errorf("got %s with transaction %q", station.Status(1), station.TransactionId(1))
+	}
+	c.expectStatus16(1, v16.ChargePointStatusPreparing)
+	c.expect("Authorize")
+	c.expect("StartTransaction")
+	c.expectStatus16(1, v16.ChargePointStatusCharging)
+
+	// unlocking the connector ends the transaction in ocpp1.6
+	res := c.call("UnlockConnector", &v16.UnlockConnectorReq{ConnectorId: ptr(1)})
+	if res.(*v16.UnlockConnectorConf).Status != v16.UnlockStatusUnlocked {
+		t.Errorf("got %+v", res)
+	}
+	c.expectStatus16(1, v16.ChargePointStatusFinishing)
+	if stop := c.expect("StopTransaction").(*v16.StopTransactionReq); stop.Reason != v16.ReasonUnlockCommand {
+		t.Errorf("got %+v", stop)
+	}
+	c.expectStatus16(1, v16.ChargePointStatusAvailable)
+	if err := station.StopTransaction(ctx, 1); err != simulator.ErrNoTransaction {
+		t.Errorf("got %v", err)
+	}
+	res = c.call("UnlockConnector", &v16.UnlockConnectorReq{ConnectorId: ptr(3)})
+	if res.(*v16.UnlockConnectorConf).Status != v16.UnlockStatusNotSupported {
+		t.Errorf("got %+v", res)
+	}
+}
+
+func TestAvailabilityAndReset16(t *testing.T) {
+	c, station := newStation(t, simulator.Config{}, v16Responses())
+	c.booted16(station)
+	ctx := context.Background()
+	if err := station.StartTransaction(ctx, 1, "tag"); err != nil {
+		t.Fatal(err)
+	}
+	c.expectStatus16(1, v16.ChargePointStatusPreparing)
+	c.expectStatus16(1, v16.ChargePointStatusCharging)
+
+	// connector 1 is busy until its transaction ends
+	res := c.call("ChangeAvailability", &v16.ChangeAvailabilityReq{ConnectorId: ptr(0), Type: v16.AvailabilityTypeInoperative})
+	if res.(*v16.ChangeAvailabilityConf).Status != v16.AvailabilityStatusScheduled {
+		t.Errorf("got %+v", res)
+	}
+	c.expectStatus16(0, v16.ChargePointStatusUnavailable)
+	c.expectStatus16(2, v16.ChargePointStatusUnavailable)
+	if err := station.StopTransaction(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	c.expectStatus16(1, v16.ChargePointStatusFinishing)
+	c.expect("StopTransaction")
+	c.expectStatus16(1, v16.ChargePointStatusUnavailable)
+	if err := station.StartTransaction(ctx, 2, "tag"); err != simulator.ErrConnectorBusy {
+		t.Errorf("got %v", err)
+	}
+
+	res = c.call("ChangeAvailability", &v16.ChangeAvailabilityReq{ConnectorId: ptr(2), Type: v16.AvailabilityTypeOperative})
+	if res.(*v16.ChangeAvailabilityConf).Status != v16.AvailabilityStatusAccepted {
+		t.Errorf("got %+v", res)
+	}
+	c.expectStatus16(2, v16.ChargePointStatusAvailable)
+	if err := station.StartTransaction(ctx, 2, "tag"); err != nil {
+		t.Fatal(err)
+	}
+	c.expectStatus16(2, v16.ChargePointStatusPreparing)
+	c.expectStatus16(2, v16.ChargePointStatusCharging)
+
+	// a reset stops the transactions and boots again
+	res = c.call("Reset", &v16.ResetReq{Type: v16.ResetTypeHard})
+	if res.(*v16.ResetConf).Status != v16.ResetStatusAccepted {
+		t.Errorf("got %+v", res)
+	}
+	c.expectStatus16(2, v16.ChargePointStatusFinishing)
+	if stop := c.expect("StopTransaction").(*v16.StopTransactionReq); stop.Reason != v16.ReasonHardReset {
+		t.Errorf("got %+v", stop)
+	}
+	c.expectStatus16(2, v16.ChargePointStatusAvailable)
+	c.expect("BootNotification")
+	registered(t, station)
+	c.expectStatus16(0, v16.ChargePointStatusAvailable)
+	c.expectStatus16(1, v16.ChargePointStatusUnavailable)
+	c.expectStatus16(2, v16.ChargePointStatusAvailable)
+}
+
+func v201Responses() map[string]func(ocpp.Payload) ocpp.Payload {
+	return map[string]func(ocpp.Payload) ocpp.Payload{
+		"BootNotification": func(ocpp.Payload) ocpp.Payload {
+			return &v201.BootNotificationRes{Status: v201.RegistrationStatusAccepted, CurrentTime: "2024-01-01T00:00:00Z", Interval: ptr(300)}
+		},
+		"StatusNotification": func(ocpp.Payload) ocpp.Payload { return &v201.StatusNotificationRes{} },
+		"Heartbeat": func(ocpp.Payload) ocpp.Payload {
+			return &v201.HeartbeatRes{CurrentTime: "2024-01-01T00:00:00Z"}
+		},
+		"Authorize": func(ocpp.Payload) ocpp.Payload {
+			return &v201.AuthorizeRes{IdTokenInfo: v201.IdTokenInfoType{Status: v201.AuthorizationStatusAccepted}}
+		},
+		"TransactionEvent": func(ocpp.Payload) ocpp.Payload { return &v201.TransactionEventRes{} },
+	}
+}
+
+func (c *csms) expectStatus201(evse int, status v201.ConnectorStatusEnumType) {
+	c.t.Helper()
+	req := c.expect("StatusNotification").(*v201.StatusNotificationReq)
+	if *req.EvseId != evse || *req.ConnectorId != 1 || req.ConnectorStatus != status {
+		c.t.Fatalf("got status %s of evse %d, want %s of %d", req.ConnectorStatus, req.EvseId, status, evse)
+	}
+}
+
+func (c *csms) expectEvent(eventType v201.TransactionEventEnumType, trigger v201.TriggerReasonEnumType, seqNo int) *v201.TransactionEventReq {
+	c.t.Helper()
+	req := c.expect("TransactionEvent").(*v201.TransactionEventReq)
+	if req.EventType != eventType || req.TriggerReason != trigger || *req.SeqNo != seqNo {
+		c.t.Fatalf("got %s %s #%d, want %s %s #%d", req.EventType, req.TriggerReason, req.SeqNo, eventType, trigger, seqNo)
+	}
+	return req
+}
+
+func TestTransactionEvent201(t *testing.T) {
+	c, station := newStation(t, simulator.Config{Protocol: "ocpp2.0.1", Energy: simulator.Taper(11000, 50000)}, v201Responses())
+	boot := c.expect("BootNotification").(*v201.BootNotificationReq)
+	if boot.Reason != v201.BootReasonPowerUp {
+		t.Errorf("got %+v", boot)
+	}
+	registered(t, station)
+	c.expectStatus201(1, v201.ConnectorStatusAvailable)
+	c.expectStatus201(2, v201.ConnectorStatusAvailable)
+
+	res := c.call("RequestStartTransaction", &v201.RequestStartTransactionReq{
+		IdToken:       v201.IdTokenType{IdToken: "tag", Type: v201.IdTokenCentral},
+		RemoteStartId: ptr(5),
+	})
+	if res.(*v201.RequestStartTransactionRes).Status != v201.RequestStartStopStatusAccepted {
+		t.Fatalf("got %+v", res)
+	}
+	// Preparing and Charging are both Occupied
+	c.expectStatus201(1, v201.ConnectorStatusOccupied)
+	started := c.expectEvent(v201.TransactionEventStarted, v201.TriggerReasonRemoteStart, 0)
+	txId := started.TransactionInfo.TransactionId
+	if txId == "" || *started.TransactionInfo.RemoteStartId != 5 || *started.Evse.Id != 1 || started.IdToken.IdToken != "tag" {
+		t.Errorf("got %+v", started)
+	}
+
+	c.advance(time.Minute)
+	updated := c.expectEvent(v201.TransactionEventUpdated, v201.TriggerReasonMeterValuePeriodic, 1)
+	if v := updated.MeterValue[0].SampledValue[0].Value; *v < 183 || *v > 184 {
+		t.Errorf("got %f Wh", *v)
+	}
+
+	unlock := c.call("UnlockConnector", &v201.UnlockConnectorReq{EvseId: ptr(1), ConnectorId: ptr(1)})
+	if unlock.(*v201.UnlockConnectorRes).Status != v201.UnlockStatusOngoingAuthorizedTransaction {
+		t.Errorf("got %+v", unlock)
+	}
+	reset := c.call("Reset", &v201.ResetReq{Type: v201.ResetOnIdle})
+	if reset.(*v201.ResetRes).Status != v201.ResetStatusScheduled {
+		t.Errorf("got %+v", reset)
+	}
+
+	stop := c.call("RequestStopTransaction", &v201.RequestStopTransactionReq{TransactionId: txId})
+	if stop.(*v201.RequestStopTransactionRes).Status != v201.RequestStartStopStatusAccepted {
+		t.Fatalf("got %+v", stop)
+	}
+	ended := c.expectEvent(v201.TransactionEventEnded, v201.TriggerReasonRemoteStop, 2)
+	if ended.TransactionInfo.TransactionId != txId || ended.TransactionInfo.StoppedReason != v201.ReasonRemote {
+		t.Errorf("got %+v", ended)
+	}
+	c.expectStatus201(1, v201.ConnectorStatusAvailable)
+
+	// the scheduled reset runs once the transaction has ended
+	boot = c.expect("BootNotification").(*v201.BootNotificationReq)
+	if boot.Reason != v201.BootReasonRemoteReset {
+		t.Errorf("got %+v", boot)
+	}
+	registered(t, station)
+	c.expectStatus201(1, v201.ConnectorStatusAvailable)
+	c.expectStatus201(2, v201.ConnectorStatusAvailable)
+
+	if err := station.StartTransaction(context.Background(), 2, "card"); err != nil {
+		t.Fatal(err)
+	}
+	if auth := c.expect("Authorize").(*v201.AuthorizeReq); auth.IdToken.Type != v201.IdTokenISO14443 {
+		t.Errorf("got %+v", auth)
+	}
+	c.expectStatus201(2, v201.ConnectorStatusOccupied)
+	c.expectEvent(v201.TransactionEventStarted, v201.TriggerReasonAuthorized, 0)
+	if err := station.StopTransaction(context.Background(), 2); err != nil {
+		t.Fatal(err)
+	}
+	ended = c.expectEvent(v201.TransactionEventEnded, v201.TriggerReasonStopAuthorized, 1)
+	if ended.TransactionInfo.StoppedReason != v201.ReasonLocal {
+		t.Errorf("got %+v", ended)
+	}
+	c.expectStatus201(2, v201.ConnectorStatusAvailable)
+}
+
+func TestStatusTransitions(t *testing.T) {
+	for _, tt := range []struct {
+		from, to simulator.Status
+		ok       bool
+	}{
+		{simulator.Available, simulator.Preparing, true},
+		{simulator.Preparing, simulator.Charging, true},
+		{simulator.Charging, simulator.Finishing, true},
+		{simulator.Finishing, simulator.Available, true},
+		{simulator.Finishing, simulator.Charging, false},
+		{simulator.Reserved, simulator.Charging, false},
+		{simulator.Available, simulator.Available, false},
+	} {
+		if got := tt.from.CanBecome(tt.to); got != tt.ok {
+			t.Errorf("%s to %s: got %v", tt.from, tt.to, got)
+		}
+	}
+}
+
+func TestEnergyCurves(t *testing.T) {
+	if got := simulator.ConstantPower(7400)(30 * time.Minute); got != 3700 {
+		t.Errorf("got %f", got)
+	}
+	taper := simulator.Taper(10000, 50000)
+	if got := taper(4 * time.Hour); got != 40000 {
+		t.Errorf("bulk phase: got %f", got)
+	}
+	prev := 40000.0
+	for h := 5; h <= 10; h++ {
+		got := taper(time.Duration(h) * time.Hour)
+		if got <= prev || got >= 50000 {
+			t.Errorf("%dh: got %f after %f", h, got, prev)
+		}
+		prev = got
+	}
+}
@@ -0,0 +1,683 @@
+// Package simulator models charging stations on top of ocpp.Client, for
+// staging environments, load tests and end-to-end tests of a CSMS. A Station
+// boots, sends heartbeats and status notifications, runs transactions with
+// periodic meter values and answers the remote commands of the CSMS, in
+// ocpp1.6 or ocpp2.0.1
+package simulator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aliml92/ocpp"
+)
+
+var (
+	ErrUnknownConnector = errors.New("simulator: unknown connector")
+	ErrConnectorBusy    = errors.New("simulator: connector is not available")
+	ErrNoTransaction    = errors.New("simulator: no transaction on connector")
+	ErrNotAuthorized    = errors.New("simulator: id tag not accepted")
+	ErrNotRegistered    = errors.New("simulator: station is not registered")
+)
+
+// Config describes a simulated station, zero fields take the defaults
+type Config struct {
+	// Protocol is "ocpp1.6", the default, or "ocpp2.0.1"
+	Protocol string
+	// Connectors is the number of connectors, 1 by default. In ocpp2.0.1
+	// every connector is connector 1 of its own EVSE
+	Connectors   int
+	Vendor       string
+	Model        string
+	SerialNumber string
+	// BootRetry is the delay before sending BootNotification again if the
+	// CSMS did not answer, or did not accept it and gave no interval, 30s
+	// by default
+	BootRetry time.Duration
+	// HeartbeatInterval is used if the CSMS accepts the BootNotification
+	// with interval 0, 5m by default
+	HeartbeatInterval time.Duration
+	// MeterInterval is the period of meter values during a transaction,
+	// 1m by default
+	MeterInterval time.Duration
+	// Energy is the energy curve of every transaction, ConstantPower(11000)
+	// by default
+	Energy EnergyCurve
+	// Clock drives every timer of the station, it should be the clock of
+	// the Client if one was set. ocpp.RealClock is used by default
+	Clock ocpp.Clock
+}
+
+// registration is the status of a BootNotification response
+type registration string
+
+const (
+	registrationAccepted registration = "Accepted"
+	registrationPending  registration = "Pending"
+	registrationRejected registration = "Rejected"
+)
+
+// protocol sends the messages of one ocpp version and registers the handlers
+// of the remote commands
+type protocol interface {
+	register(s *Station)
+	boot(ctx context.Context, cp *ocpp.ChargePoint, reset bool) (registration, int, error)
+	heartbeat(ctx context.Context, cp *ocpp.ChargePoint) error
+	// status reports the status of connector, 0 is the whole station
+	status(ctx context.Context, cp *ocpp.ChargePoint, connector int, st Status) error
+	authorize(ctx context.Context, cp *ocpp.ChargePoint, idTag string) (bool, error)
+	// startTransaction sets the id of tx and reports whether the CSMS
+	// accepted its id tag
+	startTransaction(ctx context.Context, cp *ocpp.ChargePoint, connector int, tx *transaction) (bool, error)
+	meterValues(ctx context.Context, cp *ocpp.ChargePoint, connector int, tx *transaction, wh float64) error
+	stopTransaction(ctx context.Context, cp *ocpp.ChargePoint, connector int, tx *transaction, wh float64, reason stopReason) error
+}
+
+// Station is a simulated charging station
+type Station struct {
+	client *ocpp.Client
+	cfg    Config
+	clock  ocpp.Clock
+	proto  protocol
+
+	// ctx lives until Stop, transactions run on it
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu sync.Mutex
+	cp *ocpp.ChargePoint
+	// cancelRun stops the boot and heartbeat loop of the current connection
+	cancelRun  context.CancelFunc
+	registered bool
+	// registeredC is closed when the current boot sequence is accepted
+	registeredC chan struct{}
+	heartbeat   time.Duration
+	// resetScheduled reboots the station once no transaction is running
+	resetScheduled bool
+	connectors     []*connector
+	// followUps run after the response to the Call of their request
+	followUps map[ocpp.Payload]func()
+}
+
+// New creates a Station speaking cfg.Protocol through client. It registers
+// the handlers of the remote commands and the OnConnect and OnDisconnect
+// functions of client, the station boots on every connection
+func New(client *ocpp.Client, cfg Config) (*Station, error) {
+	if cfg.Protocol == "" {
+		cfg.Protocol = "ocpp1.6"
+	}
+	if cfg.Connectors <= 0 {
+		cfg.Connectors = 1
+	}
+	if cfg.Vendor == "" {
+		cfg.Vendor = "ocpp"
+	}
+	if cfg.Model == "" {
+		cfg.Model = "simulator"
+	}
+	if cfg.BootRetry <= 0 {
+		cfg.BootRetry = 30 * time.Second
+	}
+	if cfg.HeartbeatInterval <= 0 {
+		cfg.HeartbeatInterval = 5 * time.Minute
+	}
+	if cfg.MeterInterval <= 0 {
+		cfg.MeterInterval = time.Minute
+	}
+	if cfg.Energy == nil {
+		cfg.Energy = ConstantPower(11000)
+	}
+	if cfg.Clock == nil {
+		cfg.Clock = ocpp.RealClock{}
+	}
+	s := &Station{
+		client:      client,
+		cfg:         cfg,
+		clock:       cfg.Clock,
+		registeredC: make(chan struct{}),
+		followUps:   make(map[ocpp.Payload]func()),
+	}
+	switch cfg.Protocol {
+	case "ocpp1.6":
+		s.proto = &v16Protocol{}
+	case "ocpp2.0.1":
+		s.proto = &v201Protocol{}
+	default:
+		return nil, fmt.Errorf("simulator: unsupported protocol %q", cfg.Protocol)
+	}
+	for i := 1; i <= cfg.Connectors; i++ {
+		s.connectors = append(s.connectors, &connector{id: i, status: Available})
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	client.AddSubProtocol(cfg.Protocol)
+	client.OnConnect(s.connected)
+	client.OnDisconnect(s.disconnected)
+	s.proto.register(s)
+	return s, nil
+}
+
+// Start connects the station to the CSMS, see Client.Start
+func (s *Station) Start(addr, path string) error {
+	_, err := s.client.Start(addr, path)
+	return err
+}
+
+// Stop ends the transactions without stopping them at the CSMS and closes
+// the connection
+func (s *Station) Stop() {
+	s.cancel()
+	s.mu.Lock()
+	cp := s.cp
+	s.mu.Unlock()
+	if cp != nil {
+		cp.Shutdown()
+	}
+}
+
+// Registered returns a channel closed once the CSMS has accepted the
+// BootNotification of the current connection
+func (s *Station) Registered() <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.registeredC
+}
+
+// Status returns the status of a connector
+func (s *Station) Status(connector int) Status {
+	c, err := s.connector(connector)
+	if err != nil {
+		return ""
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return c.status
+}
+
+// TransactionId returns the id of the transaction running on a connector,
+// or "" if there is none
+func (s *Station) TransactionId(connector int) string {
+	c, err := s.connector(connector)
+	if err != nil {
+		return ""
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c.tx == nil {
+		return ""
+	}
+	return c.tx.id
+}
+
+// MeterWh returns the energy register of a connector
+func (s *Station) MeterWh(connector int) float64 {
+	c, err := s.connector(connector)
+	if err != nil {
+		return 0
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return c.meterWh
+}
+
+// StartTransaction plugs in a vehicle on a connector, authorizes idTag and
+// starts a transaction, which sends meter values until it is stopped
+func (s *Station) StartTransaction(ctx context.Context, connector int, idTag string) error {
+	c, err := s.connector(connector)
+	if err != nil {
+		return err
+	}
+	return s.startTransaction(ctx, c, idTag, nil, true)
+}
+
+// StopTransaction stops the transaction running on a connector and unplugs
+// the vehicle
+func (s *Station) StopTransaction(ctx context.Context, connector int) error {
+	c, err := s.connector(connector)
+	if err != nil {
+		return err
+	}
+	return s.stopTransaction(ctx, c, reasonLocal)
+}
+
+func (s *Station) connector(id int) (*connector, error) {
+	if id < 1 || id > len(s.connectors) {
+		return nil, ErrUnknownConnector
+	}
+	return s.connectors[id-1], nil
+}
+
+func (s *Station) chargePoint() (*ocpp.ChargePoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cp == nil {
+		return nil, ocpp.ErrChargePointNotConnected
+	}
+	return s.cp, nil
+}
+
+func (s *Station) connected(cp *ocpp.ChargePoint) {
+	s.mu.Lock()
+	s.cp = cp
+	s.mu.Unlock()
+	s.reboot(false)
+}
+
+func (s *Station) disconnected(cp *ocpp.ChargePoint, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cp == cp && s.cancelRun != nil {
+		s.cancelRun()
+		s.cancelRun = nil
+	}
+}
+
+// reboot starts the boot sequence again, reset tells the CSMS why
+func (s *Station) reboot(reset bool) {
+	s.mu.Lock()
+	if s.cancelRun != nil {
+		s.cancelRun()
+	}
+	ctx, cancel := context.WithCancel(s.ctx)
+	s.cancelRun = cancel
+	s.resetScheduled = false
+	if s.registered {
+		s.registered = false
+		s.registeredC = make(chan struct{})
+	}
+	s.mu.Unlock()
+	go s.run(ctx, reset)
+}
+
+// run boots the station, reports the status of every connector and sends
+// heartbeats until ctx is done
+func (s *Station) run(ctx context.Context, reset bool) {
+	if !s.boot(ctx, reset) {
+		return
+	}
+	cp, err := s.chargePoint()
+	if err != nil {
+		return
+	}
+	_ = s.proto.status(ctx, cp, 0, Available)
+	for _, c := range s.connectors {
+		s.mu.Lock()
+		st := c.status
+		s.mu.Unlock()
+		_ = s.proto.status(ctx, cp, c.id, st)
+	}
+	for {
+		s.mu.Lock()
+		d := s.heartbeat
+		s.mu.Unlock()
+		if !s.sleep(ctx, d) {
+			return
+		}
+		_ = s.proto.heartbeat(ctx, cp)
+	}
+}
+
+// boot sends BootNotification until it is accepted, it returns false if
+// ctx is done first
+func (s *Station) boot(ctx context.Context, reset bool) bool {
+	for {
+		retry := s.cfg.BootRetry
+		cp, err := s.chargePoint()
+		if err != nil {
+			return false
+		}
+		status, interval, err := s.proto.boot(ctx, cp, reset)
+		if ctx.Err() != nil {
+			return false
+		}
+		if err == nil {
+			if interval > 0 {
+				retry = time.Duration(interval) * time.Second
+			}
+			if status == registrationAccepted {
+				if interval <= 0 {
+					retry = s.cfg.HeartbeatInterval
+				}
+				s.mu.Lock()
+				s.registered = true
+				s.heartbeat = retry
+				close(s.registeredC)
+				s.mu.Unlock()
+				return true
+			}
+		}
+		if !s.sleep(ctx, retry) {
+			return false
+		}
+	}
+}
+
+// sleep waits for d on the clock of the station, it returns false if ctx
+// is done first
+func (s *Station) sleep(ctx context.Context, d time.Duration) bool {
+	c, stop := s.clock.NewTimer(d)
+	select {
+	case <-c:
+		return true
+	case <-ctx.Done():
+		stop()
+		return false
+	}
+}
+
+func (s *Station) now() string {
+	return s.clock.Now().UTC().Format(time.RFC3339)
+}
+
+// setStatus changes the status of c and reports it once the station is
+// registered, the change must be allowed by the state machine
+func (s *Station) setStatus(ctx context.Context, c *connector, st Status) error {
+	s.mu.Lock()
+	if c.status == st {
+		s.mu.Unlock()
+		return nil
+	}
+	if !c.status.CanBecome(st) {
+		from := c.status
+		s.mu.Unlock()
+		return fmt.Errorf("simulator: connector %d cannot change from %s to %s", c.id, from, st)
+	}
+	c.status = st
+	registered, cp := s.registered, s.cp
+	s.mu.Unlock()
+	if !registered || cp == nil {
+		return nil
+	}
+	return s.proto.status(ctx, cp, c.id, st)
+}
+
+// startTransaction runs the Authorize, unless authorize is false for a
+// remote start, and the start of a transaction on c
+func (s *Station) startTransaction(ctx context.Context, c *connector, idTag string, remoteStartId *int, authorize bool) error {
+	s.mu.Lock()
+	registered, busy := s.registered, !c.startable()
+	if registered && !busy {
+		c.starting = true
+	}
+	s.mu.Unlock()
+	if !registered {
+		return ErrNotRegistered
+	}
+	if busy {
+		return ErrConnectorBusy
+	}
+	defer func() {
+		s.mu.Lock()
+		c.starting = false
+		s.mu.Unlock()
+	}()
+	cp, err := s.chargePoint()
+	if err != nil {
+		return err
+	}
+	if err := s.setStatus(ctx, c, Preparing); err != nil {
+		return err
+	}
+	if authorize {
+		ok, err := s.proto.authorize(ctx, cp, idTag)
+		if err == nil && !ok {
+			err = ErrNotAuthorized
+		}
+		if err != nil {
+			_ = s.setStatus(ctx, c, Available)
+			return err
+		}
+	}
+	s.mu.Lock()
+	tx := &transaction{
+		idTag:         idTag,
+		remoteStartId: remoteStartId,
+		startWh:       c.meterWh,
+		started:       s.clock.Now(),
+		done:          make(chan struct{}),
+	}
+	s.mu.Unlock()
+	accepted, err := s.proto.startTransaction(ctx, cp, c.id, tx)
+	if err != nil {
+		_ = s.setStatus(ctx, c, Available)
+		return err
+	}
+	var meterCtx context.Context
+	meterCtx, tx.stop = context.WithCancel(s.ctx)
+	s.mu.Lock()
+	c.tx = tx
+	s.mu.Unlock()
+	go s.meterValues(meterCtx, c, tx)
+	if !accepted {
+		_ = s.stopTransaction(ctx, c, reasonDeAuthorized)
+		return ErrNotAuthorized
+	}
+	return s.setStatus(ctx, c, Charging)
+}
+
+// meterValues sends the energy register of c every MeterInterval until the
+// transaction is stopped
+func (s *Station) meterValues(ctx context.Context, c *connector, tx *transaction) {
+	defer close(tx.done)
+	for s.sleep(ctx, s.cfg.MeterInterval) {
+		wh := s.readMeter(c, tx)
+		cp, err := s.chargePoint()
+		if err != nil {
+			continue
+		}
+		_ = s.proto.meterValues(ctx, cp, c.id, tx, wh)
+	}
+}
+
+// readMeter advances the energy register of c along the energy curve
+func (s *Station) readMeter(c *connector, tx *transaction) float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c.meterWh = tx.startWh + s.cfg.Energy(s.clock.Now().Sub(tx.started))
+	return c.meterWh
+}
+
+// stopTransaction stops the transaction on c and unplugs the vehicle,
+// applying a scheduled availability change or reset afterwards
+func (s *Station) stopTransaction(ctx context.Context, c *connector, reason stopReason) error {
+	s.mu.Lock()
+	tx := c.tx
+	if tx != nil && tx.stopping {
+		tx = nil
+	}
+	if tx != nil {
+		tx.stopping = true
+	}
+	s.mu.Unlock()
+	if tx == nil {
+		return ErrNoTransaction
+	}
+	tx.stop()
+	<-tx.done
+	wh := s.readMeter(c, tx)
+	_ = s.setStatus(ctx, c, Finishing)
+	var err error
+	if cp, cpErr := s.chargePoint(); cpErr != nil {
+		err = cpErr
+	} else {
+		err = s.proto.stopTransaction(ctx, cp, c.id, tx, wh, reason)
+	}
+	s.mu.Lock()
+	if c.tx == tx {
+		c.tx = nil
+	}
+	if c.scheduled != nil {
+		c.inoperative = !*c.scheduled
+		c.scheduled = nil
+	}
+	next := Available
+	if c.inoperative {
+		next = Unavailable
+	}
+	reset := s.resetScheduled && s.idle()
+	s.mu.Unlock()
+	_ = s.setStatus(ctx, c, next)
+	if reset {
+		s.reboot(true)
+	}
+	return err
+}
+
+// idle reports whether no transaction is running, s.mu must be held
+func (s *Station) idle() bool {
+	for _, c := range s.connectors {
+		if c.tx != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Station) runFollowUp(cp *ocpp.ChargePoint, req ocpp.Payload) {
+	s.mu.Lock()
+	f := s.followUps[req]
+	delete(s.followUps, req)
+	s.mu.Unlock()
+	if f != nil {
+		f()
+	}
+}
+
+// remoteStart picks the connector for a remote start, id 0 picks the first
+// available one, and starts the transaction after the response
+func (s *Station) remoteStart(req ocpp.Payload, id int, idTag string, remoteStartId *int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.registered {
+		return false
+	}
+	for _, c := range s.connectors {
+		if (id == 0 || c.id == id) && c.startable() {
+			c := c
+			s.followUps[req] = func() {
+				_ = s.startTransaction(s.ctx, c, idTag, remoteStartId, false)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// remoteStop stops the transaction with the given id after the response
+func (s *Station) remoteStop(req ocpp.Payload, txId string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range s.connectors {
+		if c.tx != nil && c.tx.id == txId {
+			c := c
+			s.followUps[req] = func() {
+				_ = s.stopTransaction(s.ctx, c, reasonRemote)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// reset reboots the station after the response, stopping the transactions
+// first. If onIdle is set and transactions are running it only schedules
+// the reset and returns false
+func (s *Station) reset(req ocpp.Payload, reason stopReason, onIdle bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if onIdle && !s.idle() {
+		s.resetScheduled = true
+		return false
+	}
+	s.followUps[req] = func() {
+		for _, c := range s.connectors {
+			_ = s.stopTransaction(s.ctx, c, reason)
+		}
+		s.reboot(true)
+	}
+	return true
+}
+
+// changeAvailability changes the availability of connector id, 0 is the
+// whole station. It returns false for an unknown connector, scheduled is
+// set if a connector changes once its transaction has ended
+func (s *Station) changeAvailability(req ocpp.Payload, id int, operative bool) (ok, scheduled bool) {
+	var targets []*connector
+	if id == 0 {
+		targets = s.connectors
+	} else if c, err := s.connector(id); err == nil {
+		targets = []*connector{c}
+	} else {
+		return false, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var now []*connector
+	for _, c := range targets {
+		if c.tx != nil {
+			op := operative
+			c.scheduled = &op
+			scheduled = true
+			continue
+		}
+		c.inoperative = !operative
+		now = append(now, c)
+	}
+	next := Available
+	if !operative {
+		next = Unavailable
+	}
+	s.followUps[req] = func() {
+		if id == 0 {
+			if cp, err := s.chargePoint(); err == nil {
+				_ = s.proto.status(s.ctx, cp, 0, next)
+			}
+		}
+		for _, c := range now {
+			_ = s.setStatus(s.ctx, c, next)
+		}
+	}
+	return true, scheduled
+}
+
+// unlock reports whether connector id exists and has a transaction, stop
+// stops it after the response
+func (s *Station) unlock(req ocpp.Payload, id int, stop bool) (known, busy bool) {
+	c, err := s.connector(id)
+	if err != nil {
+		return false, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	busy = c.tx != nil
+	if busy && stop {
+		s.followUps[req] = func() {
+			_ = s.stopTransaction(s.ctx, c, reasonUnlockCommand)
+		}
+	}
+	return true, busy
+}
+
+// handle registers a handler that cannot fail to register, since the types
+// of this package always match
+func handle[Req any, Res any](s *Station, f func(*ocpp.ChargePoint, *Req) *Res) {
+	err := ocpp.Handle(s.client, func(cp *ocpp.ChargePoint, req *Req) (*Res, error) {
+		return f(cp, req), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// followUp makes the follow-ups of action run after its response
+func (s *Station) followUp(actions ...string) {
+	for _, action := range actions {
+		s.client.After(action, s.runFollowUp)
+	}
+}
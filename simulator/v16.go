@@ -0,0 +1,172 @@
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/aliml92/ocpp"
+	"github.com/aliml92/ocpp/v16"
+)
+
+// v16Protocol speaks ocpp1.6
+type v16Protocol struct {
+	s *Station
+}
+
+func (p *v16Protocol) register(s *Station) {
+	p.s = s
+	handle(s, p.remoteStartTransaction)
+	handle(s, p.remoteStopTransaction)
+	handle(s, p.reset)
+	handle(s, p.changeAvailability)
+	handle(s, p.unlockConnector)
+	s.followUp("RemoteStartTransaction", "RemoteStopTransaction", "Reset", "ChangeAvailability", "UnlockConnector")
+}
+
+func (p *v16Protocol) boot(ctx context.Context, cp *ocpp.ChargePoint, reset bool) (registration, int, error) {
+	res, err := ocpp.CallTypedContext[v16.BootNotificationReq, v16.BootNotificationConf](ctx, cp, &v16.BootNotificationReq{
+		ChargePointVendor:       p.s.cfg.Vendor,
+		ChargePointModel:        p.s.cfg.Model,
+		ChargePointSerialNumber: p.s.cfg.SerialNumber,
+	})
+	if err != nil {
+		return "", 0, err
+	}
+	return registration(res.Status), *res.Interval, nil
+}
+
+func (p *v16Protocol) heartbeat(ctx context.Context, cp *ocpp.ChargePoint) error {
+	_, err := ocpp.CallTypedContext[v16.HeartbeatReq, v16.HeartbeatConf](ctx, cp, &v16.HeartbeatReq{})
+	return err
+}
+
+func (p *v16Protocol) status(ctx context.Context, cp *ocpp.ChargePoint, connector int, st Status) error {
+	_, err := ocpp.CallTypedContext[v16.StatusNotificationReq, v16.StatusNotificationConf](ctx, cp, &v16.StatusNotificationReq{
+		ConnectorId: &connector,
+		ErrorCode:   v16.ChargePointErrorCodeNoError,
+		Status:      v16.ChargePointStatus(st),
+		Timestamp:   p.s.now(),
+	})
+	return err
+}
+
+func (p *v16Protocol) authorize(ctx context.Context, cp *ocpp.ChargePoint, idTag string) (bool, error) {
+	res, err := ocpp.CallTypedContext[v16.AuthorizeReq, v16.AuthorizeConf](ctx, cp, &v16.AuthorizeReq{IdTag: idTag})
+	if err != nil {
+		return false, err
+	}
+	return res.IdTagInfo.Status == v16.AuthorizationStatusAccepted, nil
+}
+
+func (p *v16Protocol) startTransaction(ctx context.Context, cp *ocpp.ChargePoint, connector int, tx *transaction) (bool, error) {
+	res, err := ocpp.CallTypedContext[v16.StartTransactionReq, v16.StartTransactionConf](ctx, cp, &v16.StartTransactionReq{
+		ConnectorId: &connector,
+		IdTag:       tx.idTag,
+		MeterStart:  ptr(wattHours(tx.startWh)),
+		Timestamp:   p.s.now(),
+	})
+	if err != nil {
+		return false, err
+	}
+	tx.id = strconv.Itoa(*res.TransactionId)
+	return res.IdTagInfo.Status == v16.AuthorizationStatusAccepted, nil
+}
+
+func (p *v16Protocol) meterValues(ctx context.Context, cp *ocpp.ChargePoint, connector int, tx *transaction, wh float64) error {
+	id, err := strconv.Atoi(tx.id)
+	if err != nil {
+		return err
+	}
+	_, err = ocpp.CallTypedContext[v16.MeterValuesReq, v16.MeterValuesConf](ctx, cp, &v16.MeterValuesReq{
+		ConnectorId:   &connector,
+		TransactionId: &id,
+		MeterValue:    []v16.MeterValue{p.energy(wh, v16.ReadingContextSamplePeriodic)},
+	})
+	return err
+}
+
+func (p *v16Protocol) stopTransaction(ctx context.Context, cp *ocpp.ChargePoint, connector int, tx *transaction, wh float64, reason stopReason) error {
+	id, err := strconv.Atoi(tx.id)
+	if err != nil {
+		return err
+	}
+	_, err = ocpp.CallTypedContext[v16.StopTransactionReq, v16.StopTransactionConf](ctx, cp, &v16.StopTransactionReq{
+		IdTag:           tx.idTag,
+		MeterStop:       ptr(wattHours(wh)),
+		Timestamp:       p.s.now(),
+		TransactionId:   &id,
+		Reason:          v16.Reason(reason),
+		TransactionData: []v16.MeterValue{p.energy(wh, v16.ReadingContextTransactionEnd)},
+	})
+	return err
+}
+
+func (p *v16Protocol) energy(wh float64, context v16.ReadingContext) v16.MeterValue {
+	return v16.MeterValue{
+		Timestamp: p.s.now(),
+		SampledValue: []v16.SampledValue{{
+			Value:     fmt.Sprint(wattHours(wh)),
+			Context:   context,
+			Measurand: v16.MeasurandEnergyActiveImportRegister,
+			Unit:      v16.UnitOfMeasureWh,
+		}},
+	}
+}
+
+func (p *v16Protocol) remoteStartTransaction(cp *ocpp.ChargePoint, req *v16.RemoteStartTransactionReq) *v16.RemoteStartTransactionConf {
+	connector := 0
+	if req.ConnectorId != nil {
+		connector = *req.ConnectorId
+	}
+	status := v16.RemoteStartStopStatusRejected
+	if connector >= 0 && p.s.remoteStart(req, connector, req.IdTag, nil) {
+		status = v16.RemoteStartStopStatusAccepted
+	}
+	return &v16.RemoteStartTransactionConf{Status: status}
+}
+
+func (p *v16Protocol) remoteStopTransaction(cp *ocpp.ChargePoint, req *v16.RemoteStopTransactionReq) *v16.RemoteStopTransactionConf {
+	status := v16.RemoteStartStopStatusRejected
+	if p.s.remoteStop(req, strconv.Itoa(*req.TransactionId)) {
+		status = v16.RemoteStartStopStatusAccepted
+	}
+	return &v16.RemoteStopTransactionConf{Status: status}
+}
+
+// reset reboots the station, a hard reset is simulated like a soft one
+// apart from the stop reason of the transactions
+func (p *v16Protocol) reset(cp *ocpp.ChargePoint, req *v16.ResetReq) *v16.ResetConf {
+	reason := reasonSoftReset
+	if req.Type == v16.ResetTypeHard {
+		reason = reasonHardReset
+	}
+	p.s.reset(req, reason, false)
+	return &v16.ResetConf{Status: v16.ResetStatusAccepted}
+}
+
+func (p *v16Protocol) changeAvailability(cp *ocpp.ChargePoint, req *v16.ChangeAvailabilityReq) *v16.ChangeAvailabilityConf {
+	ok, scheduled := p.s.changeAvailability(req, *req.ConnectorId, req.Type == v16.AvailabilityTypeOperative)
+	switch {
+	case !ok:
+		return &v16.ChangeAvailabilityConf{Status: v16.AvailabilityStatusRejected}
+	case scheduled:
+		return &v16.ChangeAvailabilityConf{Status: v16.AvailabilityStatusScheduled}
+	}
+	return &v16.ChangeAvailabilityConf{Status: v16.AvailabilityStatusAccepted}
+}
+
+// unlockConnector stops the transaction of the connector, if any, and
+// unlocks it
+func (p *v16Protocol) unlockConnector(cp *ocpp.ChargePoint, req *v16.UnlockConnectorReq) *v16.UnlockConnectorConf {
+	if known, _ := p.s.unlock(req, *req.ConnectorId, true); !known {
+		return &v16.UnlockConnectorConf{Status: v16.UnlockStatusNotSupported}
+	}
+	return &v16.UnlockConnectorConf{Status: v16.UnlockStatusUnlocked}
+}
+
+// wattHours rounds an energy register to the integer Wh of ocpp1.6
+func wattHours(wh float64) int {
+	return int(math.Round(wh))
+}
@@ -0,0 +1,259 @@
+package simulator
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aliml92/ocpp"
+	"github.com/aliml92/ocpp/v201"
+	"github.com/google/uuid"
+)
+
+// v201Protocol speaks ocpp2.0.1, transactions are reported with
+// TransactionEvent and every connector is connector 1 of its own EVSE
+type v201Protocol struct {
+	s *Station
+
+	mu sync.Mutex
+	// sent is the last ConnectorStatus sent for every EVSE, several
+	// statuses of the simulator map to Occupied
+	sent map[int]v201.ConnectorStatusEnumType
+}
+
+// connectorStatus maps the statuses of the simulator to ocpp2.0.1
+var connectorStatus = map[Status]v201.ConnectorStatusEnumType{
+	Available:     v201.ConnectorStatusAvailable,
+	Preparing:     v201.ConnectorStatusOccupied,
+	Charging:      v201.ConnectorStatusOccupied,
+	SuspendedEVSE: v201.ConnectorStatusOccupied,
+	SuspendedEV:   v201.ConnectorStatusOccupied,
+	Finishing:     v201.ConnectorStatusOccupied,
+	Reserved:      v201.ConnectorStatusReserved,
+	Unavailable:   v201.ConnectorStatusUnavailable,
+	Faulted:       v201.ConnectorStatusFaulted,
+}
+
+// stoppedReasons maps the stop reasons of the simulator to ocpp2.0.1
+var stoppedReasons = map[stopReason]struct {
+	reason  v201.ReasonEnumType
+	trigger v201.TriggerReasonEnumType
+}{
+	reasonLocal:         {v201.ReasonLocal, v201.TriggerReasonStopAuthorized},
+	reasonRemote:        {v201.ReasonRemote, v201.TriggerReasonRemoteStop},
+	reasonSoftReset:     {v201.ReasonImmediateReset, v201.TriggerReasonResetCommand},
+	reasonHardReset:     {v201.ReasonImmediateReset, v201.TriggerReasonResetCommand},
+	reasonUnlockCommand: {v201.ReasonOther, v201.TriggerReasonUnlockCommand},
+	reasonDeAuthorized:  {v201.ReasonDeAuthorized, v201.TriggerReasonDeauthorized},
+}
+
+func (p *v201Protocol) register(s *Station) {
+	p.s = s
+	handle(s, p.requestStartTransaction)
+	handle(s, p.requestStopTransaction)
+	handle(s, p.reset)
+	handle(s, p.changeAvailability)
+	handle(s, p.unlockConnector)
+	s.followUp("RequestStartTransaction", "RequestStopTransaction", "Reset", "ChangeAvailability", "UnlockConnector")
+}
+
+func (p *v201Protocol) boot(ctx context.Context, cp *ocpp.ChargePoint, reset bool) (registration, int, error) {
+	p.mu.Lock()
+	// every status is reported again after booting
+	p.sent = make(map[int]v201.ConnectorStatusEnumType)
+	p.mu.Unlock()
+	reason := v201.BootReasonPowerUp
+	if reset {
+		reason = v201.BootReasonRemoteReset
+	}
+	res, err := ocpp.CallTypedContext[v201.BootNotificationReq, v201.BootNotificationRes](ctx, cp, &v201.BootNotificationReq{
+		ChargingStation: v201.ChargingStationType{
+			Model:        p.s.cfg.Model,
+			VendorName:   p.s.cfg.Vendor,
+			SerialNumber: p.s.cfg.SerialNumber,
+		},
+		Reason: reason,
+	})
+	if err != nil {
+		return "", 0, err
+	}
+	return registration(res.Status), *res.Interval, nil
+}
+
+func (p *v201Protocol) heartbeat(ctx context.Context, cp *ocpp.ChargePoint) error {
+	_, err := ocpp.CallTypedContext[v201.HeartbeatReq, v201.HeartbeatRes](ctx, cp, &v201.HeartbeatReq{})
+	return err
+}
+
+func (p *v201Protocol) status(ctx context.Context, cp *ocpp.ChargePoint, connector int, st Status) error {
+	if connector == 0 {
+		// there is no status of the whole station
+		return nil
+	}
+	status := connectorStatus[st]
+	p.mu.Lock()
+	if p.sent[connector] == status {
+		p.mu.Unlock()
+		return nil
+	}
+	p.sent[connector] = status
+	p.mu.Unlock()
+	_, err := ocpp.CallTypedContext[v201.StatusNotificationReq, v201.StatusNotificationRes](ctx, cp, &v201.StatusNotificationReq{
+		Timestamp:       p.s.now(),
+		ConnectorStatus: status,
+		EvseId:          &connector,
+		ConnectorId:     ptr(1),
+	})
+	return err
+}
+
+func (p *v201Protocol) authorize(ctx context.Context, cp *ocpp.ChargePoint, idTag string) (bool, error) {
+	res, err := ocpp.CallTypedContext[v201.AuthorizeReq, v201.AuthorizeRes](ctx, cp, &v201.AuthorizeReq{
+		IdToken: idToken(idTag, nil),
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.IdTokenInfo.Status == v201.AuthorizationStatusAccepted, nil
+}
+
+func (p *v201Protocol) startTransaction(ctx context.Context, cp *ocpp.ChargePoint, connector int, tx *transaction) (bool, error) {
+	tx.id = uuid.NewString()
+	trigger := v201.TriggerReasonAuthorized
+	if tx.remoteStartId != nil {
+		trigger = v201.TriggerReasonRemoteStart
+	}
+	token := idToken(tx.idTag, tx.remoteStartId)
+	res, err := p.transactionEvent(ctx, cp, connector, tx, &v201.TransactionEventReq{
+		EventType:     v201.TransactionEventStarted,
+		TriggerReason: trigger,
+		MeterValue:    []v201.MeterValueType{p.energy(tx.startWh, v201.ReadingContextTransactionBegin)},
+		TransactionInfo: v201.TransactionType{
+			ChargingState: v201.ChargingStateCharging,
+			RemoteStartId: tx.remoteStartId,
+		},
+		IdToken: &token,
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.IdTokenInfo == nil || res.IdTokenInfo.Status == v201.AuthorizationStatusAccepted, nil
+}
+
+func (p *v201Protocol) meterValues(ctx context.Context, cp *ocpp.ChargePoint, connector int, tx *transaction, wh float64) error {
+	_, err := p.transactionEvent(ctx, cp, connector, tx, &v201.TransactionEventReq{
+		EventType:     v201.TransactionEventUpdated,
+		TriggerReason: v201.TriggerReasonMeterValuePeriodic,
+		MeterValue:    []v201.MeterValueType{p.energy(wh, v201.ReadingContextSamplePeriodic)},
+	})
+	return err
+}
+
+func (p *v201Protocol) stopTransaction(ctx context.Context, cp *ocpp.ChargePoint, connector int, tx *transaction, wh float64, reason stopReason) error {
+	stopped := stoppedReasons[reason]
+	_, err := p.transactionEvent(ctx, cp, connector, tx, &v201.TransactionEventReq{
+		EventType:     v201.TransactionEventEnded,
+		TriggerReason: stopped.trigger,
+		MeterValue:    []v201.MeterValueType{p.energy(wh, v201.ReadingContextTransactionEnd)},
+		TransactionInfo: v201.TransactionType{
+			StoppedReason: stopped.reason,
+		},
+	})
+	return err
+}
+
+// transactionEvent fills in the fields common to every TransactionEvent
+// of tx and sends it
+func (p *v201Protocol) transactionEvent(ctx context.Context, cp *ocpp.ChargePoint, connector int, tx *transaction, req *v201.TransactionEventReq) (*v201.TransactionEventRes, error) {
+	p.s.mu.Lock()
+	req.SeqNo = ptr(tx.seqNo)
+	tx.seqNo++
+	p.s.mu.Unlock()
+	req.Timestamp = p.s.now()
+	req.TransactionInfo.TransactionId = tx.id
+	if req.EventType == v201.TransactionEventStarted {
+		req.Evse = &v201.EVSEType{Id: &connector, ConnectorId: ptr(1)}
+	}
+	return ocpp.CallTypedContext[v201.TransactionEventReq, v201.TransactionEventRes](ctx, cp, req)
+}
+
+func (p *v201Protocol) energy(wh float64, context v201.ReadingContextEnumType) v201.MeterValueType {
+	return v201.MeterValueType{
+		Timestamp: p.s.now(),
+		SampledValue: []v201.SampledValueType{{
+			Value:         &wh,
+			Context:       context,
+			Measurand:     v201.MeasurandEnergyActiveImportRegister,
+			UnitOfMeasure: &v201.UnitOfMeasureType{Unit: "Wh"},
+		}},
+	}
+}
+
+// idToken is a local RFID card, or the central token of a remote start
+func idToken(idTag string, remoteStartId *int) v201.IdTokenType {
+	if remoteStartId != nil {
+		return v201.IdTokenType{IdToken: idTag, Type: v201.IdTokenCentral}
+	}
+	return v201.IdTokenType{IdToken: idTag, Type: v201.IdTokenISO14443}
+}
+
+func (p *v201Protocol) requestStartTransaction(cp *ocpp.ChargePoint, req *v201.RequestStartTransactionReq) *v201.RequestStartTransactionRes {
+	evse := 0
+	if req.EvseId != nil {
+		evse = *req.EvseId
+	}
+	status := v201.RequestStartStopStatusRejected
+	if evse >= 0 && p.s.remoteStart(req, evse, req.IdToken.IdToken, req.RemoteStartId) {
+		status = v201.RequestStartStopStatusAccepted
+	}
+	return &v201.RequestStartTransactionRes{Status: status}
+}
+
+func (p *v201Protocol) requestStopTransaction(cp *ocpp.ChargePoint, req *v201.RequestStopTransactionReq) *v201.RequestStopTransactionRes {
+	status := v201.RequestStartStopStatusRejected
+	if p.s.remoteStop(req, req.TransactionId) {
+		status = v201.RequestStartStopStatusAccepted
+	}
+	return &v201.RequestStopTransactionRes{Status: status}
+}
+
+// reset reboots the whole station, the reset of a single EVSE is rejected
+func (p *v201Protocol) reset(cp *ocpp.ChargePoint, req *v201.ResetReq) *v201.ResetRes {
+	if req.EvseId != nil {
+		return &v201.ResetRes{Status: v201.ResetStatusRejected}
+	}
+	if !p.s.reset(req, reasonSoftReset, req.Type == v201.ResetOnIdle) {
+		return &v201.ResetRes{Status: v201.ResetStatusScheduled}
+	}
+	return &v201.ResetRes{Status: v201.ResetStatusAccepted}
+}
+
+func (p *v201Protocol) changeAvailability(cp *ocpp.ChargePoint, req *v201.ChangeAvailabilityReq) *v201.ChangeAvailabilityRes {
+	evse := 0
+	if req.Evse != nil {
+		evse = *req.Evse.Id
+		if req.Evse.ConnectorId != nil && *req.Evse.ConnectorId != 1 {
+			return &v201.ChangeAvailabilityRes{Status: v201.ChangeAvailabilityStatusRejected}
+		}
+	}
+	ok, scheduled := p.s.changeAvailability(req, evse, req.OperationalStatus == v201.OperationalStatusOperative)
+	switch {
+	case !ok:
+		return &v201.ChangeAvailabilityRes{Status: v201.ChangeAvailabilityStatusRejected}
+	case scheduled:
+		return &v201.ChangeAvailabilityRes{Status: v201.ChangeAvailabilityStatusScheduled}
+	}
+	return &v201.ChangeAvailabilityRes{Status: v201.ChangeAvailabilityStatusAccepted}
+}
+
+// unlockConnector refuses to unlock a connector with a transaction, as
+// required by ocpp2.0.1
+func (p *v201Protocol) unlockConnector(cp *ocpp.ChargePoint, req *v201.UnlockConnectorReq) *v201.UnlockConnectorRes {
+	known, busy := p.s.unlock(req, *req.EvseId, false)
+	switch {
+	case !known || *req.ConnectorId != 1:
+		return &v201.UnlockConnectorRes{Status: v201.UnlockStatusUnknownConnector}
+	case busy:
+		return &v201.UnlockConnectorRes{Status: v201.UnlockStatusOngoingAuthorizedTransaction}
+	}
+	return &v201.UnlockConnectorRes{Status: v201.UnlockStatusUnlocked}
+}
@@ -0,0 +1,120 @@
+package ocpp
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/aliml92/ocpp/v16"
+	"github.com/aliml92/ocpp/v201"
+	"github.com/aliml92/ocpp/v21"
+)
+
+var (
+	pkgPathV16  = reflect.TypeOf(v16.HeartbeatReq{}).PkgPath()
+	pkgPathV201 = reflect.TypeOf(v201.HeartbeatReq{}).PkgPath()
+	pkgPathV21  = reflect.TypeOf(v21.HeartbeatReq{}).PkgPath()
+)
+
+// actionOf returns the action name and protocol tied to the request type Req,
+// e.g. "BootNotification" and "ocpp1.6" for v16.BootNotificationReq, and checks
+// that Res is the matching response type (v16.BootNotificationConf)
+func actionOf[Req any, Res any]() (action string, proto string, err error) {
+	reqType := reflect.TypeOf((*Req)(nil)).Elem()
+	resType := reflect.TypeOf((*Res)(nil)).Elem()
+	var resSuffix string
+	switch reqType.PkgPath() {
+	case pkgPathV16:
+		proto, resSuffix = ocppV16, "Conf"
+	case pkgPathV201:
+		proto, resSuffix = ocppV201, "Res"
+	case pkgPathV21:
+		proto, resSuffix = ocppV21, "Res"
+	default:
+		return "", "", fmt.Errorf("%s is not an ocpp request type", reqType)
+	}
+	if !strings.HasSuffix(reqType.Name(), "Req") {
+		return "", "", fmt.Errorf("%s is not an ocpp request type", reqType)
+	}
+	action = strings.TrimSuffix(reqType.Name(), "Req")
+	if resType.PkgPath() != reqType.PkgPath() || resType.Name() != action+resSuffix {
+		return "", "", fmt.Errorf("%s is not the response type of %s", resType, reqType)
+	}
+	return action, proto, nil
+}
+
+// Handle registers a type-safe handler on a Server or Client for the action
+// tied to Req. It returns an error if Req and Res are not a matching request and
+// response pair of a supported action. Handlers for the same action of both
+// ocpp1.6 and ocpp2.0.1 can be registered on the same peer. An error returned
+// by f is sent as a CallError, errors other than *CallError as GenericError
+func Handle[Req any, Res any](peer Peer, f func(*ChargePoint, *Req) (*Res, error)) error {
+	return HandleContext(peer, func(_ context.Context, cp *ChargePoint, req *Req) (*Res, error) {
+		return f(cp, req)
+	})
+}
+
+// HandleContext is like Handle for a handler that receives a context, it is
+// cancelled when the handler timeout set by SetHandlerTimeout expires
+func HandleContext[Req any, Res any](peer Peer, f func(context.Context, *ChargePoint, *Req) (*Res, error)) error {
+	action, proto, err := actionOf[Req, Res]()
+	if err != nil {
+		return err
+	}
+	if _, ok := requestMap(proto)[action]; !ok {
+		return fmt.Errorf("action %s is not supported for %s", action, proto)
+	}
+	// keep the handler registered for the other protocol version
+	next := peer.getHandler(action)
+	peer.setHandler(action, func(ctx context.Context, cp *ChargePoint, p Payload) Payload {
+		req, ok := p.(*Req)
+		if !ok {
+			if next != nil {
+				return next(ctx, cp, p)
+			}
+			log.Errorf("unexpected payload type %T for action %s", p, action)
+			return NewCallError(NotImplemented, fmt.Sprintf("Action %s is not implemented for %s", action, cp.proto), nil)
+		}
+		res, err := f(ctx, cp, req)
+		if err != nil {
+			return newCallError("", err, cp.proto)
+		}
+		if res == nil {
+			log.Errorf("%s handler of %s returned no response", action, cp.Id)
+			return NewCallError(InternalError, fmt.Sprintf("Handler for %s returned no response", action), nil)
+		}
+		return res
+	})
+	return nil
+}
+
+// CallTyped sends req under the action tied to its type and returns the typed
+// response. It fails without sending anything if Req and Res do not match or
+// the ChargePoint speaks another protocol version than the one of Req
+func CallTyped[Req any, Res any](cp *ChargePoint, req *Req) (*Res, error) {
+	return CallTypedContext[Req, Res](context.Background(), cp, req)
+}
+
+// CallTypedContext is like CallTyped but honours ctx like ChargePoint.CallContext
+func CallTypedContext[Req any, Res any](ctx context.Context, cp *ChargePoint, req *Req) (*Res, error) {
+	action, proto, err := actionOf[Req, Res]()
+	if err != nil {
+		return nil, err
+	}
+	if proto != cp.proto {
+		return nil, fmt.Errorf("cannot send %s request to charge point speaking %s", proto, cp.proto)
+	}
+	if _, ok := responseMap(proto)[action]; !ok {
+		return nil, fmt.Errorf("action %s is not supported for %s", action, proto)
+	}
+	p, err := cp.CallContext(ctx, action, req)
+	if err != nil {
+		return nil, err
+	}
+	res, ok := p.(*Res)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type %T for action %s", p, action)
+	}
+	return res, nil
+}
@@ -0,0 +1,146 @@
+package ocpp
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aliml92/ocpp/v16"
+	"github.com/aliml92/ocpp/v201"
+)
+
+func TestHandleRejectsMismatchedTypes(t *testing.T) {
+	csms := NewServer()
+	if err := Handle(csms, func(cp *ChargePoint, req *v16.BootNotificationReq) (*v16.AuthorizeConf, error) { return nil, nil }); err == nil {
+		t.Error("mismatched response type must be rejected")
+	}
+	if err := Handle(csms, func(cp *ChargePoint, req *v16.BootNotificationReq) (*v201.BootNotificationRes, error) {
+		return nil, nil
+	}); err == nil {
+		t.Error("response type of another version must be rejected")
+	}
+	if err := Handle(csms, func(cp *ChargePoint, req *v16.IdTagInfo) (*v16.AuthorizeConf, error) { return nil, nil }); err == nil {
+		t.Error("non request type must be rejected")
+	}
+	if csms.getHandler("BootNotification") != nil {
+		t.Error("rejected handler must not be registered")
+	}
+}
+
+func TestHandleAndCallTyped(t *testing.T) {
+	csms := NewServer()
+	csms.AddSubProtocol(ocppV16)
+	csms.AddSubProtocol(ocppV201)
+	err := Handle(csms, func(cp *ChargePoint, req *v16.BootNotificationReq) (*v16.BootNotificationConf, error) {
+		return &v16.BootNotificationConf{
+			CurrentTime: time.Now().UTC().Format("2006-01-02T15:04:05Z"),
+			Interval:    ptr(16),
+			Status:      "Accepted",
+		}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Handle(csms, func(cp *ChargePoint, req *v201.BootNotificationReq) (*v201.BootNotificationRes, error) {
+		return &v201.BootNotificationRes{
+			CurrentTime: time.Now().UTC().Format("2006-01-02T15:04:05Z"),
+			Interval:    ptr(201),
+			Status:      "Accepted",
+		}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := newHTTPTestServer(t, csms)
+	cp := newTestClient(t, "cp1", ts.URL)
+
+	res, err := CallTyped[v16.BootNotificationReq, v16.BootNotificationConf](cp, &v16.BootNotificationReq{
+		ChargePointModel:  "model",
+		ChargePointVendor: "vendor",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *res.Interval != 16 {
+		t.Errorf("got interval %d want 16", *res.Interval)
+	}
+	_, err = CallTyped[v201.HeartbeatReq, v201.HeartbeatRes](cp, &v201.HeartbeatReq{})
+	if err == nil || !strings.Contains(err.Error(), ocppV16) {
+		t.Errorf("got %v, want protocol mismatch error", err)
+	}
+}
+
+func TestHandleErrors(t *testing.T) {
+	csms := NewServer()
+	csms.AddSubProtocol(ocppV16)
+	csms.AddSubProtocol(ocppV201)
+	err := Handle(csms, func(cp *ChargePoint, req *v16.AuthorizeReq) (*v16.AuthorizeConf, error) {
+		switch req.IdTag {
+		case "blocked":
+			return nil, NewCallError(SecurityError, "tag is blocked", nil)
+		case "fail":
+			return nil, errors.New("database is down")
+		}
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := newHTTPTestServer(t, csms)
+	cp := newTestClient(t, "cp1", ts.URL)
+	for idTag, want := range map[string]ErrorCode{
+		"blocked": SecurityError,
+		"fail":    GenericError,
+		"none":    InternalError,
+	} {
+		_, err := cp.Call("Authorize", &v16.AuthorizeReq{IdTag: idTag})
+		var callErr *CallError
+		if !errors.As(err, &callErr) || callErr.ErrorCode != want {
+			t.Errorf("%s: got %v want %s", idTag, err, want)
+		}
+	}
+
+	c := NewClient()
+	c.SetID("cs1")
+	c.AddSubProtocol(ocppV201)
+	c.SetCallQueueSize(8)
+	cs, err := c.Start("ws"+strings.TrimPrefix(ts.URL, "http"), "/ws")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cs.Shutdown()
+	_, err = cs.Call("Authorize", &v201.AuthorizeReq{IdToken: v201.IdTokenType{IdToken: "tag", Type: "ISO14443"}})
+	var callErr *CallError
+	if !errors.As(err, &callErr) || callErr.ErrorCode != NotImplemented {
+		t.Errorf("got %v want NotImplemented for the ocpp2.0.1 Authorize", err)
+	}
+}
+
+func TestHandleContextCancelled(t *testing.T) {
+	csms := NewServer()
+	csms.AddSubProtocol(ocppV16)
+	csms.SetHandlerTimeout("Authorize", 100*time.Millisecond)
+	cancelled := make(chan struct{})
+	err := HandleContext(csms, func(ctx context.Context, cp *ChargePoint, req *v16.AuthorizeReq) (*v16.AuthorizeConf, error) {
+		<-ctx.Done()
+		close(cancelled)
+		return nil, ctx.Err()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := newHTTPTestServer(t, csms)
+	cp := newTestClient(t, "cp1", ts.URL)
+	_, err = cp.Call("Authorize", &v16.AuthorizeReq{IdTag: "tag"})
+	var callErr *CallError
+	if !errors.As(err, &callErr) || callErr.ErrorCode != InternalError {
+		t.Fatalf("got %v want InternalError CallError", err)
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("handler context was not cancelled")
+	}
+}
@@ -1,3 +1,5 @@
+// Code generated by ocppgen from the OCPP JSON schemas. DO NOT EDIT.
+
 package v16
 
 type AuthorizeReq struct {
@@ -5,96 +7,71 @@ type AuthorizeReq struct {
 }
 
 type BootNotificationReq struct {
-	ChargeBoxSerialNumber   string `json:"chargeBoxSerialNumber,omitempty" validate:"omitempty,max=25"`
+	ChargePointVendor       string `json:"chargePointVendor" validate:"required,max=20"`
 	ChargePointModel        string `json:"chargePointModel" validate:"required,max=20"`
 	ChargePointSerialNumber string `json:"chargePointSerialNumber,omitempty" validate:"omitempty,max=25"`
-	ChargePointVendor       string `json:"chargePointVendor" validate:"required,max=20"`
+	ChargeBoxSerialNumber   string `json:"chargeBoxSerialNumber,omitempty" validate:"omitempty,max=25"`
 	FirmwareVersion         string `json:"firmwareVersion,omitempty" validate:"omitempty,max=50"`
 	Iccid                   string `json:"iccid,omitempty" validate:"omitempty,max=20"`
 	Imsi                    string `json:"imsi,omitempty" validate:"omitempty,max=20"`
-	MeterSerialNumber       string `json:"meterSerialNumber,omitempty" validate:"omitempty,max=25"`
 	MeterType               string `json:"meterType,omitempty" validate:"omitempty,max=25"`
+	MeterSerialNumber       string `json:"meterSerialNumber,omitempty" validate:"omitempty,max=25"`
 }
 
-type DataTransferReq struct {
-	VendorId  string `json:"vendorId" validate:"required,max=255"`
-	MessageId string `json:"messageId,omitempty" validate:"omitempty,max=255"`
-	Data      string `json:"data,omitempty"`
+type CancelReservationReq struct {
+	ReservationId *int `json:"reservationId" validate:"required"`
 }
 
-type DiagnosticsStatusNotificationReq struct {
-	Status string `json:"status" validate:"required,DiagnosticsStatus"`
+type CertificateSignedReq struct {
+	CertificateChain string `json:"certificateChain" validate:"required,max=10000"`
 }
 
-type FirmwareStatusNotificationReq struct {
-	Status string `json:"status" validate:"required,FirmwareStatus"`
+type ChangeAvailabilityReq struct {
+	ConnectorId *int             `json:"connectorId" validate:"required"`
+	Type        AvailabilityType `json:"type" validate:"required,AvailabilityType"`
 }
 
-type HeartbeatReq struct{}
-
-type MeterValuesReq struct {
-	ConnectorId   *int         `json:"connectorId" validate:"required,gte=0"`
-	TransactionId int         `json:"transactionId"`
-	MeterValue    []MeterValue `json:"meterValue" validate:"required,gt=0,dive"`
+type ChangeConfigurationReq struct {
+	Key   string `json:"key" validate:"required,max=50"`
+	Value string `json:"value" validate:"required,max=500"`
 }
 
-type StartTransactionReq struct {
-	ConnectorId   int    `json:"connectorId" validate:"required,gt=0"`
-	IdTag         string `json:"idTag" validate:"required,max=20"`
-	MeterStart    *int   `json:"meterStart" validate:"required,gte=0"`
-	ReservationId int    `json:"reservationId,omitempty" validate:"omitempty"`
-	Timestamp     string `json:"timestamp" validate:"required,ISO8601date"`
-}
+type ClearCacheReq struct{}
 
-type StatusNotificationReq struct {
-	ConnectorId     *int   `json:"connectorId" validate:"required,gte=0"`
-	ErrorCode       string `json:"errorCode" validate:"required,ChargePointErrorCode"`
-	Info            string `json:"info,omitempty" validate:"omitempty,max=50"`
-	Status          string `json:"status" validate:"required,ChargePointStatus"`
-	Timestamp       string `json:"timestamp,omitempty" validate:"omitempty,ISO8601date"`
-	VendorId        string `json:"vendorId,omitempty" validate:"omitempty,max=255"`
-	VendorErrorCode string `json:"vendorErrorCode,omitempty" validate:"omitempty,max=50"`
+type ClearChargingProfileReq struct {
+	Id                     *int                       `json:"id,omitempty"`
+	ConnectorId            *int                       `json:"connectorId,omitempty"`
+	ChargingProfilePurpose ChargingProfilePurposeType `json:"chargingProfilePurpose,omitempty" validate:"omitempty,ChargingProfilePurposeType"`
+	StackLevel             *int                       `json:"stackLevel,omitempty"`
 }
 
-// temporary type for unmarshalling
-type StopTransactionReq struct {
-	IdTag           string       `json:"idTag" validate:"omitempty,max=20"`
-	MeterStop       *int          `json:"meterStop" validate:"required"`
-	Timestamp       string       `json:"timestamp" validate:"required,ISO8601date"`
-	TransactionId   int          `json:"transactionId" validate:"required"`
-	Reason          string       `json:"reason,omitempty" validate:"omitempty,Reason"`
-	TransactionData []MeterValue `json:"transactionData,omitempty" validate:"omitempty,dive,required"`
+type DataTransferReq struct {
+	VendorId  string `json:"vendorId" validate:"required,max=255"`
+	MessageId string `json:"messageId,omitempty" validate:"omitempty,max=50"`
+	Data      string `json:"data,omitempty"`
 }
 
-type CancelReservationReq struct {
-	ReservationId int `json:"reservationId" validate:"required"`
+type DeleteCertificateReq struct {
+	CertificateHashData CertificateHashDataType `json:"certificateHashData"`
 }
 
-// actions by csms
-
-type ChangeAvailabilityReq struct {
-	ConnectorId *int   `json:"connectorId" validate:"required,gte=0"`
-	Type        string `json:"type" validate:"required,AvailabilityType"`
+type DiagnosticsStatusNotificationReq struct {
+	Status DiagnosticsStatus `json:"status" validate:"required,DiagnosticsStatus"`
 }
 
-type ChangeConfigurationReq struct {
-	Key   string `json:"key" validate:"required,max=50"`
-	Value string `json:"value" validate:"required,max=50"`
+type ExtendedTriggerMessageReq struct {
+	RequestedMessage MessageTriggerEnumType `json:"requestedMessage" validate:"required,MessageTriggerEnumType"`
+	ConnectorId      *int                   `json:"connectorId,omitempty"`
 }
 
-type ClearCacheReq struct{}
-
-type ClearChargingProfileReq struct {
-	Id                     string `json:"id,omitempty"`
-	ConnectorId            *int   `json:"connectorId,omitempty" validate:"omitempty,gte=0"`
-	ChargingProfilePurpose string `json:"chargingProfilePurpose,omitempty" validate:"omitempty,ChargingProfilePurpose"`
-	StackLevel             *int   `json:"stackLevel,omitempty" validate:"omitempty,gte=0"`
+type FirmwareStatusNotificationReq struct {
+	Status FirmwareStatus `json:"status" validate:"required,FirmwareStatus"`
 }
 
 type GetCompositeScheduleReq struct {
-	ConnectorId      *int   `json:"connectorId,omitempty" validate:"omitempty,gte=0"`
-	Duration         int   `json:"duration" validate:"required,gt=0"`
-	ChargingRateUnit string `json:"chargingRateUnit,omitempty" validate:"omitempty,ChargingRateUnit"`
+	ConnectorId      *int                 `json:"connectorId" validate:"required"`
+	Duration         *int                 `json:"duration" validate:"required"`
+	ChargingRateUnit ChargingRateUnitType `json:"chargingRateUnit,omitempty" validate:"omitempty,ChargingRateUnitType"`
 }
 
 type GetConfigurationReq struct {
@@ -102,120 +79,139 @@ type GetConfigurationReq struct {
 }
 
 type GetDiagnosticsReq struct {
-	Location      string `json:"location" validate:"required"`
-	Retries       int   `json:"retries,omitempty" validate:"omitempty,gt=0"`
-	RetryInterval int   `json:"retryInterval,omitempty" validate:"omitempty,gt=0"`
+	Location      string `json:"location" validate:"required,uri"`
+	Retries       *int   `json:"retries,omitempty"`
+	RetryInterval *int   `json:"retryInterval,omitempty"`
 	StartTime     string `json:"startTime,omitempty" validate:"omitempty,ISO8601date"`
 	StopTime      string `json:"stopTime,omitempty" validate:"omitempty,ISO8601date"`
 }
 
+type GetInstalledCertificateIdsReq struct {
+	CertificateType CertificateUseEnumType `json:"certificateType" validate:"required,CertificateUseEnumType"`
+}
+
 type GetLocalListVersionReq struct{}
 
+type GetLogReq struct {
+	Log           LogParametersType `json:"log"`
+	LogType       LogEnumType       `json:"logType" validate:"required,LogEnumType"`
+	RequestId     *int              `json:"requestId" validate:"required"`
+	Retries       *int              `json:"retries,omitempty"`
+	RetryInterval *int              `json:"retryInterval,omitempty"`
+}
+
+type HeartbeatReq struct{}
+
+type InstallCertificateReq struct {
+	CertificateType CertificateUseEnumType `json:"certificateType" validate:"required,CertificateUseEnumType"`
+	Certificate     string                 `json:"certificate" validate:"required,max=5500"`
+}
+
+type LogStatusNotificationReq struct {
+	Status    UploadLogStatusEnumType `json:"status" validate:"required,UploadLogStatusEnumType"`
+	RequestId *int                    `json:"requestId,omitempty"`
+}
+
+type MeterValuesReq struct {
+	ConnectorId   *int         `json:"connectorId" validate:"required"`
+	TransactionId *int         `json:"transactionId,omitempty"`
+	MeterValue    []MeterValue `json:"meterValue" validate:"required,dive"`
+}
+
 type RemoteStartTransactionReq struct {
-	ConnectorId     *int             `json:"connectorId,omitempty" validate:"omitempty,gte=0"`
+	ConnectorId     *int             `json:"connectorId,omitempty"`
 	IdTag           string           `json:"idTag" validate:"required,max=20"`
 	ChargingProfile *ChargingProfile `json:"chargingProfile,omitempty"`
 }
 
 type RemoteStopTransactionReq struct {
-	TransactionId int `json:"transactionId" validate:"required"`
+	TransactionId *int `json:"transactionId" validate:"required"`
 }
 
 type ReserveNowReq struct {
-	ConnectorId   *int   `json:"connectorId" validate:"required,gte=0"`
+	ConnectorId   *int   `json:"connectorId" validate:"required"`
 	ExpiryDate    string `json:"expiryDate" validate:"required,ISO8601date"`
 	IdTag         string `json:"idTag" validate:"required,max=20"`
 	ParentIdTag   string `json:"parentIdTag,omitempty" validate:"omitempty,max=20"`
-	ReservationId int    `json:"reservationId" validate:"required"`
+	ReservationId *int   `json:"reservationId" validate:"required"`
 }
 
 type ResetReq struct {
-	Type string `json:"type" validate:"required,ResetType"`
-}
-
-type SendLocalListReq struct {
-	ListVersion            *int                `json:"listVersion" validate:"required,gte=0"`
-	LocalAuthorizationList []AuthorizationData `json:"localAuthorizationList,omitempty" validate:"omitempty,dive,required"`
-	UpdateType             string              `json:"updateType" validate:"required,UpdateType"`
-}
-
-type SetChargingProfileReq struct {
-	ConnectorId        *int            `json:"connectorId" validate:"required,gte=0"`
-	CsChargingProfiles ChargingProfile `json:"csChargingProfiles" validate:"required"`
-}
-
-type TriggerMessageReq struct {
-	RequestedMessage string `json:"requestedMessage" validate:"required,MessageTrigger"`
-	ConnectorId      *int   `json:"connectorId,omitempty" validate:"omitempty,gte=0"`
-}
-
-type UnlockConnectorReq struct {
-	ConnectorId *int `json:"connectorId" validate:"required,gte=0"`
+	Type ResetType `json:"type" validate:"required,ResetType"`
 }
 
-type UpdateFirmwareReq struct {
-	Location      string `json:"location" validate:"required"`
-	Retries       int   `json:"retries,omitempty" validate:"omitempty,gt=0"`
-	RetrieveDate  string `json:"retrieveDate" validate:"required,ISO8601date"`
-	RetryInterval int   `json:"retryInterval,omitempty" validate:"omitempty,gt=0"`
+type SecurityEventNotificationReq struct {
+	Type      string `json:"type" validate:"required,max=50"`
+	Timestamp string `json:"timestamp" validate:"required,ISO8601date"`
+	TechInfo  string `json:"techInfo,omitempty" validate:"omitempty,max=255"`
 }
 
-// OCPP 1.6 security whitepaper edition 2 implementation
-
-type CertificateSignedReq struct {
-	CertificateChain string `json:"certificateChain" validate:"required,max=10000"`
+type SendLocalListReq struct {
+	ListVersion            *int                `json:"listVersion" validate:"required"`
+	LocalAuthorizationList []AuthorizationData `json:"localAuthorizationList,omitempty" validate:"omitempty,dive"`
+	UpdateType             UpdateType          `json:"updateType" validate:"required,UpdateType"`
 }
 
-type DeleteCertificateReq struct {
-	CertificateHashData CertificateHashDataType `json:"certificateHashData" validate:"required"`
+type SetChargingProfileReq struct {
+	ConnectorId        *int            `json:"connectorId" validate:"required"`
+	CsChargingProfiles ChargingProfile `json:"csChargingProfiles"`
 }
 
-type ExtendedTriggerMessageReq struct {
-	RequestedMessage string `json:"requestedMessage" validate:"required,MessageTriggerEnumType"`
-	ConnectorId      *int    `json:"connectorId,omitempty" validate:"omitempty,gt=0"`
+type SignCertificateReq struct {
+	Csr string `json:"csr" validate:"required,max=5500"`
 }
 
-type GetInstalledCertificateIdsReq struct {
-	CertificateType string `json:"certificateType" validate:"required,CertificateUseEnumType"`
+type SignedFirmwareStatusNotificationReq struct {
+	Status    FirmwareStatusEnumType `json:"status" validate:"required,FirmwareStatusEnumType"`
+	RequestId *int                   `json:"requestId,omitempty"`
 }
 
-type GetLogReq struct {
-	LogType       string `json:"logType" validate:"required,LogEnumType"`
-	RequestId     int    `json:"requestId" validate:"required"`
-	Retries       int   `json:"retries,omitempty" validate:"omitempty,gt=0"`
-	RetryInterval int   `json:"retryInterval,omitempty" validate:"omitempty,gt=0"`
-	Log           string `json:"log" validate:"required,LogParametersType"`
+type SignedUpdateFirmwareReq struct {
+	Retries       *int         `json:"retries,omitempty"`
+	RetryInterval *int         `json:"retryInterval,omitempty"`
+	RequestId     *int         `json:"requestId" validate:"required"`
+	Firmware      FirmwareType `json:"firmware"`
 }
 
-type InstallCertificateReq struct {
-	CertificateType string `json:"certificateType" validate:"required,CertificateUseEnumType"`
-	Certificate     string `json:"certificate" validate:"required,max=5500"`
+type StartTransactionReq struct {
+	ConnectorId   *int   `json:"connectorId" validate:"required"`
+	IdTag         string `json:"idTag" validate:"required,max=20"`
+	MeterStart    *int   `json:"meterStart" validate:"required"`
+	ReservationId *int   `json:"reservationId,omitempty"`
+	Timestamp     string `json:"timestamp" validate:"required,ISO8601date"`
 }
 
-type LogStatusNotificationReq struct {
-	Status    string `json:"status" validate:"required,UploadLogStatusEnumType"`
-	RequestId int    `json:"requestId,omitempty"`
+type StatusNotificationReq struct {
+	ConnectorId     *int                 `json:"connectorId" validate:"required"`
+	ErrorCode       ChargePointErrorCode `json:"errorCode" validate:"required,ChargePointErrorCode"`
+	Info            string               `json:"info,omitempty" validate:"omitempty,max=50"`
+	Status          ChargePointStatus    `json:"status" validate:"required,ChargePointStatus"`
+	Timestamp       string               `json:"timestamp,omitempty" validate:"omitempty,ISO8601date"`
+	VendorId        string               `json:"vendorId,omitempty" validate:"omitempty,max=255"`
+	VendorErrorCode string               `json:"vendorErrorCode,omitempty" validate:"omitempty,max=50"`
 }
 
-type SecurityEventNotificationReq struct {
-	Type      string `json:"type" validate:"required,max=50"`
-	Timestamp string `json:"timestamp" validate:"required,ISO8601date"`
-	TechInfo  string `json:"techInfo,omitempty" validate:"omitempty,max=255"`
+type StopTransactionReq struct {
+	IdTag           string       `json:"idTag,omitempty" validate:"omitempty,max=20"`
+	MeterStop       *int         `json:"meterStop" validate:"required"`
+	Timestamp       string       `json:"timestamp" validate:"required,ISO8601date"`
+	TransactionId   *int         `json:"transactionId" validate:"required"`
+	Reason          Reason       `json:"reason,omitempty" validate:"omitempty,Reason"`
+	TransactionData []MeterValue `json:"transactionData,omitempty" validate:"omitempty,dive"`
 }
 
-type SignCertificateReq struct {
-	Csr    string `json:"csr" validate:"required,max=5500"`
-	Status string `json:"status" validate:"required,CertificateStatusEnumType"`
+type TriggerMessageReq struct {
+	RequestedMessage MessageTrigger `json:"requestedMessage" validate:"required,MessageTrigger"`
+	ConnectorId      *int           `json:"connectorId,omitempty"`
 }
 
-type SignedFirmwareStatusNotificationReq struct {
-	Status    string `json:"status" validate:"required,FirmwareStatusEnumType"`
-	RequestId int    `json:"requestId,omitempty"`
+type UnlockConnectorReq struct {
+	ConnectorId *int `json:"connectorId" validate:"required"`
 }
 
-type SignedUpdateFirmwareReq struct {
-	Retries       int   `json:"retries,omitempty" validate:"omitempty,gt=0"`
-	RetryInterval int   `json:"retryInterval,omitempty" validate:"omitempty,gt=0"`
-	RequestId     int    `json:"requestId" validate:"required"`
-	Firmware      string `json:"firmware" validate:"required,FirewareType"`
+type UpdateFirmwareReq struct {
+	Location      string `json:"location" validate:"required,uri"`
+	Retries       *int   `json:"retries,omitempty"`
+	RetrieveDate  string `json:"retrieveDate" validate:"required,ISO8601date"`
+	RetryInterval *int   `json:"retryInterval,omitempty"`
 }
@@ -1,153 +1,153 @@
+// Code generated by ocppgen from the OCPP JSON schemas. DO NOT EDIT.
+
 package v16
 
 type AuthorizeConf struct {
-	IdTagInfo IdTagInfo `json:"idTagInfo" validate:"required"`
+	IdTagInfo IdTagInfo `json:"idTagInfo"`
 }
 
 type BootNotificationConf struct {
-	CurrentTime string `json:"currentTime" validate:"required,ISO8601date"`
-	Interval    int   `json:"interval" validate:"required,gte=0"`
-	Status      string `json:"status" validate:"required,RegistrationStatus"`
+	Status      RegistrationStatus `json:"status" validate:"required,RegistrationStatus"`
+	CurrentTime string             `json:"currentTime" validate:"required,ISO8601date"`
+	Interval    *int               `json:"interval" validate:"required"`
 }
 
-type DataTransferConf struct {
-	Status string `json:"status" validate:"required,DataTransferStatus"`
-	Data   string `json:"data,omitempty"`
+type CancelReservationConf struct {
+	Status CancelReservationStatus `json:"status" validate:"required,CancelReservationStatus"`
 }
 
-type DiagnosticsStatusNotificationConf struct{}
-
-type FirmwareStatusNotificationConf struct{}
-
-type HeartbeatConf struct {
-	CurrentTime string `json:"currentTime" validate:"required,ISO8601date"`
+type CertificateSignedConf struct {
+	Status CertificateSignedStatusEnumType `json:"status" validate:"required,CertificateSignedStatusEnumType"`
 }
 
-type MeterValuesConf struct{}
-
-type StartTransactionConf struct {
-	IdTagInfo     IdTagInfo `json:"idTagInfo" validate:"required"`
-	TransactionId int       `json:"transactionId" validate:"required"`
+type ChangeAvailabilityConf struct {
+	Status AvailabilityStatus `json:"status" validate:"required,AvailabilityStatus"`
 }
 
-type StatusNotificationConf struct{}
-
-type StopTransactionConf struct {
-	IdTagInfo IdTagInfo `json:"idTagInfo" validate:"required"`
+type ChangeConfigurationConf struct {
+	Status ConfigurationStatus `json:"status" validate:"required,ConfigurationStatus"`
 }
 
-type CancelReservationConf struct {
-	Status string `json:"status" validate:"required,CancelReservationStatus"`
+type ClearCacheConf struct {
+	Status ClearCacheStatus `json:"status" validate:"required,ClearCacheStatus"`
 }
 
-type ChangeAvailabilityConf struct {
-	Status string `json:"status" validate:"required,AvailabilityStatus"`
+type ClearChargingProfileConf struct {
+	Status ClearChargingProfileStatus `json:"status" validate:"required,ClearChargingProfileStatus"`
 }
 
-type ChangeConfigurationConf struct {
-	Status string `json:"status" validate:"required,ConfigurationStatus"`
+type DataTransferConf struct {
+	Status DataTransferStatus `json:"status" validate:"required,DataTransferStatus"`
+	Data   string             `json:"data,omitempty"`
 }
 
-type ClearCacheConf struct {
-	Status string `json:"status" validate:"required,ClearCacheStatus"`
+type DeleteCertificateConf struct {
+	Status DeleteCertificateStatusEnumType `json:"status" validate:"required,DeleteCertificateStatusEnumType"`
 }
 
-type ClearChargingProfileConf struct {
-	Status string `json:"status" validate:"required,ClearChargingProfileStatus"`
+type DiagnosticsStatusNotificationConf struct{}
+
+type ExtendedTriggerMessageConf struct {
+	Status TriggerMessageStatusEnumType `json:"status" validate:"required,TriggerMessageStatusEnumType"`
 }
 
+type FirmwareStatusNotificationConf struct{}
+
 type GetCompositeScheduleConf struct {
-	Status           string           `json:"status" validate:"required,GetCompositeScheduleStatus"`
-	ConnectorId      int              `json:"connectorId" validate:"required,gte=0"`
-	ScheduleStart    string           `json:"scheduleStart,omitempty" validate:"omitempty,ISO8601date"`
-	ChargingSchedule *ChargingSchedule `json:"chargingSchedule,omitempty"`
+	Status           GetCompositeScheduleStatus `json:"status" validate:"required,GetCompositeScheduleStatus"`
+	ConnectorId      *int                       `json:"connectorId,omitempty"`
+	ScheduleStart    string                     `json:"scheduleStart,omitempty" validate:"omitempty,ISO8601date"`
+	ChargingSchedule *ChargingSchedule          `json:"chargingSchedule,omitempty"`
 }
 
 type GetConfigurationConf struct {
-	ConfigurationKey map[string]string `json:"configurationKey,omitempty"`
-	UnknownKey       []string          `json:"unknownKey,omitempty" validate:"omitempty,max=50"`
+	ConfigurationKey []KeyValue `json:"configurationKey,omitempty" validate:"omitempty,dive"`
+	UnknownKey       []string   `json:"unknownKey,omitempty" validate:"omitempty,dive,max=50"`
 }
 
 type GetDiagnosticsConf struct {
 	FileName string `json:"fileName,omitempty" validate:"omitempty,max=255"`
 }
 
-type GetLocalListVersionConf struct {
-	ListVersion int `json:"listVersion" validate:"required,gte=0"`
+type GetInstalledCertificateIdsConf struct {
+	CertificateHashData []CertificateHashDataType             `json:"certificateHashData,omitempty" validate:"omitempty,min=1,dive"`
+	Status              GetInstalledCertificateStatusEnumType `json:"status" validate:"required,GetInstalledCertificateStatusEnumType"`
 }
 
-type RemoteStartTransactionConf struct {
-	Status string `json:"status" validate:"required,RemoteStartStopStatus"`
+type GetLocalListVersionConf struct {
+	ListVersion *int `json:"listVersion" validate:"required"`
 }
 
-type RemoteStopTransactionConf struct {
-	Status string `json:"status" validate:"required,RemoteStartStopStatus"`
+type GetLogConf struct {
+	Status   LogStatusEnumType `json:"status" validate:"required,LogStatusEnumType"`
+	Filename string            `json:"filename,omitempty" validate:"omitempty,max=255"`
 }
 
-type ReserveNowConf struct {
-	Status string `json:"status" validate:"required,ReservationStatus"`
+type HeartbeatConf struct {
+	CurrentTime string `json:"currentTime" validate:"required,ISO8601date"`
 }
 
-type ResetConf struct {
-	Status string `json:"status" validate:"required,ResetStatus"`
+type InstallCertificateConf struct {
+	Status InstallCertificateStatusEnumType `json:"status" validate:"required,InstallCertificateStatusEnumType"`
 }
 
-type SendLocalListConf struct {
-	Status string `json:"status" validate:"required,UpdateStatus"`
-}
+type LogStatusNotificationConf struct{}
 
-type SetChargingProfileConf struct {
-	Status string `json:"status" validate:"required,ChargingProfileStatus"`
+type MeterValuesConf struct{}
+
+type RemoteStartTransactionConf struct {
+	Status RemoteStartStopStatus `json:"status" validate:"required,RemoteStartStopStatus"`
 }
 
-type TriggerMessageConf struct {
-	Status string `json:"status" validate:"required,TriggerMessageStatus"`
+type RemoteStopTransactionConf struct {
+	Status RemoteStartStopStatus `json:"status" validate:"required,RemoteStartStopStatus"`
 }
 
-type UnlockConnectorConf struct {
-	Status string `json:"status" validate:"required,UnlockStatus"`
+type ReserveNowConf struct {
+	Status ReservationStatus `json:"status" validate:"required,ReservationStatus"`
 }
 
-type UpdateFirmwareConf struct{}
+type ResetConf struct {
+	Status ResetStatus `json:"status" validate:"required,ResetStatus"`
+}
 
-// OCPP 1.6 security whitepaper edition 2 implementation
+type SecurityEventNotificationConf struct{}
 
-type CertificateSignedConf struct {
-	Status string `json:"status" validate:"required,CertificateSignedStatusEnumType"`
+type SendLocalListConf struct {
+	Status UpdateStatus `json:"status" validate:"required,UpdateStatus"`
 }
 
-type DeleteCertificateConf struct {
-	Status string `json:"status" validate:"required,DeleteCertificateStatusEnumType"`
+type SetChargingProfileConf struct {
+	Status ChargingProfileStatus `json:"status" validate:"required,ChargingProfileStatus"`
 }
 
-type ExtendedTriggerMessageConf struct {
-	Status string `json:"status" validate:"required,TriggerMessageStatusEnumType"`
+type SignCertificateConf struct {
+	Status GenericStatusEnumType `json:"status" validate:"required,GenericStatusEnumType"`
 }
 
-type GetInstalledCertificateIdsConf struct {
-	Status              string                    `json:"status" validate:"required,GetInstalledCertificateStatusEnumType"`
-	CertificateHashData []CertificateHashDataType `json:"certificateHashData,omitempty" validate:"omitempty,dive,required"`
-}
+type SignedFirmwareStatusNotificationConf struct{}
 
-type GetLogConf struct {
-	Status   string `json:"status" validate:"required,LogStatusEnumType"`
-	Filename string `json:"filename,omitempty" validate:"omitempty,max=255"`
+type SignedUpdateFirmwareConf struct {
+	Status UpdateFirmwareStatusEnumType `json:"status" validate:"required,UpdateFirmwareStatusEnumType"`
 }
 
-type InstallCertificateConf struct {
-	Status string `json:"status" validate:"required,CertificateStatusEnumType"`
+type StartTransactionConf struct {
+	IdTagInfo     IdTagInfo `json:"idTagInfo"`
+	TransactionId *int      `json:"transactionId" validate:"required"`
 }
 
-type LogStatusNotificationConf struct{}
-
-type SecurityEventNotificationConf struct{}
+type StatusNotificationConf struct{}
 
-type SignCertificateConf struct {
-	Status string `json:"status" validate:"required,GenericStatusEnumType"`
+type StopTransactionConf struct {
+	IdTagInfo *IdTagInfo `json:"idTagInfo,omitempty"`
 }
 
-type SignedFirmwareStatusNotificationConf struct{}
+type TriggerMessageConf struct {
+	Status TriggerMessageStatus `json:"status" validate:"required,TriggerMessageStatus"`
+}
 
-type SignedUpdateFirmwareConf struct {
-	Status string `json:"status" validate:"required,UpdateFirmwareStatusEnumType"`
+type UnlockConnectorConf struct {
+	Status UnlockStatus `json:"status" validate:"required,UnlockStatus"`
 }
+
+type UpdateFirmwareConf struct{}
@@ -0,0 +1,1120 @@
+// Code generated by ocppgen from the OCPP JSON schemas. DO NOT EDIT.
+
+package v16
+
+type AuthorizationStatus string
+
+const (
+	AuthorizationStatusAccepted     AuthorizationStatus = "Accepted"
+	AuthorizationStatusBlocked      AuthorizationStatus = "Blocked"
+	AuthorizationStatusExpired      AuthorizationStatus = "Expired"
+	AuthorizationStatusInvalid      AuthorizationStatus = "Invalid"
+	AuthorizationStatusConcurrentTx AuthorizationStatus = "ConcurrentTx"
+)
+
+// IsValid reports whether e is one of the AuthorizationStatus values
+func (e AuthorizationStatus) IsValid() bool {
+	switch e {
+	case AuthorizationStatusAccepted,
+		AuthorizationStatusBlocked,
+		AuthorizationStatusExpired,
+		AuthorizationStatusInvalid,
+		AuthorizationStatusConcurrentTx:
+		return true
+	}
+	return false
+}
+
+type AvailabilityStatus string
+
+const (
+	AvailabilityStatusAccepted  AvailabilityStatus = "Accepted"
+	AvailabilityStatusRejected  AvailabilityStatus = "Rejected"
+	AvailabilityStatusScheduled AvailabilityStatus = "Scheduled"
+)
+
+// IsValid reports whether e is one of the AvailabilityStatus values
+func (e AvailabilityStatus) IsValid() bool {
+	switch e {
+	case AvailabilityStatusAccepted,
+		AvailabilityStatusRejected,
+		AvailabilityStatusScheduled:
+		return true
+	}
+	return false
+}
+
+type AvailabilityType string
+
+const (
+	AvailabilityTypeInoperative AvailabilityType = "Inoperative"
+	AvailabilityTypeOperative   AvailabilityType = "Operative"
+)
+
+// IsValid reports whether e is one of the AvailabilityType values
+func (e AvailabilityType) IsValid() bool {
+	switch e {
+	case AvailabilityTypeInoperative,
+		AvailabilityTypeOperative:
+		return true
+	}
+	return false
+}
+
+type CancelReservationStatus string
+
+const (
+	CancelReservationStatusAccepted CancelReservationStatus = "Accepted"
+	CancelReservationStatusRejected CancelReservationStatus = "Rejected"
+)
+
+// IsValid reports whether e is one of the CancelReservationStatus values
+func (e CancelReservationStatus) IsValid() bool {
+	switch e {
+	case CancelReservationStatusAccepted,
+		CancelReservationStatusRejected:
+		return true
+	}
+	return false
+}
+
+type CertificateSignedStatusEnumType string
+
+const (
+	CertificateSignedStatusAccepted CertificateSignedStatusEnumType = "Accepted"
+	CertificateSignedStatusRejected CertificateSignedStatusEnumType = "Rejected"
+)
+
+// IsValid reports whether e is one of the CertificateSignedStatusEnumType values
+func (e CertificateSignedStatusEnumType) IsValid() bool {
+	switch e {
+	case CertificateSignedStatusAccepted,
+		CertificateSignedStatusRejected:
+		return true
+	}
+	return false
+}
+
+type CertificateUseEnumType string
+
+const (
+	CertificateUseCentralSystemRootCertificate CertificateUseEnumType = "CentralSystemRootCertificate"
+	CertificateUseManufacturerRootCertificate  CertificateUseEnumType = "ManufacturerRootCertificate"
+)
+
+// IsValid reports whether e is one of the CertificateUseEnumType values
+func (e CertificateUseEnumType) IsValid() bool {
+	switch e {
+	case CertificateUseCentralSystemRootCertificate,
+		CertificateUseManufacturerRootCertificate:
+		return true
+	}
+	return false
+}
+
+type ChargePointErrorCode string
+
+const (
+	ChargePointErrorCodeConnectorLockFailure ChargePointErrorCode = "ConnectorLockFailure"
+	ChargePointErrorCodeEVCommunicationError ChargePointErrorCode = "EVCommunicationError"
+	ChargePointErrorCodeGroundFailure        ChargePointErrorCode = "GroundFailure"
+	ChargePointErrorCodeHighTemperature      ChargePointErrorCode = "HighTemperature"
+	ChargePointErrorCodeInternalError        ChargePointErrorCode = "InternalError"
+	ChargePointErrorCodeLocalListConflict    ChargePointErrorCode = "LocalListConflict"
+	ChargePointErrorCodeNoError              ChargePointErrorCode = "NoError"
+	ChargePointErrorCodeOtherError           ChargePointErrorCode = "OtherError"
+	ChargePointErrorCodeOverCurrentFailure   ChargePointErrorCode = "OverCurrentFailure"
+	ChargePointErrorCodePowerMeterFailure    ChargePointErrorCode = "PowerMeterFailure"
+	ChargePointErrorCodePowerSwitchFailure   ChargePointErrorCode = "PowerSwitchFailure"
+	ChargePointErrorCodeReaderFailure        ChargePointErrorCode = "ReaderFailure"
+	ChargePointErrorCodeResetFailure         ChargePointErrorCode = "ResetFailure"
+	ChargePointErrorCodeUnderVoltage         ChargePointErrorCode = "UnderVoltage"
+	ChargePointErrorCodeOverVoltage          ChargePointErrorCode = "OverVoltage"
+	ChargePointErrorCodeWeakSignal           ChargePointErrorCode = "WeakSignal"
+)
+
+// IsValid reports whether e is one of the ChargePointErrorCode values
+func (e ChargePointErrorCode) IsValid() bool {
+	switch e {
+	case ChargePointErrorCodeConnectorLockFailure,
+		ChargePointErrorCodeEVCommunicationError,
+		ChargePointErrorCodeGroundFailure,
+		ChargePointErrorCodeHighTemperature,
+		ChargePointErrorCodeInternalError,
+		ChargePointErrorCodeLocalListConflict,
+		ChargePointErrorCodeNoError,
+		ChargePointErrorCodeOtherError,
+		ChargePointErrorCodeOverCurrentFailure,
+		ChargePointErrorCodePowerMeterFailure,
+		ChargePointErrorCodePowerSwitchFailure,
+		ChargePointErrorCodeReaderFailure,
+		ChargePointErrorCodeResetFailure,
+		ChargePointErrorCodeUnderVoltage,
+		ChargePointErrorCodeOverVoltage,
+		ChargePointErrorCodeWeakSignal:
+		return true
+	}
+	return false
+}
+
+type ChargePointStatus string
+
+const (
+	ChargePointStatusAvailable     ChargePointStatus = "Available"
+	ChargePointStatusPreparing     ChargePointStatus = "Preparing"
+	ChargePointStatusCharging      ChargePointStatus = "Charging"
+	ChargePointStatusSuspendedEVSE ChargePointStatus = "SuspendedEVSE"
+	ChargePointStatusSuspendedEV   ChargePointStatus = "SuspendedEV"
+	ChargePointStatusFinishing     ChargePointStatus = "Finishing"
+	ChargePointStatusReserved      ChargePointStatus = "Reserved"
+	ChargePointStatusUnavailable   ChargePointStatus = "Unavailable"
+	ChargePointStatusFaulted       ChargePointStatus = "Faulted"
+)
+
+// IsValid reports whether e is one of the ChargePointStatus values
+func (e ChargePointStatus) IsValid() bool {
+	switch e {
+	case ChargePointStatusAvailable,
+		ChargePointStatusPreparing,
+		ChargePointStatusCharging,
+		ChargePointStatusSuspendedEVSE,
+		ChargePointStatusSuspendedEV,
+		ChargePointStatusFinishing,
+		ChargePointStatusReserved,
+		ChargePointStatusUnavailable,
+		ChargePointStatusFaulted:
+		return true
+	}
+	return false
+}
+
+type ChargingProfileKindType string
+
+const (
+	ChargingProfileKindTypeAbsolute  ChargingProfileKindType = "Absolute"
+	ChargingProfileKindTypeRecurring ChargingProfileKindType = "Recurring"
+	ChargingProfileKindTypeRelative  ChargingProfileKindType = "Relative"
+)
+
+// IsValid reports whether e is one of the ChargingProfileKindType values
+func (e ChargingProfileKindType) IsValid() bool {
+	switch e {
+	case ChargingProfileKindTypeAbsolute,
+		ChargingProfileKindTypeRecurring,
+		ChargingProfileKindTypeRelative:
+		return true
+	}
+	return false
+}
+
+type ChargingProfilePurposeType string
+
+const (
+	ChargingProfilePurposeTypeChargePointMaxProfile ChargingProfilePurposeType = "ChargePointMaxProfile"
+	ChargingProfilePurposeTypeTxDefaultProfile      ChargingProfilePurposeType = "TxDefaultProfile"
+	ChargingProfilePurposeTypeTxProfile             ChargingProfilePurposeType = "TxProfile"
+)
+
+// IsValid reports whether e is one of the ChargingProfilePurposeType values
+func (e ChargingProfilePurposeType) IsValid() bool {
+	switch e {
+	case ChargingProfilePurposeTypeChargePointMaxProfile,
+		ChargingProfilePurposeTypeTxDefaultProfile,
+		ChargingProfilePurposeTypeTxProfile:
+		return true
+	}
+	return false
+}
+
+type ChargingProfileStatus string
+
+const (
+	ChargingProfileStatusAccepted     ChargingProfileStatus = "Accepted"
+	ChargingProfileStatusRejected     ChargingProfileStatus = "Rejected"
+	ChargingProfileStatusNotSupported ChargingProfileStatus = "NotSupported"
+)
+
+// IsValid reports whether e is one of the ChargingProfileStatus values
+func (e ChargingProfileStatus) IsValid() bool {
+	switch e {
+	case ChargingProfileStatusAccepted,
+		ChargingProfileStatusRejected,
+		ChargingProfileStatusNotSupported:
+		return true
+	}
+	return false
+}
+
+type ChargingRateUnitType string
+
+const (
+	ChargingRateUnitTypeA ChargingRateUnitType = "A"
+	ChargingRateUnitTypeW ChargingRateUnitType = "W"
+)
+
+// IsValid reports whether e is one of the ChargingRateUnitType values
+func (e ChargingRateUnitType) IsValid() bool {
+	switch e {
+	case ChargingRateUnitTypeA,
+		ChargingRateUnitTypeW:
+		return true
+	}
+	return false
+}
+
+type ClearCacheStatus string
+
+const (
+	ClearCacheStatusAccepted ClearCacheStatus = "Accepted"
+	ClearCacheStatusRejected ClearCacheStatus = "Rejected"
+)
+
+// IsValid reports whether e is one of the ClearCacheStatus values
+func (e ClearCacheStatus) IsValid() bool {
+	switch e {
+	case ClearCacheStatusAccepted,
+		ClearCacheStatusRejected:
+		return true
+	}
+	return false
+}
+
+type ClearChargingProfileStatus string
+
+const (
+	ClearChargingProfileStatusAccepted ClearChargingProfileStatus = "Accepted"
+	ClearChargingProfileStatusUnknown  ClearChargingProfileStatus = "Unknown"
+)
+
+// IsValid reports whether e is one of the ClearChargingProfileStatus values
+func (e ClearChargingProfileStatus) IsValid() bool {
+	switch e {
+	case ClearChargingProfileStatusAccepted,
+		ClearChargingProfileStatusUnknown:
+		return true
+	}
+	return false
+}
+
+type ConfigurationStatus string
+
+const (
+	ConfigurationStatusAccepted       ConfigurationStatus = "Accepted"
+	ConfigurationStatusRejected       ConfigurationStatus = "Rejected"
+	ConfigurationStatusRebootRequired ConfigurationStatus = "RebootRequired"
+	ConfigurationStatusNotSupported   ConfigurationStatus = "NotSupported"
+)
+
+// IsValid reports whether e is one of the ConfigurationStatus values
+func (e ConfigurationStatus) IsValid() bool {
+	switch e {
+	case ConfigurationStatusAccepted,
+		ConfigurationStatusRejected,
+		ConfigurationStatusRebootRequired,
+		ConfigurationStatusNotSupported:
+		return true
+	}
+	return false
+}
+
+type DataTransferStatus string
+
+const (
+	DataTransferStatusAccepted         DataTransferStatus = "Accepted"
+	DataTransferStatusRejected         DataTransferStatus = "Rejected"
+	DataTransferStatusUnknownMessageId DataTransferStatus = "UnknownMessageId"
+	DataTransferStatusUnknownVendorId  DataTransferStatus = "UnknownVendorId"
+)
+
+// IsValid reports whether e is one of the DataTransferStatus values
+func (e DataTransferStatus) IsValid() bool {
+	switch e {
+	case DataTransferStatusAccepted,
+		DataTransferStatusRejected,
+		DataTransferStatusUnknownMessageId,
+		DataTransferStatusUnknownVendorId:
+		return true
+	}
+	return false
+}
+
+type DeleteCertificateStatusEnumType string
+
+const (
+	DeleteCertificateStatusAccepted DeleteCertificateStatusEnumType = "Accepted"
+	DeleteCertificateStatusFailed   DeleteCertificateStatusEnumType = "Failed"
+	DeleteCertificateStatusNotFound DeleteCertificateStatusEnumType = "NotFound"
+)
+
+// IsValid reports whether e is one of the DeleteCertificateStatusEnumType values
+func (e DeleteCertificateStatusEnumType) IsValid() bool {
+	switch e {
+	case DeleteCertificateStatusAccepted,
+		DeleteCertificateStatusFailed,
+		DeleteCertificateStatusNotFound:
+		return true
+	}
+	return false
+}
+
+type DiagnosticsStatus string
+
+const (
+	DiagnosticsStatusIdle         DiagnosticsStatus = "Idle"
+	DiagnosticsStatusUploaded     DiagnosticsStatus = "Uploaded"
+	DiagnosticsStatusUploadFailed DiagnosticsStatus = "UploadFailed"
+	DiagnosticsStatusUploading    DiagnosticsStatus = "Uploading"
+)
+
+// IsValid reports whether e is one of the DiagnosticsStatus values
+func (e DiagnosticsStatus) IsValid() bool {
+	switch e {
+	case DiagnosticsStatusIdle,
+		DiagnosticsStatusUploaded,
+		DiagnosticsStatusUploadFailed,
+		DiagnosticsStatusUploading:
+		return true
+	}
+	return false
+}
+
+type FirmwareStatus string
+
+const (
+	FirmwareStatusDownloaded         FirmwareStatus = "Downloaded"
+	FirmwareStatusDownloadFailed     FirmwareStatus = "DownloadFailed"
+	FirmwareStatusDownloading        FirmwareStatus = "Downloading"
+	FirmwareStatusIdle               FirmwareStatus = "Idle"
+	FirmwareStatusInstallationFailed FirmwareStatus = "InstallationFailed"
+	FirmwareStatusInstalling         FirmwareStatus = "Installing"
+	FirmwareStatusInstalled          FirmwareStatus = "Installed"
+)
+
+// IsValid reports whether e is one of the FirmwareStatus values
+func (e FirmwareStatus) IsValid() bool {
+	switch e {
+	case FirmwareStatusDownloaded,
+		FirmwareStatusDownloadFailed,
+		FirmwareStatusDownloading,
+		FirmwareStatusIdle,
+		FirmwareStatusInstallationFailed,
+		FirmwareStatusInstalling,
+		FirmwareStatusInstalled:
+		return true
+	}
+	return false
+}
+
+type FirmwareStatusEnumType string
+
+const (
+	FirmwareStatusEnumTypeDownloaded                FirmwareStatusEnumType = "Downloaded"
+	FirmwareStatusEnumTypeDownloadFailed            FirmwareStatusEnumType = "DownloadFailed"
+	FirmwareStatusEnumTypeDownloading               FirmwareStatusEnumType = "Downloading"
+	FirmwareStatusEnumTypeDownloadScheduled         FirmwareStatusEnumType = "DownloadScheduled"
+	FirmwareStatusEnumTypeDownloadPaused            FirmwareStatusEnumType = "DownloadPaused"
+	FirmwareStatusEnumTypeIdle                      FirmwareStatusEnumType = "Idle"
+	FirmwareStatusEnumTypeInstallationFailed        FirmwareStatusEnumType = "InstallationFailed"
+	FirmwareStatusEnumTypeInstalling                FirmwareStatusEnumType = "Installing"
+	FirmwareStatusEnumTypeInstalled                 FirmwareStatusEnumType = "Installed"
+	FirmwareStatusEnumTypeInstallRebooting          FirmwareStatusEnumType = "InstallRebooting"
+	FirmwareStatusEnumTypeInstallScheduled          FirmwareStatusEnumType = "InstallScheduled"
+	FirmwareStatusEnumTypeInstallVerificationFailed FirmwareStatusEnumType = "InstallVerificationFailed"
+	FirmwareStatusEnumTypeInvalidSignature          FirmwareStatusEnumType = "InvalidSignature"
+	FirmwareStatusEnumTypeSignatureVerified         FirmwareStatusEnumType = "SignatureVerified"
+)
+
+// IsValid reports whether e is one of the FirmwareStatusEnumType values
+func (e FirmwareStatusEnumType) IsValid() bool {
+	switch e {
+	case FirmwareStatusEnumTypeDownloaded,
+		FirmwareStatusEnumTypeDownloadFailed,
+		FirmwareStatusEnumTypeDownloading,
+		FirmwareStatusEnumTypeDownloadScheduled,
+		FirmwareStatusEnumTypeDownloadPaused,
+		FirmwareStatusEnumTypeIdle,
+		FirmwareStatusEnumTypeInstallationFailed,
+		FirmwareStatusEnumTypeInstalling,
+		FirmwareStatusEnumTypeInstalled,
+		FirmwareStatusEnumTypeInstallRebooting,
+		FirmwareStatusEnumTypeInstallScheduled,
+		FirmwareStatusEnumTypeInstallVerificationFailed,
+		FirmwareStatusEnumTypeInvalidSignature,
+		FirmwareStatusEnumTypeSignatureVerified:
+		return true
+	}
+	return false
+}
+
+type GenericStatusEnumType string
+
+const (
+	GenericStatusAccepted GenericStatusEnumType = "Accepted"
+	GenericStatusRejected GenericStatusEnumType = "Rejected"
+)
+
+// IsValid reports whether e is one of the GenericStatusEnumType values
+func (e GenericStatusEnumType) IsValid() bool {
+	switch e {
+	case GenericStatusAccepted,
+		GenericStatusRejected:
+		return true
+	}
+	return false
+}
+
+type GetCompositeScheduleStatus string
+
+const (
+	GetCompositeScheduleStatusAccepted GetCompositeScheduleStatus = "Accepted"
+	GetCompositeScheduleStatusRejected GetCompositeScheduleStatus = "Rejected"
+)
+
+// IsValid reports whether e is one of the GetCompositeScheduleStatus values
+func (e GetCompositeScheduleStatus) IsValid() bool {
+	switch e {
+	case GetCompositeScheduleStatusAccepted,
+		GetCompositeScheduleStatusRejected:
+		return true
+	}
+	return false
+}
+
+type GetInstalledCertificateStatusEnumType string
+
+const (
+	GetInstalledCertificateStatusAccepted GetInstalledCertificateStatusEnumType = "Accepted"
+	GetInstalledCertificateStatusNotFound GetInstalledCertificateStatusEnumType = "NotFound"
+)
+
+// IsValid reports whether e is one of the GetInstalledCertificateStatusEnumType values
+func (e GetInstalledCertificateStatusEnumType) IsValid() bool {
+	switch e {
+	case GetInstalledCertificateStatusAccepted,
+		GetInstalledCertificateStatusNotFound:
+		return true
+	}
+	return false
+}
+
+type HashAlgorithmEnumType string
+
+const (
+	HashAlgorithmSHA256 HashAlgorithmEnumType = "SHA256"
+	HashAlgorithmSHA384 HashAlgorithmEnumType = "SHA384"
+	HashAlgorithmSHA512 HashAlgorithmEnumType = "SHA512"
+)
+
+// IsValid reports whether e is one of the HashAlgorithmEnumType values
+func (e HashAlgorithmEnumType) IsValid() bool {
+	switch e {
+	case HashAlgorithmSHA256,
+		HashAlgorithmSHA384,
+		HashAlgorithmSHA512:
+		return true
+	}
+	return false
+}
+
+type InstallCertificateStatusEnumType string
+
+const (
+	InstallCertificateStatusAccepted InstallCertificateStatusEnumType = "Accepted"
+	InstallCertificateStatusFailed   InstallCertificateStatusEnumType = "Failed"
+	InstallCertificateStatusRejected InstallCertificateStatusEnumType = "Rejected"
+)
+
+// IsValid reports whether e is one of the InstallCertificateStatusEnumType values
+func (e InstallCertificateStatusEnumType) IsValid() bool {
+	switch e {
+	case InstallCertificateStatusAccepted,
+		InstallCertificateStatusFailed,
+		InstallCertificateStatusRejected:
+		return true
+	}
+	return false
+}
+
+type Location string
+
+const (
+	LocationCable  Location = "Cable"
+	LocationEV     Location = "EV"
+	LocationInlet  Location = "Inlet"
+	LocationOutlet Location = "Outlet"
+	LocationBody   Location = "Body"
+)
+
+// IsValid reports whether e is one of the Location values
+func (e Location) IsValid() bool {
+	switch e {
+	case LocationCable,
+		LocationEV,
+		LocationInlet,
+		LocationOutlet,
+		LocationBody:
+		return true
+	}
+	return false
+}
+
+type LogEnumType string
+
+const (
+	LogDiagnosticsLog LogEnumType = "DiagnosticsLog"
+	LogSecurityLog    LogEnumType = "SecurityLog"
+)
+
+// IsValid reports whether e is one of the LogEnumType values
+func (e LogEnumType) IsValid() bool {
+	switch e {
+	case LogDiagnosticsLog,
+		LogSecurityLog:
+		return true
+	}
+	return false
+}
+
+type LogStatusEnumType string
+
+const (
+	LogStatusAccepted         LogStatusEnumType = "Accepted"
+	LogStatusRejected         LogStatusEnumType = "Rejected"
+	LogStatusAcceptedCanceled LogStatusEnumType = "AcceptedCanceled"
+)
+
+// IsValid reports whether e is one of the LogStatusEnumType values
+func (e LogStatusEnumType) IsValid() bool {
+	switch e {
+	case LogStatusAccepted,
+		LogStatusRejected,
+		LogStatusAcceptedCanceled:
+		return true
+	}
+	return false
+}
+
+type Measurand string
+
+const (
+	MeasurandEnergyActiveExportRegister   Measurand = "Energy.Active.Export.Register"
+	MeasurandEnergyActiveImportRegister   Measurand = "Energy.Active.Import.Register"
+	MeasurandEnergyReactiveExportRegister Measurand = "Energy.Reactive.Export.Register"
+	MeasurandEnergyReactiveImportRegister Measurand = "Energy.Reactive.Import.Register"
+	MeasurandEnergyActiveExportInterval   Measurand = "Energy.Active.Export.Interval"
+	MeasurandEnergyActiveImportInterval   Measurand = "Energy.Active.Import.Interval"
+	MeasurandEnergyReactiveExportInterval Measurand = "Energy.Reactive.Export.Interval"
+	MeasurandEnergyReactiveImportInterval Measurand = "Energy.Reactive.Import.Interval"
+	MeasurandPowerActiveExport            Measurand = "Power.Active.Export"
+	MeasurandPowerActiveImport            Measurand = "Power.Active.Import"
+	MeasurandPowerOffered                 Measurand = "Power.Offered"
+	MeasurandPowerReactiveExport          Measurand = "Power.Reactive.Export"
+	MeasurandPowerReactiveImport          Measurand = "Power.Reactive.Import"
+	MeasurandPowerFactor                  Measurand = "Power.Factor"
+	MeasurandCurrentImport                Measurand = "Current.Import"
+	MeasurandCurrentExport                Measurand = "Current.Export"
+	MeasurandCurrentOffered               Measurand = "Current.Offered"
+	MeasurandVoltage                      Measurand = "Voltage"
+	MeasurandFrequency                    Measurand = "Frequency"
+	MeasurandTemperature                  Measurand = "Temperature"
+	MeasurandSoC                          Measurand = "SoC"
+	MeasurandRPM                          Measurand = "RPM"
+)
+
+// IsValid reports whether e is one of the Measurand values
+func (e Measurand) IsValid() bool {
+	switch e {
+	case MeasurandEnergyActiveExportRegister,
+		MeasurandEnergyActiveImportRegister,
+		MeasurandEnergyReactiveExportRegister,
+		MeasurandEnergyReactiveImportRegister,
+		MeasurandEnergyActiveExportInterval,
+		MeasurandEnergyActiveImportInterval,
+		MeasurandEnergyReactiveExportInterval,
+		MeasurandEnergyReactiveImportInterval,
+		MeasurandPowerActiveExport,
+		MeasurandPowerActiveImport,
+		MeasurandPowerOffered,
+		MeasurandPowerReactiveExport,
+		MeasurandPowerReactiveImport,
+		MeasurandPowerFactor,
+		MeasurandCurrentImport,
+		MeasurandCurrentExport,
+		MeasurandCurrentOffered,
+		MeasurandVoltage,
+		MeasurandFrequency,
+		MeasurandTemperature,
+		MeasurandSoC,
+		MeasurandRPM:
+		return true
+	}
+	return false
+}
+
+type MessageTrigger string
+
+const (
+	MessageTriggerBootNotification              MessageTrigger = "BootNotification"
+	MessageTriggerDiagnosticsStatusNotification MessageTrigger = "DiagnosticsStatusNotification"
+	MessageTriggerFirmwareStatusNotification    MessageTrigger = "FirmwareStatusNotification"
+	MessageTriggerHeartbeat                     MessageTrigger = "Heartbeat"
+	MessageTriggerMeterValues                   MessageTrigger = "MeterValues"
+	MessageTriggerStatusNotification            MessageTrigger = "StatusNotification"
+)
+
+// IsValid reports whether e is one of the MessageTrigger values
+func (e MessageTrigger) IsValid() bool {
+	switch e {
+	case MessageTriggerBootNotification,
+		MessageTriggerDiagnosticsStatusNotification,
+		MessageTriggerFirmwareStatusNotification,
+		MessageTriggerHeartbeat,
+		MessageTriggerMeterValues,
+		MessageTriggerStatusNotification:
+		return true
+	}
+	return false
+}
+
+type MessageTriggerEnumType string
+
+const (
+	MessageTriggerEnumTypeBootNotification           MessageTriggerEnumType = "BootNotification"
+	MessageTriggerEnumTypeLogStatusNotification      MessageTriggerEnumType = "LogStatusNotification"
+	MessageTriggerEnumTypeFirmwareStatusNotification MessageTriggerEnumType = "FirmwareStatusNotification"
+	MessageTriggerEnumTypeHeartbeat                  MessageTriggerEnumType = "Heartbeat"
+	MessageTriggerEnumTypeMeterValues                MessageTriggerEnumType = "MeterValues"
+	MessageTriggerEnumTypeSignChargePointCertificate MessageTriggerEnumType = "SignChargePointCertificate"
+	MessageTriggerEnumTypeStatusNotification         MessageTriggerEnumType = "StatusNotification"
+)
+
+// IsValid reports whether e is one of the MessageTriggerEnumType values
+func (e MessageTriggerEnumType) IsValid() bool {
+	switch e {
+	case MessageTriggerEnumTypeBootNotification,
+		MessageTriggerEnumTypeLogStatusNotification,
+		MessageTriggerEnumTypeFirmwareStatusNotification,
+		MessageTriggerEnumTypeHeartbeat,
+		MessageTriggerEnumTypeMeterValues,
+		MessageTriggerEnumTypeSignChargePointCertificate,
+		MessageTriggerEnumTypeStatusNotification:
+		return true
+	}
+	return false
+}
+
+type Phase string
+
+const (
+	PhaseL1   Phase = "L1"
+	PhaseL2   Phase = "L2"
+	PhaseL3   Phase = "L3"
+	PhaseN    Phase = "N"
+	PhaseL1N  Phase = "L1-N"
+	PhaseL2N  Phase = "L2-N"
+	PhaseL3N  Phase = "L3-N"
+	PhaseL1L2 Phase = "L1-L2"
+	PhaseL2L3 Phase = "L2-L3"
+	PhaseL3L1 Phase = "L3-L1"
+)
+
+// IsValid reports whether e is one of the Phase values
+func (e Phase) IsValid() bool {
+	switch e {
+	case PhaseL1,
+		PhaseL2,
+		PhaseL3,
+		PhaseN,
+		PhaseL1N,
+		PhaseL2N,
+		PhaseL3N,
+		PhaseL1L2,
+		PhaseL2L3,
+		PhaseL3L1:
+		return true
+	}
+	return false
+}
+
+type ReadingContext string
+
+const (
+	ReadingContextInterruptionBegin ReadingContext = "Interruption.Begin"
+	ReadingContextInterruptionEnd   ReadingContext = "Interruption.End"
+	ReadingContextSampleClock       ReadingContext = "Sample.Clock"
+	ReadingContextSamplePeriodic    ReadingContext = "Sample.Periodic"
+	ReadingContextTransactionBegin  ReadingContext = "Transaction.Begin"
+	ReadingContextTransactionEnd    ReadingContext = "Transaction.End"
+	ReadingContextTrigger           ReadingContext = "Trigger"
+	ReadingContextOther             ReadingContext = "Other"
+)
+
+// IsValid reports whether e is one of the ReadingContext values
+func (e ReadingContext) IsValid() bool {
+	switch e {
+	case ReadingContextInterruptionBegin,
+		ReadingContextInterruptionEnd,
+		ReadingContextSampleClock,
+		ReadingContextSamplePeriodic,
+		ReadingContextTransactionBegin,
+		ReadingContextTransactionEnd,
+		ReadingContextTrigger,
+		ReadingContextOther:
+		return true
+	}
+	return false
+}
+
+type Reason string
+
+const (
+	ReasonEmergencyStop  Reason = "EmergencyStop"
+	ReasonEVDisconnected Reason = "EVDisconnected"
+	ReasonHardReset      Reason = "HardReset"
+	ReasonLocal          Reason = "Local"
+	ReasonOther          Reason = "Other"
+	ReasonPowerLoss      Reason = "PowerLoss"
+	ReasonReboot         Reason = "Reboot"
+	ReasonRemote         Reason = "Remote"
+	ReasonSoftReset      Reason = "SoftReset"
+	ReasonUnlockCommand  Reason = "UnlockCommand"
+	ReasonDeAuthorized   Reason = "DeAuthorized"
+)
+
+// IsValid reports whether e is one of the Reason values
+func (e Reason) IsValid() bool {
+	switch e {
+	case ReasonEmergencyStop,
+		ReasonEVDisconnected,
+		ReasonHardReset,
+		ReasonLocal,
+		ReasonOther,
+		ReasonPowerLoss,
+		ReasonReboot,
+		ReasonRemote,
+		ReasonSoftReset,
+		ReasonUnlockCommand,
+		ReasonDeAuthorized:
+		return true
+	}
+	return false
+}
+
+type RecurrencyKindType string
+
+const (
+	RecurrencyKindTypeDaily  RecurrencyKindType = "Daily"
+	RecurrencyKindTypeWeekly RecurrencyKindType = "Weekly"
+)
+
+// IsValid reports whether e is one of the RecurrencyKindType values
+func (e RecurrencyKindType) IsValid() bool {
+	switch e {
+	case RecurrencyKindTypeDaily,
+		RecurrencyKindTypeWeekly:
+		return true
+	}
+	return false
+}
+
+type RegistrationStatus string
+
+const (
+	RegistrationStatusAccepted RegistrationStatus = "Accepted"
+	RegistrationStatusPending  RegistrationStatus = "Pending"
+	RegistrationStatusRejected RegistrationStatus = "Rejected"
+)
+
+// IsValid reports whether e is one of the RegistrationStatus values
+func (e RegistrationStatus) IsValid() bool {
+	switch e {
+	case RegistrationStatusAccepted,
+		RegistrationStatusPending,
+		RegistrationStatusRejected:
+		return true
+	}
+	return false
+}
+
+type RemoteStartStopStatus string
+
+const (
+	RemoteStartStopStatusAccepted RemoteStartStopStatus = "Accepted"
+	RemoteStartStopStatusRejected RemoteStartStopStatus = "Rejected"
+)
+
+// IsValid reports whether e is one of the RemoteStartStopStatus values
+func (e RemoteStartStopStatus) IsValid() bool {
+	switch e {
+	case RemoteStartStopStatusAccepted,
+		RemoteStartStopStatusRejected:
+		return true
+	}
+	return false
+}
+
+type ReservationStatus string
+
+const (
+	ReservationStatusAccepted    ReservationStatus = "Accepted"
+	ReservationStatusFaulted     ReservationStatus = "Faulted"
+	ReservationStatusOccupied    ReservationStatus = "Occupied"
+	ReservationStatusRejected    ReservationStatus = "Rejected"
+	ReservationStatusUnavailable ReservationStatus = "Unavailable"
+)
+
+// IsValid reports whether e is one of the ReservationStatus values
+func (e ReservationStatus) IsValid() bool {
+	switch e {
+	case ReservationStatusAccepted,
+		ReservationStatusFaulted,
+		ReservationStatusOccupied,
+		ReservationStatusRejected,
+		ReservationStatusUnavailable:
+		return true
+	}
+	return false
+}
+
+type ResetStatus string
+
+const (
+	ResetStatusAccepted ResetStatus = "Accepted"
+	ResetStatusRejected ResetStatus = "Rejected"
+)
+
+// IsValid reports whether e is one of the ResetStatus values
+func (e ResetStatus) IsValid() bool {
+	switch e {
+	case ResetStatusAccepted,
+		ResetStatusRejected:
+		return true
+	}
+	return false
+}
+
+type ResetType string
+
+const (
+	ResetTypeHard ResetType = "Hard"
+	ResetTypeSoft ResetType = "Soft"
+)
+
+// IsValid reports whether e is one of the ResetType values
+func (e ResetType) IsValid() bool {
+	switch e {
+	case ResetTypeHard,
+		ResetTypeSoft:
+		return true
+	}
+	return false
+}
+
+type TriggerMessageStatus string
+
+const (
+	TriggerMessageStatusAccepted       TriggerMessageStatus = "Accepted"
+	TriggerMessageStatusRejected       TriggerMessageStatus = "Rejected"
+	TriggerMessageStatusNotImplemented TriggerMessageStatus = "NotImplemented"
+)
+
+// IsValid reports whether e is one of the TriggerMessageStatus values
+func (e TriggerMessageStatus) IsValid() bool {
+	switch e {
+	case TriggerMessageStatusAccepted,
+		TriggerMessageStatusRejected,
+		TriggerMessageStatusNotImplemented:
+		return true
+	}
+	return false
+}
+
+type TriggerMessageStatusEnumType string
+
+const (
+	TriggerMessageStatusEnumTypeAccepted       TriggerMessageStatusEnumType = "Accepted"
+	TriggerMessageStatusEnumTypeRejected       TriggerMessageStatusEnumType = "Rejected"
+	TriggerMessageStatusEnumTypeNotImplemented TriggerMessageStatusEnumType = "NotImplemented"
+)
+
+// IsValid reports whether e is one of the TriggerMessageStatusEnumType values
+func (e TriggerMessageStatusEnumType) IsValid() bool {
+	switch e {
+	case TriggerMessageStatusEnumTypeAccepted,
+		TriggerMessageStatusEnumTypeRejected,
+		TriggerMessageStatusEnumTypeNotImplemented:
+		return true
+	}
+	return false
+}
+
+type UnitOfMeasure string
+
+const (
+	UnitOfMeasureWh         UnitOfMeasure = "Wh"
+	UnitOfMeasureKWh        UnitOfMeasure = "kWh"
+	UnitOfMeasureVarh       UnitOfMeasure = "varh"
+	UnitOfMeasureKvarh      UnitOfMeasure = "kvarh"
+	UnitOfMeasureW          UnitOfMeasure = "W"
+	UnitOfMeasureKW         UnitOfMeasure = "kW"
+	UnitOfMeasureVA         UnitOfMeasure = "VA"
+	UnitOfMeasureKVA        UnitOfMeasure = "kVA"
+	UnitOfMeasureVar        UnitOfMeasure = "var"
+	UnitOfMeasureKvar       UnitOfMeasure = "kvar"
+	UnitOfMeasureA          UnitOfMeasure = "A"
+	UnitOfMeasureV          UnitOfMeasure = "V"
+	UnitOfMeasureK          UnitOfMeasure = "K"
+	UnitOfMeasureCelcius    UnitOfMeasure = "Celcius"
+	UnitOfMeasureCelsius    UnitOfMeasure = "Celsius"
+	UnitOfMeasureFahrenheit UnitOfMeasure = "Fahrenheit"
+	UnitOfMeasurePercent    UnitOfMeasure = "Percent"
+)
+
+// IsValid reports whether e is one of the UnitOfMeasure values
+func (e UnitOfMeasure) IsValid() bool {
+	switch e {
+	case UnitOfMeasureWh,
+		UnitOfMeasureKWh,
+		UnitOfMeasureVarh,
+		UnitOfMeasureKvarh,
+		UnitOfMeasureW,
+		UnitOfMeasureKW,
+		UnitOfMeasureVA,
+		UnitOfMeasureKVA,
+		UnitOfMeasureVar,
+		UnitOfMeasureKvar,
+		UnitOfMeasureA,
+		UnitOfMeasureV,
+		UnitOfMeasureK,
+		UnitOfMeasureCelcius,
+		UnitOfMeasureCelsius,
+		UnitOfMeasureFahrenheit,
+		UnitOfMeasurePercent:
+		return true
+	}
+	return false
+}
+
+type UnlockStatus string
+
+const (
+	UnlockStatusUnlocked     UnlockStatus = "Unlocked"
+	UnlockStatusUnlockFailed UnlockStatus = "UnlockFailed"
+	UnlockStatusNotSupported UnlockStatus = "NotSupported"
+)
+
+// IsValid reports whether e is one of the UnlockStatus values
+func (e UnlockStatus) IsValid() bool {
+	switch e {
+	case UnlockStatusUnlocked,
+		UnlockStatusUnlockFailed,
+		UnlockStatusNotSupported:
+		return true
+	}
+	return false
+}
+
+type UpdateFirmwareStatusEnumType string
+
+const (
+	UpdateFirmwareStatusAccepted           UpdateFirmwareStatusEnumType = "Accepted"
+	UpdateFirmwareStatusRejected           UpdateFirmwareStatusEnumType = "Rejected"
+	UpdateFirmwareStatusAcceptedCanceled   UpdateFirmwareStatusEnumType = "AcceptedCanceled"
+	UpdateFirmwareStatusInvalidCertificate UpdateFirmwareStatusEnumType = "InvalidCertificate"
+	UpdateFirmwareStatusRevokedCertificate UpdateFirmwareStatusEnumType = "RevokedCertificate"
+)
+
+// IsValid reports whether e is one of the UpdateFirmwareStatusEnumType values
+func (e UpdateFirmwareStatusEnumType) IsValid() bool {
+	switch e {
+	case UpdateFirmwareStatusAccepted,
+		UpdateFirmwareStatusRejected,
+		UpdateFirmwareStatusAcceptedCanceled,
+		UpdateFirmwareStatusInvalidCertificate,
+		UpdateFirmwareStatusRevokedCertificate:
+		return true
+	}
+	return false
+}
+
+type UpdateStatus string
+
+const (
+	UpdateStatusAccepted        UpdateStatus = "Accepted"
+	UpdateStatusFailed          UpdateStatus = "Failed"
+	UpdateStatusNotSupported    UpdateStatus = "NotSupported"
+	UpdateStatusVersionMismatch UpdateStatus = "VersionMismatch"
+)
+
+// IsValid reports whether e is one of the UpdateStatus values
+func (e UpdateStatus) IsValid() bool {
+	switch e {
+	case UpdateStatusAccepted,
+		UpdateStatusFailed,
+		UpdateStatusNotSupported,
+		UpdateStatusVersionMismatch:
+		return true
+	}
+	return false
+}
+
+type UpdateType string
+
+const (
+	UpdateTypeDifferential UpdateType = "Differential"
+	UpdateTypeFull         UpdateType = "Full"
+)
+
+// IsValid reports whether e is one of the UpdateType values
+func (e UpdateType) IsValid() bool {
+	switch e {
+	case UpdateTypeDifferential,
+		UpdateTypeFull:
+		return true
+	}
+	return false
+}
+
+type UploadLogStatusEnumType string
+
+const (
+	UploadLogStatusBadMessage            UploadLogStatusEnumType = "BadMessage"
+	UploadLogStatusIdle                  UploadLogStatusEnumType = "Idle"
+	UploadLogStatusNotSupportedOperation UploadLogStatusEnumType = "NotSupportedOperation"
+	UploadLogStatusPermissionDenied      UploadLogStatusEnumType = "PermissionDenied"
+	UploadLogStatusUploaded              UploadLogStatusEnumType = "Uploaded"
+	UploadLogStatusUploadFailure         UploadLogStatusEnumType = "UploadFailure"
+	UploadLogStatusUploading             UploadLogStatusEnumType = "Uploading"
+)
+
+// IsValid reports whether e is one of the UploadLogStatusEnumType values
+func (e UploadLogStatusEnumType) IsValid() bool {
+	switch e {
+	case UploadLogStatusBadMessage,
+		UploadLogStatusIdle,
+		UploadLogStatusNotSupportedOperation,
+		UploadLogStatusPermissionDenied,
+		UploadLogStatusUploaded,
+		UploadLogStatusUploadFailure,
+		UploadLogStatusUploading:
+		return true
+	}
+	return false
+}
+
+type ValueFormat string
+
+const (
+	ValueFormatRaw        ValueFormat = "Raw"
+	ValueFormatSignedData ValueFormat = "SignedData"
+)
+
+// IsValid reports whether e is one of the ValueFormat values
+func (e ValueFormat) IsValid() bool {
+	switch e {
+	case ValueFormatRaw,
+		ValueFormatSignedData:
+		return true
+	}
+	return false
+}
@@ -1,358 +1,427 @@
+// Code generated by ocppgen from the OCPP JSON schemas. DO NOT EDIT.
+
 package v201
 
 type AuthorizeReq struct {
+	CustomData                  *CustomDataType       `json:"customData,omitempty"`
+	IdToken                     IdTokenType           `json:"idToken"`
 	Certificate                 string                `json:"certificate,omitempty" validate:"omitempty,max=5500"`
-	IdToken                     IdTokenType           `json:"idTokenType" validate:"required"`
-	Iso15118CertificateHashData []OCSPRequestDataType `json:"iso15118CertificateHashData,omitempty" validate:"omitempty,max=4,dive,required"`
+	Iso15118CertificateHashData []OCSPRequestDataType `json:"iso15118CertificateHashData,omitempty" validate:"omitempty,min=1,max=4,dive"`
 }
 
 type BootNotificationReq struct {
-	Reason          string              `json:"reason" validate:"required,BootReasonEnumType"`
-	ChargingStation ChargingStationType `json:"chargingStation" validate:"required"`
+	CustomData      *CustomDataType     `json:"customData,omitempty"`
+	ChargingStation ChargingStationType `json:"chargingStation"`
+	Reason          BootReasonEnumType  `json:"reason" validate:"required,BootReasonEnumType"`
 }
 
 type CancelReservationReq struct {
-	ReservationId *int `json:"reservationId" validate:"required"`
+	CustomData    *CustomDataType `json:"customData,omitempty"`
+	ReservationId *int            `json:"reservationId" validate:"required"`
 }
 
 type CertificateSignedReq struct {
-	CertificateChain string `json:"certificateChain" validate:"required,max=10000"`
-	CertificateType  string `json:"certificateType,omitempty" validate:"omitempty,CertificateSigningUseEnumType"`
+	CustomData       *CustomDataType               `json:"customData,omitempty"`
+	CertificateChain string                        `json:"certificateChain" validate:"required,max=10000"`
+	CertificateType  CertificateSigningUseEnumType `json:"certificateType,omitempty" validate:"omitempty,CertificateSigningUseEnumType"`
 }
 
 type ChangeAvailabilityReq struct {
-	OperationalStatus string   `json:"operationalStatus" validate:"required,OperationalStatusEnumType"`
-	Evse              EVSEType `json:"evse,omitempty"`
+	CustomData        *CustomDataType           `json:"customData,omitempty"`
+	Evse              *EVSEType                 `json:"evse,omitempty"`
+	OperationalStatus OperationalStatusEnumType `json:"operationalStatus" validate:"required,OperationalStatusEnumType"`
 }
 
-type ClearCacheReq struct{}
+type ClearCacheReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
 
 type ClearChargingProfileReq struct {
-	ChargingProfileId       int                     `json:"chargingProfileId,omitempty"`
-	ChargingProfileCriteria ClearChargingProfileType `json:"chargingProfileCriteria,omitempty"`
+	CustomData              *CustomDataType           `json:"customData,omitempty"`
+	ChargingProfileId       *int                      `json:"chargingProfileId,omitempty"`
+	ChargingProfileCriteria *ClearChargingProfileType `json:"chargingProfileCriteria,omitempty"`
 }
 
 type ClearDisplayMessageReq struct {
-	Id *int `json:"id" validate:"required"`
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Id         *int            `json:"id" validate:"required"`
 }
 
-type ClearedChargingLimitReq struct {
-	ChargingLimitSource string `json:"chargingLimitSource" validate:"required,ChargingLimitSourceEnumType"`
-	EvseId              *int   `json:"evseId,omitempty"`
+type ClearVariableMonitoringReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Id         []int           `json:"id" validate:"required,min=1"`
 }
 
-type ClearVariableMonitoringReq struct {
-	Id []int `json:"id" validate:"required,dive,required"`
+type ClearedChargingLimitReq struct {
+	CustomData          *CustomDataType             `json:"customData,omitempty"`
+	ChargingLimitSource ChargingLimitSourceEnumType `json:"chargingLimitSource" validate:"required,ChargingLimitSourceEnumType"`
+	EvseId              *int                        `json:"evseId,omitempty"`
 }
 
 type CostUpdatedReq struct {
-	TotalCost     float32 `json:"totalCost" validate:"required"`
-	TransactionId string  `json:"transactionId" validate:"required,max=36"`
+	CustomData    *CustomDataType `json:"customData,omitempty"`
+	TotalCost     *float64        `json:"totalCost" validate:"required"`
+	TransactionId string          `json:"transactionId" validate:"required,max=36"`
 }
 
 type CustomerInformationReq struct {
-	RequestId           int                    `json:"requestId" validate:"required"`
-	Report              bool                    `json:"report" validate:"required"`
-	Clear               bool                    `json:"clear" validate:"required"`
-	CustomerIdentifier  string                  `json:"customerIdentifier,omitempty" validate:"omitempty,max=64"`
-	IdToken             IdTokenType             `json:"idTokenType,omitempty"`
-	CustomerCertificate CertificateHashDataType `json:"customerCertificate,omitempty" `
+	CustomData          *CustomDataType          `json:"customData,omitempty"`
+	CustomerCertificate *CertificateHashDataType `json:"customerCertificate,omitempty"`
+	IdToken             *IdTokenType             `json:"idToken,omitempty"`
+	RequestId           *int                     `json:"requestId" validate:"required"`
+	Report              *bool                    `json:"report" validate:"required"`
+	Clear               *bool                    `json:"clear" validate:"required"`
+	CustomerIdentifier  string                   `json:"customerIdentifier,omitempty" validate:"omitempty,max=64"`
 }
 
 type DataTransferReq struct {
-	MessageId string      `json:"messageId,omitempty" validate:"omitempty,max=50"`
-	Data      interface{} `json:"data,omitempty"`
-	VendorId  string      `json:"vendorId" validate:"required,max=255"`
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	MessageId  string          `json:"messageId,omitempty" validate:"omitempty,max=50"`
+	Data       interface{}     `json:"data,omitempty"`
+	VendorId   string          `json:"vendorId" validate:"required,max=255"`
 }
 
 type DeleteCertificateReq struct {
-	CertificateHashData CertificateHashDataType `json:"certificateHashData" validate:"required"`
+	CustomData          *CustomDataType         `json:"customData,omitempty"`
+	CertificateHashData CertificateHashDataType `json:"certificateHashData"`
 }
 
 type FirmwareStatusNotificationReq struct {
-	Status    string `json:"status" validate:"required,FirmwareStatusEnumType"`
-	RequestId int   `json:"requestId,omitempty" `
+	CustomData *CustomDataType        `json:"customData,omitempty"`
+	Status     FirmwareStatusEnumType `json:"status" validate:"required,FirmwareStatusEnumType"`
+	RequestId  *int                   `json:"requestId,omitempty"`
 }
 
 type Get15118EVCertificateReq struct {
-	Iso15118SchemaVersion string `json:"iso15118SchemaVersion" validate:"required,max=50"`
-	Action                string `json:"action" validate:"required,CertificateActionEnumType"`
-	ExiRequest            string `json:"exiRequest" validate:"required,max=5600"`
+	CustomData            *CustomDataType           `json:"customData,omitempty"`
+	Iso15118SchemaVersion string                    `json:"iso15118SchemaVersion" validate:"required,max=50"`
+	Action                CertificateActionEnumType `json:"action" validate:"required,CertificateActionEnumType"`
+	ExiRequest            string                    `json:"exiRequest" validate:"required,max=5600"`
 }
 
 type GetBaseReportReq struct {
-	RequestId  int    `json:"requestId"`
-	ReportBase string `json:"reportBase" validate:"required,ReportBaseEnumType"`
+	CustomData *CustomDataType    `json:"customData,omitempty"`
+	RequestId  *int               `json:"requestId" validate:"required"`
+	ReportBase ReportBaseEnumType `json:"reportBase" validate:"required,ReportBaseEnumType"`
 }
 
 type GetCertificateStatusReq struct {
-	OcspRequestData OCSPRequestDataType `json:"ocspRequestData" validate:"required"`
+	CustomData      *CustomDataType     `json:"customData,omitempty"`
+	OcspRequestData OCSPRequestDataType `json:"ocspRequestData"`
 }
 
 type GetChargingProfilesReq struct {
-	RequestId       int                          `json:"requestId" validate:"required"`
-	EvseId          int                          `json:"evseId,omitempty"`
-	ChargingProfile ChargingProfileCriterionType `json:"chargingProfile" validate:"required"`
+	CustomData      *CustomDataType              `json:"customData,omitempty"`
+	RequestId       *int                         `json:"requestId" validate:"required"`
+	EvseId          *int                         `json:"evseId,omitempty"`
+	ChargingProfile ChargingProfileCriterionType `json:"chargingProfile"`
 }
 
 type GetCompositeScheduleReq struct {
-	Duration         int   `json:"duration" validate:"required"`
-	ChargingRateUnit string `json:"chargingRateUnit,omitempty" validate:"omitempty,ChargingRateUnitEnumType"`
-	EvseId           *int   `json:"evseId" validate:"required"`
+	CustomData       *CustomDataType          `json:"customData,omitempty"`
+	Duration         *int                     `json:"duration" validate:"required"`
+	ChargingRateUnit ChargingRateUnitEnumType `json:"chargingRateUnit,omitempty" validate:"omitempty,ChargingRateUnitEnumType"`
+	EvseId           *int                     `json:"evseId" validate:"required"`
 }
 
 type GetDisplayMessagesReq struct {
-	Id        []int  `json:"id,omitempty" validate:"omitempty,dive,required"`
-	RequestId int   `json:"requestId" validate:"required"`
-	Priority  string `json:"priority,omitempty" validate:"omitempty,MessagePriorityEnumType"`
-	State     string `json:"state,omitempty" validate:"omitempty,MessageStateEnumType"`
+	CustomData *CustomDataType         `json:"customData,omitempty"`
+	Id         []int                   `json:"id,omitempty" validate:"omitempty,min=1"`
+	RequestId  *int                    `json:"requestId" validate:"required"`
+	Priority   MessagePriorityEnumType `json:"priority,omitempty" validate:"omitempty,MessagePriorityEnumType"`
+	State      MessageStateEnumType    `json:"state,omitempty" validate:"omitempty,MessageStateEnumType"`
 }
 
 type GetInstalledCertificateIdsReq struct {
-	CertificateType []string `json:"certificateType,omitempty" validate:"omitempty,dive,GetCertificateIdUseEnumType"`
+	CustomData      *CustomDataType               `json:"customData,omitempty"`
+	CertificateType []GetCertificateIdUseEnumType `json:"certificateType,omitempty" validate:"omitempty,min=1,dive,GetCertificateIdUseEnumType"`
 }
 
-type GetLocalListVersionReq struct{}
+type GetLocalListVersionReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
 
 type GetLogReq struct {
-	LogType       string            `json:"logType" validate:"required,LogEnumType"`
-	RequestId     int              `json:"requestId" validate:"required"`
-	Retries       int              `json:"retries,omitempty"`
-	RetryInterval int              `json:"retryInterval,omitempty"`
-	Log           LogParametersType `json:"log" validate:"required"`
+	CustomData    *CustomDataType   `json:"customData,omitempty"`
+	Log           LogParametersType `json:"log"`
+	LogType       LogEnumType       `json:"logType" validate:"required,LogEnumType"`
+	RequestId     *int              `json:"requestId" validate:"required"`
+	Retries       *int              `json:"retries,omitempty"`
+	RetryInterval *int              `json:"retryInterval,omitempty"`
 }
 
 type GetMonitoringReportReq struct {
-	RequestId          int                    `json:"requestId" validate:"required"`
-	MonitoringCriteria []string                `json:"monitoringCriteria,omitempty" validate:"omitempty,max=3,dive,required,MonitoringCriteriaEnumType"`
-	ComponentVariable  []ComponentVariableType `json:"componentVariable,omitempty" validate:"omitempty,dive,required"`
+	CustomData         *CustomDataType               `json:"customData,omitempty"`
+	ComponentVariable  []ComponentVariableType       `json:"componentVariable,omitempty" validate:"omitempty,min=1,dive"`
+	RequestId          *int                          `json:"requestId" validate:"required"`
+	MonitoringCriteria []MonitoringCriterionEnumType `json:"monitoringCriteria,omitempty" validate:"omitempty,min=1,max=3,dive,MonitoringCriterionEnumType"`
 }
 
 type GetReportReq struct {
-	RequestId         int                    `json:"requestId" validate:"required"`
-	ComponentCriteria []string                `json:"componentCriteria,omitempty" validate:"omitempty,max=4,dive,required,ComponentCriteriaEnumType"`
-	ComponentVariable []ComponentVariableType `json:"componentVariable,omitempty" validate:"omitempty,dive,required"`
+	CustomData        *CustomDataType              `json:"customData,omitempty"`
+	ComponentVariable []ComponentVariableType      `json:"componentVariable,omitempty" validate:"omitempty,min=1,dive"`
+	RequestId         *int                         `json:"requestId" validate:"required"`
+	ComponentCriteria []ComponentCriterionEnumType `json:"componentCriteria,omitempty" validate:"omitempty,min=1,max=4,dive,ComponentCriterionEnumType"`
 }
 
 type GetTransactionStatusReq struct {
-	TransactionId string `json:"transactionId,omitempty" validate:"omiempty,max=36"`
+	CustomData    *CustomDataType `json:"customData,omitempty"`
+	TransactionId string          `json:"transactionId,omitempty" validate:"omitempty,max=36"`
 }
 
 type GetVariablesReq struct {
-	GetVariableData []GetVariableDataType `json:"getVariableData" validate:"required,dive,required"`
+	CustomData      *CustomDataType       `json:"customData,omitempty"`
+	GetVariableData []GetVariableDataType `json:"getVariableData" validate:"required,min=1,dive"`
 }
 
-type HeartbeatReq struct{}
+type HeartbeatReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
 
 type InstallCertificateReq struct {
-	CertificateType string `json:"certificateType" validate:"required,InstallCertificateUseEnumType"`
-	Certificate     string `json:"certificate" validate:"required,max=5500"`
+	CustomData      *CustomDataType               `json:"customData,omitempty"`
+	CertificateType InstallCertificateUseEnumType `json:"certificateType" validate:"required,InstallCertificateUseEnumType"`
+	Certificate     string                        `json:"certificate" validate:"required,max=5500"`
 }
 
 type LogStatusNotificationReq struct {
-	Status    string `json:"status" validate:"required,UploadLogStatusEnumType"`
-	RequestId int   `json:"requestId,omitempty"`
+	CustomData *CustomDataType         `json:"customData,omitempty"`
+	Status     UploadLogStatusEnumType `json:"status" validate:"required,UploadLogStatusEnumType"`
+	RequestId  *int                    `json:"requestId,omitempty"`
 }
 
 type MeterValuesReq struct {
-	EvseId     *int             `json:"evseId" validate:"required,gte=0"`
-	MeterValue []MeterValueType `json:"meterValue" validate:"required,dive,required"`
+	CustomData *CustomDataType  `json:"customData,omitempty"`
+	EvseId     *int             `json:"evseId" validate:"required"`
+	MeterValue []MeterValueType `json:"meterValue" validate:"required,min=1,dive"`
 }
 
 type NotifyChargingLimitReq struct {
-	EvseId           int                    `json:"evseId,omitempty" validate:"omitempty,gt=0"`
-	ChargingLimit    ChargingLimitType      `json:"chargingLimit" validate:"required"`
-	ChargingSchedule []ChargingScheduleType `json:"chargingSchedule,omitempty" validate:"omitempty,dive,required"`
+	CustomData       *CustomDataType        `json:"customData,omitempty"`
+	ChargingSchedule []ChargingScheduleType `json:"chargingSchedule,omitempty" validate:"omitempty,min=1,dive"`
+	EvseId           *int                   `json:"evseId,omitempty"`
+	ChargingLimit    ChargingLimitType      `json:"chargingLimit"`
 }
 
 type NotifyCustomerInformationReq struct {
-	Data        string `json:"data" validate:"required,max=512"`
-	Tbc         bool   `json:"tbc,omitempty"`
-	SeqNo       *int   `json:"seqNo" validate:"required,gte=0"`
-	GeneratedAt string `json:"generatedAt" validate:"required,ISO8601date"`
-	RequestId   int   `json:"requestId" validate:"required"`
+	CustomData  *CustomDataType `json:"customData,omitempty"`
+	Data        string          `json:"data" validate:"required,max=512"`
+	Tbc         *bool           `json:"tbc,omitempty"`
+	SeqNo       *int            `json:"seqNo" validate:"required"`
+	GeneratedAt string          `json:"generatedAt" validate:"required,ISO8601date"`
+	RequestId   *int            `json:"requestId" validate:"required"`
 }
 
 type NotifyDisplayMessagesReq struct {
-	RequestId   int              `json:"requestId" validate:"required"`
-	Tbc         bool              `json:"tbc,omitempty"`
-	MessageInfo []MessageInfoType `json:"messageInfo,omitempty" validate:"omitempty,dive,required"`
+	CustomData  *CustomDataType   `json:"customData,omitempty"`
+	MessageInfo []MessageInfoType `json:"messageInfo,omitempty" validate:"omitempty,min=1,dive"`
+	RequestId   *int              `json:"requestId" validate:"required"`
+	Tbc         *bool             `json:"tbc,omitempty"`
 }
 
 type NotifyEVChargingNeedsReq struct {
+	CustomData        *CustomDataType   `json:"customData,omitempty"`
 	MaxScheduleTuples *int              `json:"maxScheduleTuples,omitempty"`
-	EvseId            *int              `json:"evseId" validate:"required,gt=0"`
-	ChargingNeeds     ChargingNeedsType `json:"chargingNeeds" validate:"required"`
+	ChargingNeeds     ChargingNeedsType `json:"chargingNeeds"`
+	EvseId            *int              `json:"evseId" validate:"required"`
 }
 
 type NotifyEVChargingScheduleReq struct {
+	CustomData       *CustomDataType      `json:"customData,omitempty"`
 	TimeBase         string               `json:"timeBase" validate:"required,ISO8601date"`
-	EvseId           int                  `json:"evseId" validate:"required,gt=0"`
-	ChargingSchedule ChargingScheduleType `json:"chargingSchedule" validate:"required"`
+	ChargingSchedule ChargingScheduleType `json:"chargingSchedule"`
+	EvseId           *int                 `json:"evseId" validate:"required"`
 }
 
 type NotifyEventReq struct {
+	CustomData  *CustomDataType `json:"customData,omitempty"`
 	GeneratedAt string          `json:"generatedAt" validate:"required,ISO8601date"`
-	Tbc         bool            `json:"tbc,omitempty"`
-	SeqNo       *int            `json:"seqNo" validate:"required,gte=0"`
-	EventData   []EventDataType `json:"eventData" validate:"required,dive,required"`
+	Tbc         *bool           `json:"tbc,omitempty"`
+	SeqNo       *int            `json:"seqNo" validate:"required"`
+	EventData   []EventDataType `json:"eventData" validate:"required,min=1,dive"`
 }
 
 type NotifyMonitoringReportReq struct {
-	RequestId   int                 `json:"requestId" validate:"required"`
-	Tbc         bool                 `json:"tbc,omitempty"`
-	SeqNo       *int                 `json:"seqNo" validate:"required,gte=0"`
+	CustomData  *CustomDataType      `json:"customData,omitempty"`
+	Monitor     []MonitoringDataType `json:"monitor,omitempty" validate:"omitempty,min=1,dive"`
+	RequestId   *int                 `json:"requestId" validate:"required"`
+	Tbc         *bool                `json:"tbc,omitempty"`
+	SeqNo       *int                 `json:"seqNo" validate:"required"`
 	GeneratedAt string               `json:"generatedAt" validate:"required,ISO8601date"`
-	Monitor     []MonitoringDataType `json:"monitor,omitempty" validate:"omitempty,dive,required"`
 }
 
 type NotifyReportReq struct {
-	RequestId   int             `json:"requestId" validate:"required"`
+	CustomData  *CustomDataType  `json:"customData,omitempty"`
+	RequestId   *int             `json:"requestId" validate:"required"`
 	GeneratedAt string           `json:"generatedAt" validate:"required,ISO8601date"`
-	Tbc         bool             `json:"tbc,omitempty"`
-	SeqNo       *int             `json:"seqNo" validate:"required,gte=0"`
-	ReportData  []ReportDataType `json:"monitor,omitempty" validate:"omitempty,dive,required"`
+	ReportData  []ReportDataType `json:"reportData,omitempty" validate:"omitempty,min=1,dive"`
+	Tbc         *bool            `json:"tbc,omitempty"`
+	SeqNo       *int             `json:"seqNo" validate:"required"`
 }
 
 type PublishFirmwareReq struct {
-	Location      string `json:"location" validate:"required,max=512"`
-	Retries       int   `json:"retries,omitempty"`
-	CheckSum      string `json:"checkSum" validate:"required,max=32"`
-	RequestId     int   `json:"requestId" validate:"required"`
-	RetryInterval int   `json:"retryInterval,omitempty"`
+	CustomData    *CustomDataType `json:"customData,omitempty"`
+	Location      string          `json:"location" validate:"required,max=512"`
+	Retries       *int            `json:"retries,omitempty"`
+	Checksum      string          `json:"checksum" validate:"required,max=32"`
+	RequestId     *int            `json:"requestId" validate:"required"`
+	RetryInterval *int            `json:"retryInterval,omitempty"`
 }
 
 type PublishFirmwareStatusNotificationReq struct {
-	Status    string   `json:"status" validate:"required,PublishFirmwareStatusEnumType"`
-	Location  []string `json:"location,omitempty" validate:"omitempty,dive,required,max=512"`
-	RequestId int     `json:"requestId,omitempty"`
+	CustomData *CustomDataType               `json:"customData,omitempty"`
+	Status     PublishFirmwareStatusEnumType `json:"status" validate:"required,PublishFirmwareStatusEnumType"`
+	Location   []string                      `json:"location,omitempty" validate:"omitempty,min=1,dive,max=512"`
+	RequestId  *int                          `json:"requestId,omitempty"`
 }
 
 type ReportChargingProfilesReq struct {
-	RequestId           int                  `json:"requestId" validate:"required"`
-	ChargingLimitSource string                `json:"chargingLimitSource" validate:"required,ChargingLimitSourceEnumType"`
-	Tbc                 bool                  `json:"tbc,omitempty"`
-	EvseId              *int                  `json:"evseId" validate:"required,gte=0"`
-	ChargingProfile     []ChargingProfileType `json:"chargingProfile" validate:"required,dive,required"`
+	CustomData          *CustomDataType             `json:"customData,omitempty"`
+	RequestId           *int                        `json:"requestId" validate:"required"`
+	ChargingLimitSource ChargingLimitSourceEnumType `json:"chargingLimitSource" validate:"required,ChargingLimitSourceEnumType"`
+	ChargingProfile     []ChargingProfileType       `json:"chargingProfile" validate:"required,min=1,dive"`
+	Tbc                 *bool                       `json:"tbc,omitempty"`
+	EvseId              *int                        `json:"evseId" validate:"required"`
 }
 
 type RequestStartTransactionReq struct {
-	EvseId          *int                `json:"evseId,omitempty" validate:"omitempty,gt=0"`
-	RemoteStartId   int                `json:"remoteStartId" validate:"required"`
-	IdToken         IdTokenType         `json:"idToken" validate:"required"`
-	ChargingProfile ChargingProfileType `json:"chargingProfile,omitempty"`
-	GroupIdToken    IdTokenType         `json:"groupIdToken,omitempty"`
+	CustomData      *CustomDataType      `json:"customData,omitempty"`
+	EvseId          *int                 `json:"evseId,omitempty"`
+	GroupIdToken    *IdTokenType         `json:"groupIdToken,omitempty"`
+	IdToken         IdTokenType          `json:"idToken"`
+	RemoteStartId   *int                 `json:"remoteStartId" validate:"required"`
+	ChargingProfile *ChargingProfileType `json:"chargingProfile,omitempty"`
 }
 
 type RequestStopTransactionReq struct {
-	TransactionId string `json:"transactionId" validate:"required,max=36"`
+	CustomData    *CustomDataType `json:"customData,omitempty"`
+	TransactionId string          `json:"transactionId" validate:"required,max=36"`
 }
 
 type ReservationStatusUpdateReq struct {
-	ReservationId           int   `json:"reservationId" validate:"required"`
-	ReservationUpdateStatus string `json:"reservationUpdateStatus" validate:"required,ReservationUpdateStatusEnumType"`
+	CustomData              *CustomDataType                 `json:"customData,omitempty"`
+	ReservationId           *int                            `json:"reservationId" validate:"required"`
+	ReservationUpdateStatus ReservationUpdateStatusEnumType `json:"reservationUpdateStatus" validate:"required,ReservationUpdateStatusEnumType"`
 }
 
 type ReserveNowReq struct {
-	Id             int        `json:"id" validate:"required"`
-	ExpiryDateTime string      `json:"expiryDateTime" validate:"required,ISO8601date"`
-	ConnectorType  string      `json:"connectorType,omitempty" validate:"omitempty,ConnectorEnumType"`
-	EvseId         *int        `json:"evseId,omitempty"`
-	IdToken        IdTokenType `json:"idToken" validate:"required"`
-	GroupIdToken   IdTokenType `json:"groupIdToken,omitempty"`
+	CustomData     *CustomDataType   `json:"customData,omitempty"`
+	Id             *int              `json:"id" validate:"required"`
+	ExpiryDateTime string            `json:"expiryDateTime" validate:"required,ISO8601date"`
+	ConnectorType  ConnectorEnumType `json:"connectorType,omitempty" validate:"omitempty,ConnectorEnumType"`
+	IdToken        IdTokenType       `json:"idToken"`
+	EvseId         *int              `json:"evseId,omitempty"`
+	GroupIdToken   *IdTokenType      `json:"groupIdToken,omitempty"`
 }
 
 type ResetReq struct {
-	Type   string `json:"type" validate:"required,ResetTypeEnumType"`
-	EvseId *int   `json:"evseId,omitempty"`
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Type       ResetEnumType   `json:"type" validate:"required,ResetEnumType"`
+	EvseId     *int            `json:"evseId,omitempty"`
 }
 
 type SecurityEventNotificationReq struct {
-	Type      string `json:"type" validate:"required,ResetTypeEnumType"`
-	Timestamp string `json:"timestamp" validate:"required,ISO8601date"`
-	TechInfo  string `json:"techInfo,omitempty" validate:"omitempty,max=255"`
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Type       string          `json:"type" validate:"required,max=50"`
+	Timestamp  string          `json:"timestamp" validate:"required,ISO8601date"`
+	TechInfo   string          `json:"techInfo,omitempty" validate:"omitempty,max=255"`
 }
 
 type SendLocalListReq struct {
+	CustomData             *CustomDataType     `json:"customData,omitempty"`
+	LocalAuthorizationList []AuthorizationData `json:"localAuthorizationList,omitempty" validate:"omitempty,min=1,dive"`
 	VersionNumber          *int                `json:"versionNumber" validate:"required"`
-	UpdateType             string              `json:"updateType" validate:"required,UpdateTypeEnumType"`
-	LocalAuthorizationList []AuthorizarionData `json:"localAuthorizationList,omitempty" validate:"omitempty,dive,required"`
+	UpdateType             UpdateEnumType      `json:"updateType" validate:"required,UpdateEnumType"`
 }
 
 type SetChargingProfileReq struct {
-	EvseId          *int                `json:"evseId" validate:"required,gte=0"`
-	ChargingProfile ChargingProfileType `json:"chargingProfile" validate:"required"`
+	CustomData      *CustomDataType     `json:"customData,omitempty"`
+	EvseId          *int                `json:"evseId" validate:"required"`
+	ChargingProfile ChargingProfileType `json:"chargingProfile"`
 }
 
 type SetDisplayMessageReq struct {
-	Message MessageInfoType `json:"message" validate:"required"`
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Message    MessageInfoType `json:"message"`
 }
 
 type SetMonitoringBaseReq struct {
-	MonitoringBase string `json:"monitoringBase" validate:"required,MonitoringBaseEnumType"`
+	CustomData     *CustomDataType        `json:"customData,omitempty"`
+	MonitoringBase MonitoringBaseEnumType `json:"monitoringBase" validate:"required,MonitoringBaseEnumType"`
 }
 
 type SetMonitoringLevelReq struct {
-	Severity *int `json:"severity" validate:"required,gte=0,lte=9"`
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Severity   *int            `json:"severity" validate:"required"`
 }
 
 type SetNetworkProfileReq struct {
-	ConfigurationSlot int                         `json:"configurationSlot" validate:"required"`
-	ConnectionData    NetworkConnectionProfileType `json:"connectionData" validate:"required"`
+	CustomData        *CustomDataType              `json:"customData,omitempty"`
+	ConfigurationSlot *int                         `json:"configurationSlot" validate:"required"`
+	ConnectionData    NetworkConnectionProfileType `json:"connectionData"`
 }
 
 type SetVariableMonitoringReq struct {
-	SetMonitoringData []SetMonitoringDataType `json:"setMonitoringData" validate:"required,dive,required"`
+	CustomData        *CustomDataType         `json:"customData,omitempty"`
+	SetMonitoringData []SetMonitoringDataType `json:"setMonitoringData" validate:"required,min=1,dive"`
 }
 
 type SetVariablesReq struct {
-	SetVariableData []SetVariableDataType `json:"setVariableData" validate:"required,dive,required"`
+	CustomData      *CustomDataType       `json:"customData,omitempty"`
+	SetVariableData []SetVariableDataType `json:"setVariableData" validate:"required,min=1,dive"`
 }
 
 type SignCertificateReq struct {
-	Csr         string `json:"csr" validate:"required,max=5500"`
-	Certificate string `json:"certificate,omitempty" validate:"omitempty,CertificateSigningUseEnumType"`
+	CustomData      *CustomDataType               `json:"customData,omitempty"`
+	Csr             string                        `json:"csr" validate:"required,max=5500"`
+	CertificateType CertificateSigningUseEnumType `json:"certificateType,omitempty" validate:"omitempty,CertificateSigningUseEnumType"`
 }
 
 type StatusNotificationReq struct {
-	Timestamp       string `json:"timestamp" validate:"required,ISO8601date"`
-	ConnectorStatus string `json:"connectorStatus" validate:"required,ConnectorStatusEnumType"`
-	EvseId          *int   `json:"evseId" validate:"required"`
-	ConnectorId     *int   `json:"connectorId" validate:"required"`
+	CustomData      *CustomDataType         `json:"customData,omitempty"`
+	Timestamp       string                  `json:"timestamp" validate:"required,ISO8601date"`
+	ConnectorStatus ConnectorStatusEnumType `json:"connectorStatus" validate:"required,ConnectorStatusEnumType"`
+	EvseId          *int                    `json:"evseId" validate:"required"`
+	ConnectorId     *int                    `json:"connectorId" validate:"required"`
 }
 
 type TransactionEventReq struct {
-	EventType          string           `json:"eventType" validate:"required,TransactionEventTypeEnumType"`
-	Timestamp          string           `json:"timestamp" validate:"required,ISO8601date"`
-	TriggerReason      string           `json:"triggerReason" validate:"required,TriggerReasonEnumType"`
-	SeqNo              int             `json:"seqNo" validate:"required"`
-	Offline            bool             `json:"offline,omitempty"`
-	NumberOfPhasesUsed int             `json:"numberOfPhasesUsed,omitempty"`
-	CableMaxCurrent    int             `json:"cableMaxCurrent,omitempty"`
-	ReservationId      int             `json:"reservationId,omitempty"`
-	TransactionInfo    TransactionType  `json:"transactionInfo" validate:"required"`
-	IdToken            IdTokenType      `json:"idToken,omitempty"`
-	Evse               EVSEType         `json:"evse,omitempty"`
-	MeterValue         []MeterValueType `json:"meterValue,omitempty" validate:"omitempty,dive,required"`
+	CustomData         *CustomDataType          `json:"customData,omitempty"`
+	EventType          TransactionEventEnumType `json:"eventType" validate:"required,TransactionEventEnumType"`
+	MeterValue         []MeterValueType         `json:"meterValue,omitempty" validate:"omitempty,min=1,dive"`
+	Timestamp          string                   `json:"timestamp" validate:"required,ISO8601date"`
+	TriggerReason      TriggerReasonEnumType    `json:"triggerReason" validate:"required,TriggerReasonEnumType"`
+	SeqNo              *int                     `json:"seqNo" validate:"required"`
+	Offline            *bool                    `json:"offline,omitempty"`
+	NumberOfPhasesUsed *int                     `json:"numberOfPhasesUsed,omitempty"`
+	CableMaxCurrent    *int                     `json:"cableMaxCurrent,omitempty"`
+	ReservationId      *int                     `json:"reservationId,omitempty"`
+	TransactionInfo    TransactionType          `json:"transactionInfo"`
+	Evse               *EVSEType                `json:"evse,omitempty"`
+	IdToken            *IdTokenType             `json:"idToken,omitempty"`
 }
 
 type TriggerMessageReq struct {
-	RequestedMessage string   `json:"requestedMessage" validate:"required,MessageTriggerEnumType"`
-	Evse             EVSEType `json:"evse,omitempty"`
+	CustomData       *CustomDataType        `json:"customData,omitempty"`
+	Evse             *EVSEType              `json:"evse,omitempty"`
+	RequestedMessage MessageTriggerEnumType `json:"requestedMessage" validate:"required,MessageTriggerEnumType"`
 }
 
 type UnlockConnectorReq struct {
-	EvseId      *int `json:"evseId" validate:"required,gte=0"`
-	ConnectorId *int `json:"connectorId" validate:"required,gte=0"`
+	CustomData  *CustomDataType `json:"customData,omitempty"`
+	EvseId      *int            `json:"evseId" validate:"required"`
+	ConnectorId *int            `json:"connectorId" validate:"required"`
 }
 
 type UnpublishFirmwareReq struct {
-	Checksum string `json:"checksum" validate:"required,max=32"`
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Checksum   string          `json:"checksum" validate:"required,max=32"`
 }
 
 type UpdateFirmwareReq struct {
-	Retries       int         `json:"retries,omitempty"`
-	RetryInterval int         `json:"retryInterval,omitempty"`
-	RequestId     int         `json:"requestId" validate:"required"`
-	Firmware      FirmwareType `json:"firmware" validate:"required"`
+	CustomData    *CustomDataType `json:"customData,omitempty"`
+	Retries       *int            `json:"retries,omitempty"`
+	RetryInterval *int            `json:"retryInterval,omitempty"`
+	RequestId     *int            `json:"requestId" validate:"required"`
+	Firmware      FirmwareType    `json:"firmware"`
 }
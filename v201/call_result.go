@@ -1,277 +1,359 @@
+// Code generated by ocppgen from the OCPP JSON schemas. DO NOT EDIT.
+
 package v201
 
 type AuthorizeRes struct {
-	CertificateStatus string      		`json:"certificateStatus,omitempty" validate:"omitempty,AuthorizeCertificateStatusEnumType"` 
-	IdTokenInfo       IdTokenInfoType 	`json:"idTokenInfo" validate:"required"`
+	CustomData        *CustomDataType                    `json:"customData,omitempty"`
+	IdTokenInfo       IdTokenInfoType                    `json:"idTokenInfo"`
+	CertificateStatus AuthorizeCertificateStatusEnumType `json:"certificateStatus,omitempty" validate:"omitempty,AuthorizeCertificateStatusEnumType"`
 }
 
 type BootNotificationRes struct {
-	CurrentTime string         `json:"currentTime" validate:"required,ISO8601date"` 
-	Interval    int           `json:"interval" validate:"required"`
-	Status      string         `json:"status" validate:"required,RegistrationStatusEnumType"` 
-	StatusInfo  StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData  *CustomDataType            `json:"customData,omitempty"`
+	CurrentTime string                     `json:"currentTime" validate:"required,ISO8601date"`
+	Interval    *int                       `json:"interval" validate:"required"`
+	Status      RegistrationStatusEnumType `json:"status" validate:"required,RegistrationStatusEnumType"`
+	StatusInfo  *StatusInfoType            `json:"statusInfo,omitempty"`
 }
 
 type CancelReservationRes struct {
-	Status     string         `json:"status" validate:"required,CancelReservationStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType                 `json:"customData,omitempty"`
+	Status     CancelReservationStatusEnumType `json:"status" validate:"required,CancelReservationStatusEnumType"`
+	StatusInfo *StatusInfoType                 `json:"statusInfo,omitempty"`
 }
 
 type CertificateSignedRes struct {
-	Status     string         `json:"status" validate:"required,CertificateSignedStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType                 `json:"customData,omitempty"`
+	Status     CertificateSignedStatusEnumType `json:"status" validate:"required,CertificateSignedStatusEnumType"`
+	StatusInfo *StatusInfoType                 `json:"statusInfo,omitempty"`
 }
 
 type ChangeAvailabilityRes struct {
-	Status     string         `json:"status" validate:"required,ChangeAvailabilityStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType                  `json:"customData,omitempty"`
+	Status     ChangeAvailabilityStatusEnumType `json:"status" validate:"required,ChangeAvailabilityStatusEnumType"`
+	StatusInfo *StatusInfoType                  `json:"statusInfo,omitempty"`
 }
 
 type ClearCacheRes struct {
-	Status     string         `json:"status" validate:"required,ClearCacheStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType          `json:"customData,omitempty"`
+	Status     ClearCacheStatusEnumType `json:"status" validate:"required,ClearCacheStatusEnumType"`
+	StatusInfo *StatusInfoType          `json:"statusInfo,omitempty"`
 }
 
 type ClearChargingProfileRes struct {
-	Status     string         `json:"status" validate:"required,ClearChargingProfileStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType                    `json:"customData,omitempty"`
+	Status     ClearChargingProfileStatusEnumType `json:"status" validate:"required,ClearChargingProfileStatusEnumType"`
+	StatusInfo *StatusInfoType                    `json:"statusInfo,omitempty"`
 }
 
 type ClearDisplayMessageRes struct {
-	Status     string         `json:"status" validate:"required,ClearMessageStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType            `json:"customData,omitempty"`
+	Status     ClearMessageStatusEnumType `json:"status" validate:"required,ClearMessageStatusEnumType"`
+	StatusInfo *StatusInfoType            `json:"statusInfo,omitempty"`
 }
 
-type ClearedChargingLimitRes struct{}
-
 type ClearVariableMonitoringRes struct {
-	ClearMonitoringResult []ClearMonitoringResultType `json:"clearMonitoringResult" validate:"required,dive,required"`
+	CustomData            *CustomDataType             `json:"customData,omitempty"`
+	ClearMonitoringResult []ClearMonitoringResultType `json:"clearMonitoringResult" validate:"required,min=1,dive"`
 }
 
-type CostUpdatedRes struct{}
+type ClearedChargingLimitRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type CostUpdatedRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
 
 type CustomerInformationRes struct {
-	Status     string         `json:"status" validate:"required,CustomerInformationStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType                   `json:"customData,omitempty"`
+	Status     CustomerInformationStatusEnumType `json:"status" validate:"required,CustomerInformationStatusEnumType"`
+	StatusInfo *StatusInfoType                   `json:"statusInfo,omitempty"`
 }
 
 type DataTransferRes struct {
-	Status     string         `json:"status" validate:"required,DataTransferStatusEnumType"` 
-	Data       interface{}    `json:"data,omitempty" `
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType            `json:"customData,omitempty"`
+	Status     DataTransferStatusEnumType `json:"status" validate:"required,DataTransferStatusEnumType"`
+	StatusInfo *StatusInfoType            `json:"statusInfo,omitempty"`
+	Data       interface{}                `json:"data,omitempty"`
 }
 
 type DeleteCertificateRes struct {
-	Status     string         `json:"status" validate:"required,DeleteCertificateStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType                 `json:"customData,omitempty"`
+	Status     DeleteCertificateStatusEnumType `json:"status" validate:"required,DeleteCertificateStatusEnumType"`
+	StatusInfo *StatusInfoType                 `json:"statusInfo,omitempty"`
 }
 
-type FirmwareStatusNotificationRes struct{}
+type FirmwareStatusNotificationRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
 
 type Get15118EVCertificateRes struct {
-	Status      string         `json:"status" validate:"required,Iso15118EVCertificateStatusEnumType"` // todo
-	ExiResponse string         `json:"exiResponse" validate:"required,max=5600"`
-	StatusInfo  StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData  *CustomDataType                     `json:"customData,omitempty"`
+	Status      Iso15118EVCertificateStatusEnumType `json:"status" validate:"required,Iso15118EVCertificateStatusEnumType"`
+	StatusInfo  *StatusInfoType                     `json:"statusInfo,omitempty"`
+	ExiResponse string                              `json:"exiResponse" validate:"required,max=5600"`
 }
 
 type GetBaseReportRes struct {
-	Status     string         `json:"status" validate:"required,GenericDeviceModelStatusEnumType"` // todo
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty"`
+	CustomData *CustomDataType                  `json:"customData,omitempty"`
+	Status     GenericDeviceModelStatusEnumType `json:"status" validate:"required,GenericDeviceModelStatusEnumType"`
+	StatusInfo *StatusInfoType                  `json:"statusInfo,omitempty"`
 }
 
 type GetCertificateStatusRes struct {
-	Status     string         `json:"status" validate:"required,GetCertificateStatusEnumType"` // todo
-	OcspResult string         `json:"ocspResult,omitempty" validate:"omitempty,max=5500"`
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty"`
+	CustomData *CustomDataType              `json:"customData,omitempty"`
+	Status     GetCertificateStatusEnumType `json:"status" validate:"required,GetCertificateStatusEnumType"`
+	StatusInfo *StatusInfoType              `json:"statusInfo,omitempty"`
+	OcspResult string                       `json:"ocspResult,omitempty" validate:"omitempty,max=5500"`
 }
 
 type GetChargingProfilesRes struct {
-	Status     string         `json:"status" validate:"required,GetChargingProfilesStatusEnumType"` // todo
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty"`
+	CustomData *CustomDataType                  `json:"customData,omitempty"`
+	Status     GetChargingProfileStatusEnumType `json:"status" validate:"required,GetChargingProfileStatusEnumType"`
+	StatusInfo *StatusInfoType                  `json:"statusInfo,omitempty"`
 }
 
 type GetCompositeScheduleRes struct {
-	Status     string                `json:"status" validate:"required,GenericStatusEnumType"`
-	Schedule   CompositeScheduleType `json:"schedule,omitempty"`
-	StatusInfo StatusInfoType        `json:"statusInfo,omitempty"`
+	CustomData *CustomDataType        `json:"customData,omitempty"`
+	Status     GenericStatusEnumType  `json:"status" validate:"required,GenericStatusEnumType"`
+	StatusInfo *StatusInfoType        `json:"statusInfo,omitempty"`
+	Schedule   *CompositeScheduleType `json:"schedule,omitempty"`
 }
 
 type GetDisplayMessagesRes struct {
-	Status     string         `json:"status" validate:"required,GetDisplayMessagesStatusEnumType"`
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty"`
+	CustomData *CustomDataType                  `json:"customData,omitempty"`
+	Status     GetDisplayMessagesStatusEnumType `json:"status" validate:"required,GetDisplayMessagesStatusEnumType"`
+	StatusInfo *StatusInfoType                  `json:"statusInfo,omitempty"`
 }
 
 type GetInstalledCertificateIdsRes struct {
-	Status                   string                         `json:"status" validate:"required,GetInstalledCertificateIdsStatusEnumType"`
-	CertificateHashDataChain []CertificateHashDataChainType `json:"certificateHashDataChain,omitempty" validate:"omitempty,dive,required"`
-	StatusInfo               StatusInfoType                 `json:"statusInfo,omitempty"`
+	CustomData               *CustomDataType                       `json:"customData,omitempty"`
+	Status                   GetInstalledCertificateStatusEnumType `json:"status" validate:"required,GetInstalledCertificateStatusEnumType"`
+	StatusInfo               *StatusInfoType                       `json:"statusInfo,omitempty"`
+	CertificateHashDataChain []CertificateHashDataChainType        `json:"certificateHashDataChain,omitempty" validate:"omitempty,min=1,dive"`
 }
 
 type GetLocalListVersionRes struct {
-	VersionNumber *int `json:"versionNumber" validate:"required"`
+	CustomData    *CustomDataType `json:"customData,omitempty"`
+	VersionNumber *int            `json:"versionNumber" validate:"required"`
 }
 
 type GetLogRes struct {
-	Status     string         `json:"status" validate:"required,LogStatusEnumType"`
-	Filename   string         `json:"filename,omitempty" validate:"omitempty,max=255"`
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty"`
+	CustomData *CustomDataType   `json:"customData,omitempty"`
+	Status     LogStatusEnumType `json:"status" validate:"required,LogStatusEnumType"`
+	StatusInfo *StatusInfoType   `json:"statusInfo,omitempty"`
+	Filename   string            `json:"filename,omitempty" validate:"omitempty,max=255"`
 }
 
 type GetMonitoringReportRes struct {
-	Status     string         `json:"status" validate:"required,GenericDeviceModelStatusEnumType"`
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty"`
+	CustomData *CustomDataType                  `json:"customData,omitempty"`
+	Status     GenericDeviceModelStatusEnumType `json:"status" validate:"required,GenericDeviceModelStatusEnumType"`
+	StatusInfo *StatusInfoType                  `json:"statusInfo,omitempty"`
 }
 
 type GetReportRes struct {
-	Status     string         `json:"status" validate:"required,GenericDeviceModelStatusEnumType"`
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty"`
+	CustomData *CustomDataType                  `json:"customData,omitempty"`
+	Status     GenericDeviceModelStatusEnumType `json:"status" validate:"required,GenericDeviceModelStatusEnumType"`
+	StatusInfo *StatusInfoType                  `json:"statusInfo,omitempty"`
 }
 
 type GetTransactionStatusRes struct {
-	OngoingIndicator bool `json:"ongoingIndicator,omitempty"`
-	MessagesInQueue  bool `json:"messagesInQueue" validate:"required"`
+	CustomData       *CustomDataType `json:"customData,omitempty"`
+	OngoingIndicator *bool           `json:"ongoingIndicator,omitempty"`
+	MessagesInQueue  *bool           `json:"messagesInQueue" validate:"required"`
 }
 
 type GetVariablesRes struct {
-	GetVariableResult []GetVariableResultType `json:"getVariableResult" validate:"required,dive,required"`
+	CustomData        *CustomDataType         `json:"customData,omitempty"`
+	GetVariableResult []GetVariableResultType `json:"getVariableResult" validate:"required,min=1,dive"`
 }
 
 type HeartbeatRes struct {
-	CurrentTime string `json:"currentTime" validate:"required,ISO8601date"`
+	CustomData  *CustomDataType `json:"customData,omitempty"`
+	CurrentTime string          `json:"currentTime" validate:"required,ISO8601date"`
 }
 
 type InstallCertificateRes struct {
-	Status     string         `json:"status" validate:"required,InstallCertificateStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType                  `json:"customData,omitempty"`
+	Status     InstallCertificateStatusEnumType `json:"status" validate:"required,InstallCertificateStatusEnumType"`
+	StatusInfo *StatusInfoType                  `json:"statusInfo,omitempty"`
 }
 
-type LogStatusNotificationRes struct{}
+type LogStatusNotificationRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
 
-type MeterValuesRes struct{}
+type MeterValuesRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
 
-type NotifyChargingLimitRes struct{}
+type NotifyChargingLimitRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
 
-type NotifyCustomerInformationRes struct{}
+type NotifyCustomerInformationRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
 
-type NotifyDisplayMessagesRes struct{}
+type NotifyDisplayMessagesRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
 
 type NotifyEVChargingNeedsRes struct {
-	Status     string         `json:"status" validate:"required,NotifyEVChargingNeedsStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType                     `json:"customData,omitempty"`
+	Status     NotifyEVChargingNeedsStatusEnumType `json:"status" validate:"required,NotifyEVChargingNeedsStatusEnumType"`
+	StatusInfo *StatusInfoType                     `json:"statusInfo,omitempty"`
 }
 
 type NotifyEVChargingScheduleRes struct {
-	Status     string         `json:"status" validate:"required,GenericStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType       `json:"customData,omitempty"`
+	Status     GenericStatusEnumType `json:"status" validate:"required,GenericStatusEnumType"`
+	StatusInfo *StatusInfoType       `json:"statusInfo,omitempty"`
 }
 
-type NotifyEventRes struct{}
+type NotifyEventRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
 
-type NotifyMonitoringReportRes struct{}
+type NotifyMonitoringReportRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
 
-type NotifyReportRes struct{}
+type NotifyReportRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
 
 type PublishFirmwareRes struct {
-	Status     string         `json:"status" validate:"required,GenericStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType       `json:"customData,omitempty"`
+	Status     GenericStatusEnumType `json:"status" validate:"required,GenericStatusEnumType"`
+	StatusInfo *StatusInfoType       `json:"statusInfo,omitempty"`
 }
 
-type PublishFirmwareStatusNotificationRes struct{}
+type PublishFirmwareStatusNotificationRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
 
-type ReportChargingProfilesRes struct{}
+type ReportChargingProfilesRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
 
 type RequestStartTransactionRes struct {
-	Status        string         `json:"status" validate:"required,RequestStartStopStatusEnumType"` 
-	TransactionId string         `json:"transactionId,omitempty" validate:"omitempty,max=36"`
-	StatusInfo    StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData    *CustomDataType                `json:"customData,omitempty"`
+	Status        RequestStartStopStatusEnumType `json:"status" validate:"required,RequestStartStopStatusEnumType"`
+	StatusInfo    *StatusInfoType                `json:"statusInfo,omitempty"`
+	TransactionId string                         `json:"transactionId,omitempty" validate:"omitempty,max=36"`
 }
 
 type RequestStopTransactionRes struct {
-	Status     string         `json:"status" validate:"required,RequestStartStopStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty"`
+	CustomData *CustomDataType                `json:"customData,omitempty"`
+	Status     RequestStartStopStatusEnumType `json:"status" validate:"required,RequestStartStopStatusEnumType"`
+	StatusInfo *StatusInfoType                `json:"statusInfo,omitempty"`
 }
 
-type ReservationStatusUpdateRes struct{}
+type ReservationStatusUpdateRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
 
 type ReserveNowRes struct {
-	Status     string         `json:"status" validate:"required,ReserveNowStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType          `json:"customData,omitempty"`
+	Status     ReserveNowStatusEnumType `json:"status" validate:"required,ReserveNowStatusEnumType"`
+	StatusInfo *StatusInfoType          `json:"statusInfo,omitempty"`
 }
 
 type ResetRes struct {
-	Status     string         `json:"status" validate:"required,ResetStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType     `json:"customData,omitempty"`
+	Status     ResetStatusEnumType `json:"status" validate:"required,ResetStatusEnumType"`
+	StatusInfo *StatusInfoType     `json:"statusInfo,omitempty"`
 }
 
-type SecurityEventNotificationRes struct{}
+type SecurityEventNotificationRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
 
 type SendLocalListRes struct {
-	Status     string         `json:"status" validate:"required,SendLocalListStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType             `json:"customData,omitempty"`
+	Status     SendLocalListStatusEnumType `json:"status" validate:"required,SendLocalListStatusEnumType"`
+	StatusInfo *StatusInfoType             `json:"statusInfo,omitempty"`
 }
 
 type SetChargingProfileRes struct {
-	Status     string         `json:"status" validate:"required,ChargingProfileStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType               `json:"customData,omitempty"`
+	Status     ChargingProfileStatusEnumType `json:"status" validate:"required,ChargingProfileStatusEnumType"`
+	StatusInfo *StatusInfoType               `json:"statusInfo,omitempty"`
 }
 
 type SetDisplayMessageRes struct {
-	Status     string         `json:"status" validate:"required,DisplayMessageStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType              `json:"customData,omitempty"`
+	Status     DisplayMessageStatusEnumType `json:"status" validate:"required,DisplayMessageStatusEnumType"`
+	StatusInfo *StatusInfoType              `json:"statusInfo,omitempty"`
 }
 
 type SetMonitoringBaseRes struct {
-	Status     string         `json:"status" validate:"required,GenericDeviceModelStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType                  `json:"customData,omitempty"`
+	Status     GenericDeviceModelStatusEnumType `json:"status" validate:"required,GenericDeviceModelStatusEnumType"`
+	StatusInfo *StatusInfoType                  `json:"statusInfo,omitempty"`
 }
 
 type SetMonitoringLevelRes struct {
-	Status     string         `json:"status" validate:"required,GenericStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType       `json:"customData,omitempty"`
+	Status     GenericStatusEnumType `json:"status" validate:"required,GenericStatusEnumType"`
+	StatusInfo *StatusInfoType       `json:"statusInfo,omitempty"`
 }
 
 type SetNetworkProfileRes struct {
-	Status     string         `json:"status" validate:"required,SetNetworkProfileStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType                 `json:"customData,omitempty"`
+	Status     SetNetworkProfileStatusEnumType `json:"status" validate:"required,SetNetworkProfileStatusEnumType"`
+	StatusInfo *StatusInfoType                 `json:"statusInfo,omitempty"`
 }
 
 type SetVariableMonitoringRes struct {
-	SetMonitoringResult []SetMonitoringResultType `json:"setMonitoringResult" validate:"required,dive,required"`
+	CustomData          *CustomDataType           `json:"customData,omitempty"`
+	SetMonitoringResult []SetMonitoringResultType `json:"setMonitoringResult" validate:"required,min=1,dive"`
 }
 
 type SetVariablesRes struct {
-	SetVariableResult []SetVariableResultType `json:"setVariableResult" validate:"required,dive,required"`
+	CustomData        *CustomDataType         `json:"customData,omitempty"`
+	SetVariableResult []SetVariableResultType `json:"setVariableResult" validate:"required,min=1,dive"`
 }
 
 type SignCertificateRes struct {
-	Status     string         `json:"status" validate:"required,GenericStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType       `json:"customData,omitempty"`
+	Status     GenericStatusEnumType `json:"status" validate:"required,GenericStatusEnumType"`
+	StatusInfo *StatusInfoType       `json:"statusInfo,omitempty"`
 }
 
-type StatusNotificationRes struct{}
+type StatusNotificationRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
 
 type TransactionEventRes struct {
-	TotalCost              float32            `json:"totalCost,omitempty" validate:"omitempty,min=0"`
-	ChargingPriority       *int               `json:"chargingPriority,omitempty" validate:"omitempty,gte=-9,lte=9"`
-	IdTokenInfo            IdTokenInfoType    `json:"idTokenInfo,omitempty"`
-	UpdatedPersonalMessage MessageContentType `json:"updatedPersonalMessage,omitempty"`
+	CustomData             *CustomDataType     `json:"customData,omitempty"`
+	TotalCost              *float64            `json:"totalCost,omitempty"`
+	ChargingPriority       *int                `json:"chargingPriority,omitempty"`
+	IdTokenInfo            *IdTokenInfoType    `json:"idTokenInfo,omitempty"`
+	UpdatedPersonalMessage *MessageContentType `json:"updatedPersonalMessage,omitempty"`
 }
 
 type TriggerMessageRes struct {
-	Status     string         `json:"status" validate:"required,TriggerMessageStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType              `json:"customData,omitempty"`
+	Status     TriggerMessageStatusEnumType `json:"status" validate:"required,TriggerMessageStatusEnumType"`
+	StatusInfo *StatusInfoType              `json:"statusInfo,omitempty"`
 }
 
 type UnlockConnectorRes struct {
-	Status     string         `json:"status" validate:"required,UnlockStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType      `json:"customData,omitempty"`
+	Status     UnlockStatusEnumType `json:"status" validate:"required,UnlockStatusEnumType"`
+	StatusInfo *StatusInfoType      `json:"statusInfo,omitempty"`
 }
 
 type UnpublishFirmwareRes struct {
-	Status string `json:"status" validate:"required,UnpublishFirmwareStatusEnumType"` 
+	CustomData *CustomDataType                 `json:"customData,omitempty"`
+	Status     UnpublishFirmwareStatusEnumType `json:"status" validate:"required,UnpublishFirmwareStatusEnumType"`
 }
 
 type UpdateFirmwareRes struct {
-	Status     string         `json:"status" validate:"required,UpdateFirmwareStatusEnumType"` 
-	StatusInfo StatusInfoType `json:"statusInfo,omitempty" `
+	CustomData *CustomDataType              `json:"customData,omitempty"`
+	Status     UpdateFirmwareStatusEnumType `json:"status" validate:"required,UpdateFirmwareStatusEnumType"`
+	StatusInfo *StatusInfoType              `json:"statusInfo,omitempty"`
 }
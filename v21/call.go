@@ -0,0 +1,619 @@
+// Code generated by ocppgen from the OCPP JSON schemas. DO NOT EDIT.
+
+package v21
+
+type AFRRSignalReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Timestamp  string          `json:"timestamp" validate:"required,ISO8601date"`
+	Signal     *int            `json:"signal" validate:"required"`
+}
+
+type AdjustPeriodicEventStreamReq struct {
+	CustomData *CustomDataType               `json:"customData,omitempty"`
+	Id         *int                          `json:"id" validate:"required"`
+	Params     PeriodicEventStreamParamsType `json:"params"`
+}
+
+type AuthorizeReq struct {
+	CustomData                  *CustomDataType       `json:"customData,omitempty"`
+	IdToken                     IdTokenType           `json:"idToken"`
+	Certificate                 string                `json:"certificate,omitempty" validate:"omitempty,max=5500"`
+	Iso15118CertificateHashData []OCSPRequestDataType `json:"iso15118CertificateHashData,omitempty" validate:"omitempty,min=1,max=4,dive"`
+}
+
+type BatterySwapReq struct {
+	CustomData  *CustomDataType          `json:"customData,omitempty"`
+	BatteryData []BatteryDataType        `json:"batteryData" validate:"required,min=1,dive"`
+	EventType   BatterySwapEventEnumType `json:"eventType" validate:"required,BatterySwapEventEnumType"`
+	IdToken     IdTokenType              `json:"idToken"`
+	RequestId   *int                     `json:"requestId" validate:"required"`
+}
+
+type BootNotificationReq struct {
+	CustomData      *CustomDataType     `json:"customData,omitempty"`
+	ChargingStation ChargingStationType `json:"chargingStation"`
+	Reason          BootReasonEnumType  `json:"reason" validate:"required,BootReasonEnumType"`
+}
+
+type CancelReservationReq struct {
+	CustomData    *CustomDataType `json:"customData,omitempty"`
+	ReservationId *int            `json:"reservationId" validate:"required"`
+}
+
+type CertificateSignedReq struct {
+	CustomData       *CustomDataType               `json:"customData,omitempty"`
+	CertificateChain string                        `json:"certificateChain" validate:"required,max=10000"`
+	CertificateType  CertificateSigningUseEnumType `json:"certificateType,omitempty" validate:"omitempty,CertificateSigningUseEnumType"`
+}
+
+type ChangeAvailabilityReq struct {
+	CustomData        *CustomDataType           `json:"customData,omitempty"`
+	Evse              *EVSEType                 `json:"evse,omitempty"`
+	OperationalStatus OperationalStatusEnumType `json:"operationalStatus" validate:"required,OperationalStatusEnumType"`
+}
+
+type ChangeTransactionTariffReq struct {
+	CustomData    *CustomDataType `json:"customData,omitempty"`
+	Tariff        TariffType      `json:"tariff"`
+	TransactionId string          `json:"transactionId" validate:"required,max=36"`
+}
+
+type ClearCacheReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type ClearChargingProfileReq struct {
+	CustomData              *CustomDataType           `json:"customData,omitempty"`
+	ChargingProfileId       *int                      `json:"chargingProfileId,omitempty"`
+	ChargingProfileCriteria *ClearChargingProfileType `json:"chargingProfileCriteria,omitempty"`
+}
+
+type ClearDERControlReq struct {
+	CustomData  *CustomDataType    `json:"customData,omitempty"`
+	IsDefault   *bool              `json:"isDefault" validate:"required"`
+	ControlType DERControlEnumType `json:"controlType,omitempty" validate:"omitempty,DERControlEnumType"`
+	ControlId   string             `json:"controlId,omitempty" validate:"omitempty,max=36"`
+}
+
+type ClearDisplayMessageReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Id         *int            `json:"id" validate:"required"`
+}
+
+type ClearTariffsReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	TariffIds  []string        `json:"tariffIds,omitempty" validate:"omitempty,min=1,dive,max=60"`
+	EvseId     *int            `json:"evseId,omitempty"`
+}
+
+type ClearVariableMonitoringReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Id         []int           `json:"id" validate:"required,min=1"`
+}
+
+type ClearedChargingLimitReq struct {
+	CustomData          *CustomDataType             `json:"customData,omitempty"`
+	ChargingLimitSource ChargingLimitSourceEnumType `json:"chargingLimitSource" validate:"required,ChargingLimitSourceEnumType"`
+	EvseId              *int                        `json:"evseId,omitempty"`
+}
+
+type ClosePeriodicEventStreamReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Id         *int            `json:"id" validate:"required"`
+}
+
+type CostUpdatedReq struct {
+	CustomData    *CustomDataType `json:"customData,omitempty"`
+	TotalCost     *float64        `json:"totalCost" validate:"required"`
+	TransactionId string          `json:"transactionId" validate:"required,max=36"`
+}
+
+type CustomerInformationReq struct {
+	CustomData          *CustomDataType          `json:"customData,omitempty"`
+	CustomerCertificate *CertificateHashDataType `json:"customerCertificate,omitempty"`
+	IdToken             *IdTokenType             `json:"idToken,omitempty"`
+	RequestId           *int                     `json:"requestId" validate:"required"`
+	Report              *bool                    `json:"report" validate:"required"`
+	Clear               *bool                    `json:"clear" validate:"required"`
+	CustomerIdentifier  string                   `json:"customerIdentifier,omitempty" validate:"omitempty,max=64"`
+}
+
+type DataTransferReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	MessageId  string          `json:"messageId,omitempty" validate:"omitempty,max=50"`
+	Data       interface{}     `json:"data,omitempty"`
+	VendorId   string          `json:"vendorId" validate:"required,max=255"`
+}
+
+type DeleteCertificateReq struct {
+	CustomData          *CustomDataType         `json:"customData,omitempty"`
+	CertificateHashData CertificateHashDataType `json:"certificateHashData"`
+}
+
+type FirmwareStatusNotificationReq struct {
+	CustomData *CustomDataType        `json:"customData,omitempty"`
+	Status     FirmwareStatusEnumType `json:"status" validate:"required,FirmwareStatusEnumType"`
+	RequestId  *int                   `json:"requestId,omitempty"`
+}
+
+type Get15118EVCertificateReq struct {
+	CustomData            *CustomDataType           `json:"customData,omitempty"`
+	Iso15118SchemaVersion string                    `json:"iso15118SchemaVersion" validate:"required,max=50"`
+	Action                CertificateActionEnumType `json:"action" validate:"required,CertificateActionEnumType"`
+	ExiRequest            string                    `json:"exiRequest" validate:"required,max=5600"`
+}
+
+type GetBaseReportReq struct {
+	CustomData *CustomDataType    `json:"customData,omitempty"`
+	RequestId  *int               `json:"requestId" validate:"required"`
+	ReportBase ReportBaseEnumType `json:"reportBase" validate:"required,ReportBaseEnumType"`
+}
+
+type GetCertificateChainStatusReq struct {
+	CustomData                *CustomDataType                    `json:"customData,omitempty"`
+	CertificateStatusRequests []CertificateStatusRequestInfoType `json:"certificateStatusRequests" validate:"required,min=1,max=4,dive"`
+}
+
+type GetCertificateStatusReq struct {
+	CustomData      *CustomDataType     `json:"customData,omitempty"`
+	OcspRequestData OCSPRequestDataType `json:"ocspRequestData"`
+}
+
+type GetChargingProfilesReq struct {
+	CustomData      *CustomDataType              `json:"customData,omitempty"`
+	RequestId       *int                         `json:"requestId" validate:"required"`
+	EvseId          *int                         `json:"evseId,omitempty"`
+	ChargingProfile ChargingProfileCriterionType `json:"chargingProfile"`
+}
+
+type GetCompositeScheduleReq struct {
+	CustomData       *CustomDataType          `json:"customData,omitempty"`
+	Duration         *int                     `json:"duration" validate:"required"`
+	ChargingRateUnit ChargingRateUnitEnumType `json:"chargingRateUnit,omitempty" validate:"omitempty,ChargingRateUnitEnumType"`
+	EvseId           *int                     `json:"evseId" validate:"required"`
+}
+
+type GetDERControlReq struct {
+	CustomData  *CustomDataType    `json:"customData,omitempty"`
+	RequestId   *int               `json:"requestId" validate:"required"`
+	IsDefault   *bool              `json:"isDefault,omitempty"`
+	ControlType DERControlEnumType `json:"controlType,omitempty" validate:"omitempty,DERControlEnumType"`
+	ControlId   string             `json:"controlId,omitempty" validate:"omitempty,max=36"`
+}
+
+type GetDisplayMessagesReq struct {
+	CustomData *CustomDataType         `json:"customData,omitempty"`
+	Id         []int                   `json:"id,omitempty" validate:"omitempty,min=1"`
+	RequestId  *int                    `json:"requestId" validate:"required"`
+	Priority   MessagePriorityEnumType `json:"priority,omitempty" validate:"omitempty,MessagePriorityEnumType"`
+	State      MessageStateEnumType    `json:"state,omitempty" validate:"omitempty,MessageStateEnumType"`
+}
+
+type GetInstalledCertificateIdsReq struct {
+	CustomData      *CustomDataType               `json:"customData,omitempty"`
+	CertificateType []GetCertificateIdUseEnumType `json:"certificateType,omitempty" validate:"omitempty,min=1,dive,GetCertificateIdUseEnumType"`
+}
+
+type GetLocalListVersionReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type GetLogReq struct {
+	CustomData    *CustomDataType   `json:"customData,omitempty"`
+	Log           LogParametersType `json:"log"`
+	LogType       LogEnumType       `json:"logType" validate:"required,LogEnumType"`
+	RequestId     *int              `json:"requestId" validate:"required"`
+	Retries       *int              `json:"retries,omitempty"`
+	RetryInterval *int              `json:"retryInterval,omitempty"`
+}
+
+type GetMonitoringReportReq struct {
+	CustomData         *CustomDataType               `json:"customData,omitempty"`
+	ComponentVariable  []ComponentVariableType       `json:"componentVariable,omitempty" validate:"omitempty,min=1,dive"`
+	RequestId          *int                          `json:"requestId" validate:"required"`
+	MonitoringCriteria []MonitoringCriterionEnumType `json:"monitoringCriteria,omitempty" validate:"omitempty,min=1,max=3,dive,MonitoringCriterionEnumType"`
+}
+
+type GetPeriodicEventStreamReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type GetReportReq struct {
+	CustomData        *CustomDataType              `json:"customData,omitempty"`
+	ComponentVariable []ComponentVariableType      `json:"componentVariable,omitempty" validate:"omitempty,min=1,dive"`
+	RequestId         *int                         `json:"requestId" validate:"required"`
+	ComponentCriteria []ComponentCriterionEnumType `json:"componentCriteria,omitempty" validate:"omitempty,min=1,max=4,dive,ComponentCriterionEnumType"`
+}
+
+type GetTariffsReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	EvseId     *int            `json:"evseId" validate:"required"`
+}
+
+type GetTransactionStatusReq struct {
+	CustomData    *CustomDataType `json:"customData,omitempty"`
+	TransactionId string          `json:"transactionId,omitempty" validate:"omitempty,max=36"`
+}
+
+type GetVariablesReq struct {
+	CustomData      *CustomDataType       `json:"customData,omitempty"`
+	GetVariableData []GetVariableDataType `json:"getVariableData" validate:"required,min=1,dive"`
+}
+
+type HeartbeatReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type InstallCertificateReq struct {
+	CustomData      *CustomDataType               `json:"customData,omitempty"`
+	CertificateType InstallCertificateUseEnumType `json:"certificateType" validate:"required,InstallCertificateUseEnumType"`
+	Certificate     string                        `json:"certificate" validate:"required,max=5500"`
+}
+
+type LogStatusNotificationReq struct {
+	CustomData *CustomDataType         `json:"customData,omitempty"`
+	Status     UploadLogStatusEnumType `json:"status" validate:"required,UploadLogStatusEnumType"`
+	RequestId  *int                    `json:"requestId,omitempty"`
+}
+
+type MeterValuesReq struct {
+	CustomData *CustomDataType  `json:"customData,omitempty"`
+	EvseId     *int             `json:"evseId" validate:"required"`
+	MeterValue []MeterValueType `json:"meterValue" validate:"required,min=1,dive"`
+}
+
+type NotifyAllowedEnergyTransferReq struct {
+	CustomData            *CustomDataType              `json:"customData,omitempty"`
+	TransactionId         string                       `json:"transactionId" validate:"required,max=36"`
+	AllowedEnergyTransfer []EnergyTransferModeEnumType `json:"allowedEnergyTransfer" validate:"required,min=1,dive,EnergyTransferModeEnumType"`
+}
+
+type NotifyChargingLimitReq struct {
+	CustomData       *CustomDataType        `json:"customData,omitempty"`
+	ChargingSchedule []ChargingScheduleType `json:"chargingSchedule,omitempty" validate:"omitempty,min=1,dive"`
+	EvseId           *int                   `json:"evseId,omitempty"`
+	ChargingLimit    ChargingLimitType      `json:"chargingLimit"`
+}
+
+type NotifyCustomerInformationReq struct {
+	CustomData  *CustomDataType `json:"customData,omitempty"`
+	Data        string          `json:"data" validate:"required,max=512"`
+	Tbc         *bool           `json:"tbc,omitempty"`
+	SeqNo       *int            `json:"seqNo" validate:"required"`
+	GeneratedAt string          `json:"generatedAt" validate:"required,ISO8601date"`
+	RequestId   *int            `json:"requestId" validate:"required"`
+}
+
+type NotifyDERAlarmReq struct {
+	CustomData     *CustomDataType        `json:"customData,omitempty"`
+	ControlType    DERControlEnumType     `json:"controlType" validate:"required,DERControlEnumType"`
+	GridEventFault GridEventFaultEnumType `json:"gridEventFault,omitempty" validate:"omitempty,GridEventFaultEnumType"`
+	AlarmEnded     *bool                  `json:"alarmEnded,omitempty"`
+	Timestamp      string                 `json:"timestamp" validate:"required,ISO8601date"`
+	ExtraInfo      string                 `json:"extraInfo,omitempty" validate:"omitempty,max=200"`
+}
+
+type NotifyDERStartStopReq struct {
+	CustomData    *CustomDataType `json:"customData,omitempty"`
+	ControlId     string          `json:"controlId" validate:"required,max=36"`
+	Started       *bool           `json:"started" validate:"required"`
+	Timestamp     string          `json:"timestamp" validate:"required,ISO8601date"`
+	SupersededIds []string        `json:"supersededIds,omitempty" validate:"omitempty,min=1,max=24,dive,max=36"`
+}
+
+type NotifyDisplayMessagesReq struct {
+	CustomData  *CustomDataType   `json:"customData,omitempty"`
+	MessageInfo []MessageInfoType `json:"messageInfo,omitempty" validate:"omitempty,min=1,dive"`
+	RequestId   *int              `json:"requestId" validate:"required"`
+	Tbc         *bool             `json:"tbc,omitempty"`
+}
+
+type NotifyEVChargingNeedsReq struct {
+	CustomData        *CustomDataType   `json:"customData,omitempty"`
+	MaxScheduleTuples *int              `json:"maxScheduleTuples,omitempty"`
+	ChargingNeeds     ChargingNeedsType `json:"chargingNeeds"`
+	EvseId            *int              `json:"evseId" validate:"required"`
+}
+
+type NotifyEVChargingScheduleReq struct {
+	CustomData       *CustomDataType      `json:"customData,omitempty"`
+	TimeBase         string               `json:"timeBase" validate:"required,ISO8601date"`
+	ChargingSchedule ChargingScheduleType `json:"chargingSchedule"`
+	EvseId           *int                 `json:"evseId" validate:"required"`
+}
+
+type NotifyEventReq struct {
+	CustomData  *CustomDataType `json:"customData,omitempty"`
+	GeneratedAt string          `json:"generatedAt" validate:"required,ISO8601date"`
+	Tbc         *bool           `json:"tbc,omitempty"`
+	SeqNo       *int            `json:"seqNo" validate:"required"`
+	EventData   []EventDataType `json:"eventData" validate:"required,min=1,dive"`
+}
+
+type NotifyMonitoringReportReq struct {
+	CustomData  *CustomDataType      `json:"customData,omitempty"`
+	Monitor     []MonitoringDataType `json:"monitor,omitempty" validate:"omitempty,min=1,dive"`
+	RequestId   *int                 `json:"requestId" validate:"required"`
+	Tbc         *bool                `json:"tbc,omitempty"`
+	SeqNo       *int                 `json:"seqNo" validate:"required"`
+	GeneratedAt string               `json:"generatedAt" validate:"required,ISO8601date"`
+}
+
+type NotifyPeriodicEventStreamReq struct {
+	CustomData *CustomDataType         `json:"customData,omitempty"`
+	Id         *int                    `json:"id" validate:"required"`
+	Pending    *int                    `json:"pending" validate:"required"`
+	Basetime   string                  `json:"basetime" validate:"required,ISO8601date"`
+	Data       []StreamDataElementType `json:"data" validate:"required,min=1,dive"`
+}
+
+type NotifyPriorityChargingReq struct {
+	CustomData    *CustomDataType `json:"customData,omitempty"`
+	TransactionId string          `json:"transactionId" validate:"required,max=36"`
+	Activated     *bool           `json:"activated" validate:"required"`
+}
+
+type NotifyReportReq struct {
+	CustomData  *CustomDataType  `json:"customData,omitempty"`
+	RequestId   *int             `json:"requestId" validate:"required"`
+	GeneratedAt string           `json:"generatedAt" validate:"required,ISO8601date"`
+	ReportData  []ReportDataType `json:"reportData,omitempty" validate:"omitempty,min=1,dive"`
+	Tbc         *bool            `json:"tbc,omitempty"`
+	SeqNo       *int             `json:"seqNo" validate:"required"`
+}
+
+type NotifySettlementReq struct {
+	CustomData       *CustomDataType       `json:"customData,omitempty"`
+	TransactionId    string                `json:"transactionId,omitempty" validate:"omitempty,max=36"`
+	PspRef           string                `json:"pspRef" validate:"required,max=255"`
+	Status           PaymentStatusEnumType `json:"status" validate:"required,PaymentStatusEnumType"`
+	StatusInfo       string                `json:"statusInfo,omitempty" validate:"omitempty,max=500"`
+	SettlementAmount *float64              `json:"settlementAmount" validate:"required"`
+	SettlementTime   string                `json:"settlementTime" validate:"required,ISO8601date"`
+	ReceiptId        string                `json:"receiptId,omitempty" validate:"omitempty,max=50"`
+	ReceiptUrl       string                `json:"receiptUrl,omitempty" validate:"omitempty,max=2000"`
+	VatCompany       *AddressType          `json:"vatCompany,omitempty"`
+	VatNumber        string                `json:"vatNumber,omitempty" validate:"omitempty,max=20"`
+}
+
+type NotifyWebPaymentStartedReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	EvseId     *int            `json:"evseId" validate:"required"`
+	Timeout    *int            `json:"timeout" validate:"required"`
+}
+
+type OpenPeriodicEventStreamReq struct {
+	CustomData         *CustomDataType        `json:"customData,omitempty"`
+	ConstantStreamData ConstantStreamDataType `json:"constantStreamData"`
+}
+
+type PublishFirmwareReq struct {
+	CustomData    *CustomDataType `json:"customData,omitempty"`
+	Location      string          `json:"location" validate:"required,max=512"`
+	Retries       *int            `json:"retries,omitempty"`
+	Checksum      string          `json:"checksum" validate:"required,max=32"`
+	RequestId     *int            `json:"requestId" validate:"required"`
+	RetryInterval *int            `json:"retryInterval,omitempty"`
+}
+
+type PublishFirmwareStatusNotificationReq struct {
+	CustomData *CustomDataType               `json:"customData,omitempty"`
+	Status     PublishFirmwareStatusEnumType `json:"status" validate:"required,PublishFirmwareStatusEnumType"`
+	Location   []string                      `json:"location,omitempty" validate:"omitempty,min=1,dive,max=512"`
+	RequestId  *int                          `json:"requestId,omitempty"`
+}
+
+type PullDynamicScheduleUpdateReq struct {
+	CustomData        *CustomDataType `json:"customData,omitempty"`
+	ChargingProfileId *int            `json:"chargingProfileId" validate:"required"`
+}
+
+type ReportChargingProfilesReq struct {
+	CustomData          *CustomDataType             `json:"customData,omitempty"`
+	RequestId           *int                        `json:"requestId" validate:"required"`
+	ChargingLimitSource ChargingLimitSourceEnumType `json:"chargingLimitSource" validate:"required,ChargingLimitSourceEnumType"`
+	ChargingProfile     []ChargingProfileType       `json:"chargingProfile" validate:"required,min=1,dive"`
+	Tbc                 *bool                       `json:"tbc,omitempty"`
+	EvseId              *int                        `json:"evseId" validate:"required"`
+}
+
+type ReportDERControlReq struct {
+	CustomData        *CustomDataType            `json:"customData,omitempty"`
+	RequestId         *int                       `json:"requestId" validate:"required"`
+	Curve             []DERCurveGetType          `json:"curve,omitempty" validate:"omitempty,min=1,max=24,dive"`
+	EnterService      []EnterServiceGetType      `json:"enterService,omitempty" validate:"omitempty,min=1,max=24,dive"`
+	FixedPFAbsorb     []FixedPFGetType           `json:"fixedPFAbsorb,omitempty" validate:"omitempty,min=1,max=24,dive"`
+	FixedPFInject     []FixedPFGetType           `json:"fixedPFInject,omitempty" validate:"omitempty,min=1,max=24,dive"`
+	FixedVar          []FixedVarGetType          `json:"fixedVar,omitempty" validate:"omitempty,min=1,max=24,dive"`
+	FreqDroop         []FreqDroopGetType         `json:"freqDroop,omitempty" validate:"omitempty,min=1,max=24,dive"`
+	Gradient          []GradientGetType          `json:"gradient,omitempty" validate:"omitempty,min=1,max=24,dive"`
+	LimitMaxDischarge []LimitMaxDischargeGetType `json:"limitMaxDischarge,omitempty" validate:"omitempty,min=1,max=24,dive"`
+	Tbc               *bool                      `json:"tbc,omitempty"`
+}
+
+type RequestBatterySwapReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	IdToken    IdTokenType     `json:"idToken"`
+	RequestId  *int            `json:"requestId" validate:"required"`
+}
+
+type RequestStartTransactionReq struct {
+	CustomData      *CustomDataType      `json:"customData,omitempty"`
+	EvseId          *int                 `json:"evseId,omitempty"`
+	GroupIdToken    *IdTokenType         `json:"groupIdToken,omitempty"`
+	IdToken         IdTokenType          `json:"idToken"`
+	RemoteStartId   *int                 `json:"remoteStartId" validate:"required"`
+	ChargingProfile *ChargingProfileType `json:"chargingProfile,omitempty"`
+}
+
+type RequestStopTransactionReq struct {
+	CustomData    *CustomDataType `json:"customData,omitempty"`
+	TransactionId string          `json:"transactionId" validate:"required,max=36"`
+}
+
+type ReservationStatusUpdateReq struct {
+	CustomData              *CustomDataType                 `json:"customData,omitempty"`
+	ReservationId           *int                            `json:"reservationId" validate:"required"`
+	ReservationUpdateStatus ReservationUpdateStatusEnumType `json:"reservationUpdateStatus" validate:"required,ReservationUpdateStatusEnumType"`
+}
+
+type ReserveNowReq struct {
+	CustomData     *CustomDataType   `json:"customData,omitempty"`
+	Id             *int              `json:"id" validate:"required"`
+	ExpiryDateTime string            `json:"expiryDateTime" validate:"required,ISO8601date"`
+	ConnectorType  ConnectorEnumType `json:"connectorType,omitempty" validate:"omitempty,ConnectorEnumType"`
+	IdToken        IdTokenType       `json:"idToken"`
+	EvseId         *int              `json:"evseId,omitempty"`
+	GroupIdToken   *IdTokenType      `json:"groupIdToken,omitempty"`
+}
+
+type ResetReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Type       ResetEnumType   `json:"type" validate:"required,ResetEnumType"`
+	EvseId     *int            `json:"evseId,omitempty"`
+}
+
+type SecurityEventNotificationReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Type       string          `json:"type" validate:"required,max=50"`
+	Timestamp  string          `json:"timestamp" validate:"required,ISO8601date"`
+	TechInfo   string          `json:"techInfo,omitempty" validate:"omitempty,max=255"`
+}
+
+type SendLocalListReq struct {
+	CustomData             *CustomDataType     `json:"customData,omitempty"`
+	LocalAuthorizationList []AuthorizationData `json:"localAuthorizationList,omitempty" validate:"omitempty,min=1,dive"`
+	VersionNumber          *int                `json:"versionNumber" validate:"required"`
+	UpdateType             UpdateEnumType      `json:"updateType" validate:"required,UpdateEnumType"`
+}
+
+type SetChargingProfileReq struct {
+	CustomData      *CustomDataType     `json:"customData,omitempty"`
+	EvseId          *int                `json:"evseId" validate:"required"`
+	ChargingProfile ChargingProfileType `json:"chargingProfile"`
+}
+
+type SetDERControlReq struct {
+	CustomData        *CustomDataType        `json:"customData,omitempty"`
+	IsDefault         *bool                  `json:"isDefault" validate:"required"`
+	ControlId         string                 `json:"controlId" validate:"required,max=36"`
+	ControlType       DERControlEnumType     `json:"controlType" validate:"required,DERControlEnumType"`
+	Curve             *DERCurveType          `json:"curve,omitempty"`
+	EnterService      *EnterServiceType      `json:"enterService,omitempty"`
+	FixedPFAbsorb     *FixedPFType           `json:"fixedPFAbsorb,omitempty"`
+	FixedPFInject     *FixedPFType           `json:"fixedPFInject,omitempty"`
+	FixedVar          *FixedVarType          `json:"fixedVar,omitempty"`
+	FreqDroop         *FreqDroopType         `json:"freqDroop,omitempty"`
+	Gradient          *GradientType          `json:"gradient,omitempty"`
+	LimitMaxDischarge *LimitMaxDischargeType `json:"limitMaxDischarge,omitempty"`
+}
+
+type SetDefaultTariffReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	EvseId     *int            `json:"evseId" validate:"required"`
+	Tariff     TariffType      `json:"tariff"`
+}
+
+type SetDisplayMessageReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Message    MessageInfoType `json:"message"`
+}
+
+type SetMonitoringBaseReq struct {
+	CustomData     *CustomDataType        `json:"customData,omitempty"`
+	MonitoringBase MonitoringBaseEnumType `json:"monitoringBase" validate:"required,MonitoringBaseEnumType"`
+}
+
+type SetMonitoringLevelReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Severity   *int            `json:"severity" validate:"required"`
+}
+
+type SetNetworkProfileReq struct {
+	CustomData        *CustomDataType              `json:"customData,omitempty"`
+	ConfigurationSlot *int                         `json:"configurationSlot" validate:"required"`
+	ConnectionData    NetworkConnectionProfileType `json:"connectionData"`
+}
+
+type SetVariableMonitoringReq struct {
+	CustomData        *CustomDataType         `json:"customData,omitempty"`
+	SetMonitoringData []SetMonitoringDataType `json:"setMonitoringData" validate:"required,min=1,dive"`
+}
+
+type SetVariablesReq struct {
+	CustomData      *CustomDataType       `json:"customData,omitempty"`
+	SetVariableData []SetVariableDataType `json:"setVariableData" validate:"required,min=1,dive"`
+}
+
+type SignCertificateReq struct {
+	CustomData      *CustomDataType               `json:"customData,omitempty"`
+	Csr             string                        `json:"csr" validate:"required,max=5500"`
+	CertificateType CertificateSigningUseEnumType `json:"certificateType,omitempty" validate:"omitempty,CertificateSigningUseEnumType"`
+}
+
+type StatusNotificationReq struct {
+	CustomData      *CustomDataType         `json:"customData,omitempty"`
+	Timestamp       string                  `json:"timestamp" validate:"required,ISO8601date"`
+	ConnectorStatus ConnectorStatusEnumType `json:"connectorStatus" validate:"required,ConnectorStatusEnumType"`
+	EvseId          *int                    `json:"evseId" validate:"required"`
+	ConnectorId     *int                    `json:"connectorId" validate:"required"`
+}
+
+type TransactionEventReq struct {
+	CustomData         *CustomDataType          `json:"customData,omitempty"`
+	EventType          TransactionEventEnumType `json:"eventType" validate:"required,TransactionEventEnumType"`
+	MeterValue         []MeterValueType         `json:"meterValue,omitempty" validate:"omitempty,min=1,dive"`
+	Timestamp          string                   `json:"timestamp" validate:"required,ISO8601date"`
+	TriggerReason      TriggerReasonEnumType    `json:"triggerReason" validate:"required,TriggerReasonEnumType"`
+	SeqNo              *int                     `json:"seqNo" validate:"required"`
+	Offline            *bool                    `json:"offline,omitempty"`
+	NumberOfPhasesUsed *int                     `json:"numberOfPhasesUsed,omitempty"`
+	CableMaxCurrent    *int                     `json:"cableMaxCurrent,omitempty"`
+	ReservationId      *int                     `json:"reservationId,omitempty"`
+	TransactionInfo    TransactionType          `json:"transactionInfo"`
+	Evse               *EVSEType                `json:"evse,omitempty"`
+	IdToken            *IdTokenType             `json:"idToken,omitempty"`
+}
+
+type TriggerMessageReq struct {
+	CustomData       *CustomDataType        `json:"customData,omitempty"`
+	Evse             *EVSEType              `json:"evse,omitempty"`
+	RequestedMessage MessageTriggerEnumType `json:"requestedMessage" validate:"required,MessageTriggerEnumType"`
+}
+
+type UnlockConnectorReq struct {
+	CustomData  *CustomDataType `json:"customData,omitempty"`
+	EvseId      *int            `json:"evseId" validate:"required"`
+	ConnectorId *int            `json:"connectorId" validate:"required"`
+}
+
+type UnpublishFirmwareReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Checksum   string          `json:"checksum" validate:"required,max=32"`
+}
+
+type UpdateDynamicScheduleReq struct {
+	CustomData        *CustomDataType            `json:"customData,omitempty"`
+	ChargingProfileId *int                       `json:"chargingProfileId" validate:"required"`
+	ScheduleUpdate    ChargingScheduleUpdateType `json:"scheduleUpdate"`
+}
+
+type UpdateFirmwareReq struct {
+	CustomData    *CustomDataType `json:"customData,omitempty"`
+	Retries       *int            `json:"retries,omitempty"`
+	RetryInterval *int            `json:"retryInterval,omitempty"`
+	RequestId     *int            `json:"requestId" validate:"required"`
+	Firmware      FirmwareType    `json:"firmware"`
+}
+
+type UsePriorityChargingReq struct {
+	CustomData    *CustomDataType `json:"customData,omitempty"`
+	TransactionId string          `json:"transactionId" validate:"required,max=36"`
+	Activate      *bool           `json:"activate" validate:"required"`
+}
+
+type VatNumberValidationReq struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	VatNumber  string          `json:"vatNumber" validate:"required,max=20"`
+	EvseId     *int            `json:"evseId,omitempty"`
+}
@@ -0,0 +1,504 @@
+// Code generated by ocppgen from the OCPP JSON schemas. DO NOT EDIT.
+
+package v21
+
+type AFRRSignalRes struct {
+	CustomData *CustomDataType       `json:"customData,omitempty"`
+	Status     GenericStatusEnumType `json:"status" validate:"required,GenericStatusEnumType"`
+	StatusInfo *StatusInfoType       `json:"statusInfo,omitempty"`
+}
+
+type AdjustPeriodicEventStreamRes struct {
+	CustomData *CustomDataType       `json:"customData,omitempty"`
+	Status     GenericStatusEnumType `json:"status" validate:"required,GenericStatusEnumType"`
+	StatusInfo *StatusInfoType       `json:"statusInfo,omitempty"`
+}
+
+type AuthorizeRes struct {
+	CustomData        *CustomDataType                    `json:"customData,omitempty"`
+	IdTokenInfo       IdTokenInfoType                    `json:"idTokenInfo"`
+	CertificateStatus AuthorizeCertificateStatusEnumType `json:"certificateStatus,omitempty" validate:"omitempty,AuthorizeCertificateStatusEnumType"`
+}
+
+type BatterySwapRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type BootNotificationRes struct {
+	CustomData  *CustomDataType            `json:"customData,omitempty"`
+	CurrentTime string                     `json:"currentTime" validate:"required,ISO8601date"`
+	Interval    *int                       `json:"interval" validate:"required"`
+	Status      RegistrationStatusEnumType `json:"status" validate:"required,RegistrationStatusEnumType"`
+	StatusInfo  *StatusInfoType            `json:"statusInfo,omitempty"`
+}
+
+type CancelReservationRes struct {
+	CustomData *CustomDataType                 `json:"customData,omitempty"`
+	Status     CancelReservationStatusEnumType `json:"status" validate:"required,CancelReservationStatusEnumType"`
+	StatusInfo *StatusInfoType                 `json:"statusInfo,omitempty"`
+}
+
+type CertificateSignedRes struct {
+	CustomData *CustomDataType                 `json:"customData,omitempty"`
+	Status     CertificateSignedStatusEnumType `json:"status" validate:"required,CertificateSignedStatusEnumType"`
+	StatusInfo *StatusInfoType                 `json:"statusInfo,omitempty"`
+}
+
+type ChangeAvailabilityRes struct {
+	CustomData *CustomDataType                  `json:"customData,omitempty"`
+	Status     ChangeAvailabilityStatusEnumType `json:"status" validate:"required,ChangeAvailabilityStatusEnumType"`
+	StatusInfo *StatusInfoType                  `json:"statusInfo,omitempty"`
+}
+
+type ChangeTransactionTariffRes struct {
+	CustomData *CustomDataType            `json:"customData,omitempty"`
+	Status     TariffChangeStatusEnumType `json:"status" validate:"required,TariffChangeStatusEnumType"`
+	StatusInfo *StatusInfoType            `json:"statusInfo,omitempty"`
+}
+
+type ClearCacheRes struct {
+	CustomData *CustomDataType          `json:"customData,omitempty"`
+	Status     ClearCacheStatusEnumType `json:"status" validate:"required,ClearCacheStatusEnumType"`
+	StatusInfo *StatusInfoType          `json:"statusInfo,omitempty"`
+}
+
+type ClearChargingProfileRes struct {
+	CustomData *CustomDataType                    `json:"customData,omitempty"`
+	Status     ClearChargingProfileStatusEnumType `json:"status" validate:"required,ClearChargingProfileStatusEnumType"`
+	StatusInfo *StatusInfoType                    `json:"statusInfo,omitempty"`
+}
+
+type ClearDERControlRes struct {
+	CustomData *CustomDataType          `json:"customData,omitempty"`
+	Status     DERControlStatusEnumType `json:"status" validate:"required,DERControlStatusEnumType"`
+	StatusInfo *StatusInfoType          `json:"statusInfo,omitempty"`
+}
+
+type ClearDisplayMessageRes struct {
+	CustomData *CustomDataType            `json:"customData,omitempty"`
+	Status     ClearMessageStatusEnumType `json:"status" validate:"required,ClearMessageStatusEnumType"`
+	StatusInfo *StatusInfoType            `json:"statusInfo,omitempty"`
+}
+
+type ClearTariffsRes struct {
+	CustomData         *CustomDataType          `json:"customData,omitempty"`
+	ClearTariffsResult []ClearTariffsResultType `json:"clearTariffsResult" validate:"required,min=1,dive"`
+}
+
+type ClearVariableMonitoringRes struct {
+	CustomData            *CustomDataType             `json:"customData,omitempty"`
+	ClearMonitoringResult []ClearMonitoringResultType `json:"clearMonitoringResult" validate:"required,min=1,dive"`
+}
+
+type ClearedChargingLimitRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type ClosePeriodicEventStreamRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type CostUpdatedRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type CustomerInformationRes struct {
+	CustomData *CustomDataType                   `json:"customData,omitempty"`
+	Status     CustomerInformationStatusEnumType `json:"status" validate:"required,CustomerInformationStatusEnumType"`
+	StatusInfo *StatusInfoType                   `json:"statusInfo,omitempty"`
+}
+
+type DataTransferRes struct {
+	CustomData *CustomDataType            `json:"customData,omitempty"`
+	Status     DataTransferStatusEnumType `json:"status" validate:"required,DataTransferStatusEnumType"`
+	StatusInfo *StatusInfoType            `json:"statusInfo,omitempty"`
+	Data       interface{}                `json:"data,omitempty"`
+}
+
+type DeleteCertificateRes struct {
+	CustomData *CustomDataType                 `json:"customData,omitempty"`
+	Status     DeleteCertificateStatusEnumType `json:"status" validate:"required,DeleteCertificateStatusEnumType"`
+	StatusInfo *StatusInfoType                 `json:"statusInfo,omitempty"`
+}
+
+type FirmwareStatusNotificationRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type Get15118EVCertificateRes struct {
+	CustomData  *CustomDataType                     `json:"customData,omitempty"`
+	Status      Iso15118EVCertificateStatusEnumType `json:"status" validate:"required,Iso15118EVCertificateStatusEnumType"`
+	StatusInfo  *StatusInfoType                     `json:"statusInfo,omitempty"`
+	ExiResponse string                              `json:"exiResponse" validate:"required,max=5600"`
+}
+
+type GetBaseReportRes struct {
+	CustomData *CustomDataType                  `json:"customData,omitempty"`
+	Status     GenericDeviceModelStatusEnumType `json:"status" validate:"required,GenericDeviceModelStatusEnumType"`
+	StatusInfo *StatusInfoType                  `json:"statusInfo,omitempty"`
+}
+
+type GetCertificateChainStatusRes struct {
+	CustomData        *CustomDataType         `json:"customData,omitempty"`
+	CertificateStatus []CertificateStatusType `json:"certificateStatus" validate:"required,min=1,max=4,dive"`
+}
+
+type GetCertificateStatusRes struct {
+	CustomData *CustomDataType              `json:"customData,omitempty"`
+	Status     GetCertificateStatusEnumType `json:"status" validate:"required,GetCertificateStatusEnumType"`
+	StatusInfo *StatusInfoType              `json:"statusInfo,omitempty"`
+	OcspResult string                       `json:"ocspResult,omitempty" validate:"omitempty,max=5500"`
+}
+
+type GetChargingProfilesRes struct {
+	CustomData *CustomDataType                  `json:"customData,omitempty"`
+	Status     GetChargingProfileStatusEnumType `json:"status" validate:"required,GetChargingProfileStatusEnumType"`
+	StatusInfo *StatusInfoType                  `json:"statusInfo,omitempty"`
+}
+
+type GetCompositeScheduleRes struct {
+	CustomData *CustomDataType        `json:"customData,omitempty"`
+	Status     GenericStatusEnumType  `json:"status" validate:"required,GenericStatusEnumType"`
+	StatusInfo *StatusInfoType        `json:"statusInfo,omitempty"`
+	Schedule   *CompositeScheduleType `json:"schedule,omitempty"`
+}
+
+type GetDERControlRes struct {
+	CustomData *CustomDataType          `json:"customData,omitempty"`
+	Status     DERControlStatusEnumType `json:"status" validate:"required,DERControlStatusEnumType"`
+	StatusInfo *StatusInfoType          `json:"statusInfo,omitempty"`
+}
+
+type GetDisplayMessagesRes struct {
+	CustomData *CustomDataType                  `json:"customData,omitempty"`
+	Status     GetDisplayMessagesStatusEnumType `json:"status" validate:"required,GetDisplayMessagesStatusEnumType"`
+	StatusInfo *StatusInfoType                  `json:"statusInfo,omitempty"`
+}
+
+type GetInstalledCertificateIdsRes struct {
+	CustomData               *CustomDataType                       `json:"customData,omitempty"`
+	Status                   GetInstalledCertificateStatusEnumType `json:"status" validate:"required,GetInstalledCertificateStatusEnumType"`
+	StatusInfo               *StatusInfoType                       `json:"statusInfo,omitempty"`
+	CertificateHashDataChain []CertificateHashDataChainType        `json:"certificateHashDataChain,omitempty" validate:"omitempty,min=1,dive"`
+}
+
+type GetLocalListVersionRes struct {
+	CustomData    *CustomDataType `json:"customData,omitempty"`
+	VersionNumber *int            `json:"versionNumber" validate:"required"`
+}
+
+type GetLogRes struct {
+	CustomData *CustomDataType   `json:"customData,omitempty"`
+	Status     LogStatusEnumType `json:"status" validate:"required,LogStatusEnumType"`
+	StatusInfo *StatusInfoType   `json:"statusInfo,omitempty"`
+	Filename   string            `json:"filename,omitempty" validate:"omitempty,max=255"`
+}
+
+type GetMonitoringReportRes struct {
+	CustomData *CustomDataType                  `json:"customData,omitempty"`
+	Status     GenericDeviceModelStatusEnumType `json:"status" validate:"required,GenericDeviceModelStatusEnumType"`
+	StatusInfo *StatusInfoType                  `json:"statusInfo,omitempty"`
+}
+
+type GetPeriodicEventStreamRes struct {
+	CustomData         *CustomDataType          `json:"customData,omitempty"`
+	ConstantStreamData []ConstantStreamDataType `json:"constantStreamData,omitempty" validate:"omitempty,min=1,dive"`
+}
+
+type GetReportRes struct {
+	CustomData *CustomDataType                  `json:"customData,omitempty"`
+	Status     GenericDeviceModelStatusEnumType `json:"status" validate:"required,GenericDeviceModelStatusEnumType"`
+	StatusInfo *StatusInfoType                  `json:"statusInfo,omitempty"`
+}
+
+type GetTariffsRes struct {
+	CustomData        *CustomDataType         `json:"customData,omitempty"`
+	Status            TariffGetStatusEnumType `json:"status" validate:"required,TariffGetStatusEnumType"`
+	StatusInfo        *StatusInfoType         `json:"statusInfo,omitempty"`
+	TariffAssignments []TariffAssignmentType  `json:"tariffAssignments,omitempty" validate:"omitempty,min=1,dive"`
+}
+
+type GetTransactionStatusRes struct {
+	CustomData       *CustomDataType `json:"customData,omitempty"`
+	OngoingIndicator *bool           `json:"ongoingIndicator,omitempty"`
+	MessagesInQueue  *bool           `json:"messagesInQueue" validate:"required"`
+}
+
+type GetVariablesRes struct {
+	CustomData        *CustomDataType         `json:"customData,omitempty"`
+	GetVariableResult []GetVariableResultType `json:"getVariableResult" validate:"required,min=1,dive"`
+}
+
+type HeartbeatRes struct {
+	CustomData  *CustomDataType `json:"customData,omitempty"`
+	CurrentTime string          `json:"currentTime" validate:"required,ISO8601date"`
+}
+
+type InstallCertificateRes struct {
+	CustomData *CustomDataType                  `json:"customData,omitempty"`
+	Status     InstallCertificateStatusEnumType `json:"status" validate:"required,InstallCertificateStatusEnumType"`
+	StatusInfo *StatusInfoType                  `json:"statusInfo,omitempty"`
+}
+
+type LogStatusNotificationRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type MeterValuesRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type NotifyAllowedEnergyTransferRes struct {
+	CustomData *CustomDataType                           `json:"customData,omitempty"`
+	Status     NotifyAllowedEnergyTransferStatusEnumType `json:"status" validate:"required,NotifyAllowedEnergyTransferStatusEnumType"`
+	StatusInfo *StatusInfoType                           `json:"statusInfo,omitempty"`
+}
+
+type NotifyChargingLimitRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type NotifyCustomerInformationRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type NotifyDERAlarmRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type NotifyDERStartStopRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type NotifyDisplayMessagesRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type NotifyEVChargingNeedsRes struct {
+	CustomData *CustomDataType                     `json:"customData,omitempty"`
+	Status     NotifyEVChargingNeedsStatusEnumType `json:"status" validate:"required,NotifyEVChargingNeedsStatusEnumType"`
+	StatusInfo *StatusInfoType                     `json:"statusInfo,omitempty"`
+}
+
+type NotifyEVChargingScheduleRes struct {
+	CustomData *CustomDataType       `json:"customData,omitempty"`
+	Status     GenericStatusEnumType `json:"status" validate:"required,GenericStatusEnumType"`
+	StatusInfo *StatusInfoType       `json:"statusInfo,omitempty"`
+}
+
+type NotifyEventRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type NotifyMonitoringReportRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type NotifyPriorityChargingRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type NotifyReportRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type NotifySettlementRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	ReceiptUrl string          `json:"receiptUrl,omitempty" validate:"omitempty,max=2000"`
+	ReceiptId  string          `json:"receiptId,omitempty" validate:"omitempty,max=50"`
+}
+
+type NotifyWebPaymentStartedRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type OpenPeriodicEventStreamRes struct {
+	CustomData *CustomDataType       `json:"customData,omitempty"`
+	Status     GenericStatusEnumType `json:"status" validate:"required,GenericStatusEnumType"`
+	StatusInfo *StatusInfoType       `json:"statusInfo,omitempty"`
+}
+
+type PublishFirmwareRes struct {
+	CustomData *CustomDataType       `json:"customData,omitempty"`
+	Status     GenericStatusEnumType `json:"status" validate:"required,GenericStatusEnumType"`
+	StatusInfo *StatusInfoType       `json:"statusInfo,omitempty"`
+}
+
+type PublishFirmwareStatusNotificationRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type PullDynamicScheduleUpdateRes struct {
+	CustomData     *CustomDataType               `json:"customData,omitempty"`
+	ScheduleUpdate *ChargingScheduleUpdateType   `json:"scheduleUpdate,omitempty"`
+	Status         ChargingProfileStatusEnumType `json:"status" validate:"required,ChargingProfileStatusEnumType"`
+	StatusInfo     *StatusInfoType               `json:"statusInfo,omitempty"`
+}
+
+type ReportChargingProfilesRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type ReportDERControlRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type RequestBatterySwapRes struct {
+	CustomData *CustomDataType       `json:"customData,omitempty"`
+	Status     GenericStatusEnumType `json:"status" validate:"required,GenericStatusEnumType"`
+	StatusInfo *StatusInfoType       `json:"statusInfo,omitempty"`
+}
+
+type RequestStartTransactionRes struct {
+	CustomData    *CustomDataType                `json:"customData,omitempty"`
+	Status        RequestStartStopStatusEnumType `json:"status" validate:"required,RequestStartStopStatusEnumType"`
+	StatusInfo    *StatusInfoType                `json:"statusInfo,omitempty"`
+	TransactionId string                         `json:"transactionId,omitempty" validate:"omitempty,max=36"`
+}
+
+type RequestStopTransactionRes struct {
+	CustomData *CustomDataType                `json:"customData,omitempty"`
+	Status     RequestStartStopStatusEnumType `json:"status" validate:"required,RequestStartStopStatusEnumType"`
+	StatusInfo *StatusInfoType                `json:"statusInfo,omitempty"`
+}
+
+type ReservationStatusUpdateRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type ReserveNowRes struct {
+	CustomData *CustomDataType          `json:"customData,omitempty"`
+	Status     ReserveNowStatusEnumType `json:"status" validate:"required,ReserveNowStatusEnumType"`
+	StatusInfo *StatusInfoType          `json:"statusInfo,omitempty"`
+}
+
+type ResetRes struct {
+	CustomData *CustomDataType     `json:"customData,omitempty"`
+	Status     ResetStatusEnumType `json:"status" validate:"required,ResetStatusEnumType"`
+	StatusInfo *StatusInfoType     `json:"statusInfo,omitempty"`
+}
+
+type SecurityEventNotificationRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type SendLocalListRes struct {
+	CustomData *CustomDataType             `json:"customData,omitempty"`
+	Status     SendLocalListStatusEnumType `json:"status" validate:"required,SendLocalListStatusEnumType"`
+	StatusInfo *StatusInfoType             `json:"statusInfo,omitempty"`
+}
+
+type SetChargingProfileRes struct {
+	CustomData *CustomDataType               `json:"customData,omitempty"`
+	Status     ChargingProfileStatusEnumType `json:"status" validate:"required,ChargingProfileStatusEnumType"`
+	StatusInfo *StatusInfoType               `json:"statusInfo,omitempty"`
+}
+
+type SetDERControlRes struct {
+	CustomData    *CustomDataType          `json:"customData,omitempty"`
+	Status        DERControlStatusEnumType `json:"status" validate:"required,DERControlStatusEnumType"`
+	SupersededIds []string                 `json:"supersededIds,omitempty" validate:"omitempty,min=1,max=24,dive,max=36"`
+	StatusInfo    *StatusInfoType          `json:"statusInfo,omitempty"`
+}
+
+type SetDefaultTariffRes struct {
+	CustomData *CustomDataType         `json:"customData,omitempty"`
+	Status     TariffSetStatusEnumType `json:"status" validate:"required,TariffSetStatusEnumType"`
+	StatusInfo *StatusInfoType         `json:"statusInfo,omitempty"`
+}
+
+type SetDisplayMessageRes struct {
+	CustomData *CustomDataType              `json:"customData,omitempty"`
+	Status     DisplayMessageStatusEnumType `json:"status" validate:"required,DisplayMessageStatusEnumType"`
+	StatusInfo *StatusInfoType              `json:"statusInfo,omitempty"`
+}
+
+type SetMonitoringBaseRes struct {
+	CustomData *CustomDataType                  `json:"customData,omitempty"`
+	Status     GenericDeviceModelStatusEnumType `json:"status" validate:"required,GenericDeviceModelStatusEnumType"`
+	StatusInfo *StatusInfoType                  `json:"statusInfo,omitempty"`
+}
+
+type SetMonitoringLevelRes struct {
+	CustomData *CustomDataType       `json:"customData,omitempty"`
+	Status     GenericStatusEnumType `json:"status" validate:"required,GenericStatusEnumType"`
+	StatusInfo *StatusInfoType       `json:"statusInfo,omitempty"`
+}
+
+type SetNetworkProfileRes struct {
+	CustomData *CustomDataType                 `json:"customData,omitempty"`
+	Status     SetNetworkProfileStatusEnumType `json:"status" validate:"required,SetNetworkProfileStatusEnumType"`
+	StatusInfo *StatusInfoType                 `json:"statusInfo,omitempty"`
+}
+
+type SetVariableMonitoringRes struct {
+	CustomData          *CustomDataType           `json:"customData,omitempty"`
+	SetMonitoringResult []SetMonitoringResultType `json:"setMonitoringResult" validate:"required,min=1,dive"`
+}
+
+type SetVariablesRes struct {
+	CustomData        *CustomDataType         `json:"customData,omitempty"`
+	SetVariableResult []SetVariableResultType `json:"setVariableResult" validate:"required,min=1,dive"`
+}
+
+type SignCertificateRes struct {
+	CustomData *CustomDataType       `json:"customData,omitempty"`
+	Status     GenericStatusEnumType `json:"status" validate:"required,GenericStatusEnumType"`
+	StatusInfo *StatusInfoType       `json:"statusInfo,omitempty"`
+}
+
+type StatusNotificationRes struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+}
+
+type TransactionEventRes struct {
+	CustomData             *CustomDataType     `json:"customData,omitempty"`
+	TotalCost              *float64            `json:"totalCost,omitempty"`
+	ChargingPriority       *int                `json:"chargingPriority,omitempty"`
+	IdTokenInfo            *IdTokenInfoType    `json:"idTokenInfo,omitempty"`
+	UpdatedPersonalMessage *MessageContentType `json:"updatedPersonalMessage,omitempty"`
+}
+
+type TriggerMessageRes struct {
+	CustomData *CustomDataType              `json:"customData,omitempty"`
+	Status     TriggerMessageStatusEnumType `json:"status" validate:"required,TriggerMessageStatusEnumType"`
+	StatusInfo *StatusInfoType              `json:"statusInfo,omitempty"`
+}
+
+type UnlockConnectorRes struct {
+	CustomData *CustomDataType      `json:"customData,omitempty"`
+	Status     UnlockStatusEnumType `json:"status" validate:"required,UnlockStatusEnumType"`
+	StatusInfo *StatusInfoType      `json:"statusInfo,omitempty"`
+}
+
+type UnpublishFirmwareRes struct {
+	CustomData *CustomDataType                 `json:"customData,omitempty"`
+	Status     UnpublishFirmwareStatusEnumType `json:"status" validate:"required,UnpublishFirmwareStatusEnumType"`
+}
+
+type UpdateDynamicScheduleRes struct {
+	CustomData *CustomDataType               `json:"customData,omitempty"`
+	Status     ChargingProfileStatusEnumType `json:"status" validate:"required,ChargingProfileStatusEnumType"`
+	StatusInfo *StatusInfoType               `json:"statusInfo,omitempty"`
+}
+
+type UpdateFirmwareRes struct {
+	CustomData *CustomDataType              `json:"customData,omitempty"`
+	Status     UpdateFirmwareStatusEnumType `json:"status" validate:"required,UpdateFirmwareStatusEnumType"`
+	StatusInfo *StatusInfoType              `json:"statusInfo,omitempty"`
+}
+
+type UsePriorityChargingRes struct {
+	CustomData *CustomDataType                `json:"customData,omitempty"`
+	Status     PriorityChargingStatusEnumType `json:"status" validate:"required,PriorityChargingStatusEnumType"`
+	StatusInfo *StatusInfoType                `json:"statusInfo,omitempty"`
+}
+
+type VatNumberValidationRes struct {
+	CustomData *CustomDataType       `json:"customData,omitempty"`
+	Company    *AddressType          `json:"company,omitempty"`
+	StatusInfo *StatusInfoType       `json:"statusInfo,omitempty"`
+	VatNumber  string                `json:"vatNumber" validate:"required,max=20"`
+	EvseId     *int                  `json:"evseId,omitempty"`
+	Status     GenericStatusEnumType `json:"status" validate:"required,GenericStatusEnumType"`
+}
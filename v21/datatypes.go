@@ -0,0 +1,862 @@
+// Code generated by ocppgen from the OCPP JSON schemas. DO NOT EDIT.
+
+package v21
+
+type ACChargingParametersType struct {
+	CustomData   *CustomDataType `json:"customData,omitempty"`
+	EnergyAmount *int            `json:"energyAmount" validate:"required"`
+	EvMinCurrent *int            `json:"evMinCurrent" validate:"required"`
+	EvMaxCurrent *int            `json:"evMaxCurrent" validate:"required"`
+	EvMaxVoltage *int            `json:"evMaxVoltage" validate:"required"`
+}
+
+type APNType struct {
+	CustomData              *CustomDataType           `json:"customData,omitempty"`
+	Apn                     string                    `json:"apn" validate:"required,max=512"`
+	ApnUserName             string                    `json:"apnUserName,omitempty" validate:"omitempty,max=20"`
+	ApnPassword             string                    `json:"apnPassword,omitempty" validate:"omitempty,max=20"`
+	SimPin                  *int                      `json:"simPin,omitempty"`
+	PreferredNetwork        string                    `json:"preferredNetwork,omitempty" validate:"omitempty,max=6"`
+	UseOnlyPreferredNetwork *bool                     `json:"useOnlyPreferredNetwork,omitempty"`
+	ApnAuthentication       APNAuthenticationEnumType `json:"apnAuthentication" validate:"required,APNAuthenticationEnumType"`
+}
+
+type AdditionalInfoType struct {
+	CustomData        *CustomDataType `json:"customData,omitempty"`
+	AdditionalIdToken string          `json:"additionalIdToken" validate:"required,max=36"`
+	Type              string          `json:"type" validate:"required,max=50"`
+}
+
+type AddressType struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Name       string          `json:"name" validate:"required,max=50"`
+	Address1   string          `json:"address1" validate:"required,max=100"`
+	Address2   string          `json:"address2,omitempty" validate:"omitempty,max=100"`
+	City       string          `json:"city" validate:"required,max=100"`
+	PostalCode string          `json:"postalCode,omitempty" validate:"omitempty,max=20"`
+	Country    string          `json:"country" validate:"required,max=50"`
+}
+
+type AuthorizationData struct {
+	CustomData  *CustomDataType  `json:"customData,omitempty"`
+	IdToken     IdTokenType      `json:"idToken"`
+	IdTokenInfo *IdTokenInfoType `json:"idTokenInfo,omitempty"`
+}
+
+type BatteryDataType struct {
+	CustomData     *CustomDataType `json:"customData,omitempty"`
+	EvseId         *int            `json:"evseId" validate:"required"`
+	SerialNumber   string          `json:"serialNumber" validate:"required,max=50"`
+	SoC            *float64        `json:"soC" validate:"required"`
+	SoH            *float64        `json:"soH" validate:"required"`
+	ProductionDate string          `json:"productionDate,omitempty" validate:"omitempty,ISO8601date"`
+	VendorInfo     string          `json:"vendorInfo,omitempty" validate:"omitempty,max=500"`
+}
+
+type CertificateHashDataChainType struct {
+	CustomData               *CustomDataType             `json:"customData,omitempty"`
+	CertificateHashData      CertificateHashDataType     `json:"certificateHashData"`
+	CertificateType          GetCertificateIdUseEnumType `json:"certificateType" validate:"required,GetCertificateIdUseEnumType"`
+	ChildCertificateHashData []CertificateHashDataType   `json:"childCertificateHashData,omitempty" validate:"omitempty,min=1,max=4,dive"`
+}
+
+type CertificateHashDataType struct {
+	CustomData     *CustomDataType       `json:"customData,omitempty"`
+	HashAlgorithm  HashAlgorithmEnumType `json:"hashAlgorithm" validate:"required,HashAlgorithmEnumType"`
+	IssuerNameHash string                `json:"issuerNameHash" validate:"required,max=128"`
+	IssuerKeyHash  string                `json:"issuerKeyHash" validate:"required,max=128"`
+	SerialNumber   string                `json:"serialNumber" validate:"required,max=40"`
+}
+
+type CertificateStatusRequestInfoType struct {
+	CustomData          *CustomDataType                 `json:"customData,omitempty"`
+	CertificateHashData CertificateHashDataType         `json:"certificateHashData"`
+	Source              CertificateStatusSourceEnumType `json:"source" validate:"required,CertificateStatusSourceEnumType"`
+	Urls                []string                        `json:"urls" validate:"required,min=1,max=5,dive,max=2000"`
+}
+
+type CertificateStatusType struct {
+	CustomData          *CustomDataType                 `json:"customData,omitempty"`
+	CertificateHashData CertificateHashDataType         `json:"certificateHashData"`
+	Source              CertificateStatusSourceEnumType `json:"source" validate:"required,CertificateStatusSourceEnumType"`
+	Status              CertificateStatusEnumType       `json:"status" validate:"required,CertificateStatusEnumType"`
+	NextUpdate          string                          `json:"nextUpdate" validate:"required,ISO8601date"`
+}
+
+type ChargingLimitType struct {
+	CustomData          *CustomDataType             `json:"customData,omitempty"`
+	ChargingLimitSource ChargingLimitSourceEnumType `json:"chargingLimitSource" validate:"required,ChargingLimitSourceEnumType"`
+	IsGridCritical      *bool                       `json:"isGridCritical,omitempty"`
+}
+
+type ChargingNeedsType struct {
+	CustomData              *CustomDataType              `json:"customData,omitempty"`
+	AcChargingParameters    *ACChargingParametersType    `json:"acChargingParameters,omitempty"`
+	DcChargingParameters    *DCChargingParametersType    `json:"dcChargingParameters,omitempty"`
+	V2xChargingParameters   *V2XChargingParametersType   `json:"v2xChargingParameters,omitempty"`
+	AvailableEnergyTransfer []EnergyTransferModeEnumType `json:"availableEnergyTransfer,omitempty" validate:"omitempty,min=1,dive,EnergyTransferModeEnumType"`
+	ControlMode             ControlModeEnumType          `json:"controlMode,omitempty" validate:"omitempty,ControlModeEnumType"`
+	MobilityNeedsMode       MobilityNeedsModeEnumType    `json:"mobilityNeedsMode,omitempty" validate:"omitempty,MobilityNeedsModeEnumType"`
+	RequestedEnergyTransfer EnergyTransferModeEnumType   `json:"requestedEnergyTransfer" validate:"required,EnergyTransferModeEnumType"`
+	DepartureTime           string                       `json:"departureTime,omitempty" validate:"omitempty,ISO8601date"`
+}
+
+type ChargingProfileCriterionType struct {
+	CustomData             *CustomDataType                `json:"customData,omitempty"`
+	ChargingProfilePurpose ChargingProfilePurposeEnumType `json:"chargingProfilePurpose,omitempty" validate:"omitempty,ChargingProfilePurposeEnumType"`
+	StackLevel             *int                           `json:"stackLevel,omitempty"`
+	ChargingProfileId      []int                          `json:"chargingProfileId,omitempty" validate:"omitempty,min=1"`
+	ChargingLimitSource    []ChargingLimitSourceEnumType  `json:"chargingLimitSource,omitempty" validate:"omitempty,min=1,max=4,dive,ChargingLimitSourceEnumType"`
+}
+
+type ChargingProfileType struct {
+	CustomData                  *CustomDataType                `json:"customData,omitempty"`
+	Id                          *int                           `json:"id" validate:"required"`
+	StackLevel                  *int                           `json:"stackLevel" validate:"required"`
+	ChargingProfilePurpose      ChargingProfilePurposeEnumType `json:"chargingProfilePurpose" validate:"required,ChargingProfilePurposeEnumType"`
+	ChargingProfileKind         ChargingProfileKindEnumType    `json:"chargingProfileKind" validate:"required,ChargingProfileKindEnumType"`
+	RecurrencyKind              RecurrencyKindEnumType         `json:"recurrencyKind,omitempty" validate:"omitempty,RecurrencyKindEnumType"`
+	ValidFrom                   string                         `json:"validFrom,omitempty" validate:"omitempty,ISO8601date"`
+	ValidTo                     string                         `json:"validTo,omitempty" validate:"omitempty,ISO8601date"`
+	TransactionId               string                         `json:"transactionId,omitempty" validate:"omitempty,max=36"`
+	MaxOfflineDuration          *int                           `json:"maxOfflineDuration,omitempty"`
+	InvalidAfterOfflineDuration *bool                          `json:"invalidAfterOfflineDuration,omitempty"`
+	DynUpdateInterval           *int                           `json:"dynUpdateInterval,omitempty"`
+	DynUpdateTime               string                         `json:"dynUpdateTime,omitempty" validate:"omitempty,ISO8601date"`
+	PriceScheduleSignature      string                         `json:"priceScheduleSignature,omitempty" validate:"omitempty,max=256"`
+	ChargingSchedule            []ChargingScheduleType         `json:"chargingSchedule" validate:"required,min=1,max=3,dive"`
+}
+
+type ChargingSchedulePeriodType struct {
+	CustomData             *CustomDataType          `json:"customData,omitempty"`
+	StartPeriod            *int                     `json:"startPeriod" validate:"required"`
+	Limit                  *float64                 `json:"limit,omitempty"`
+	Limit_L2               *float64                 `json:"limit_L2,omitempty"`
+	Limit_L3               *float64                 `json:"limit_L3,omitempty"`
+	NumberPhases           *int                     `json:"numberPhases,omitempty"`
+	PhaseToUse             *int                     `json:"phaseToUse,omitempty"`
+	DischargeLimit         *float64                 `json:"dischargeLimit,omitempty"`
+	DischargeLimit_L2      *float64                 `json:"dischargeLimit_L2,omitempty"`
+	DischargeLimit_L3      *float64                 `json:"dischargeLimit_L3,omitempty"`
+	Setpoint               *float64                 `json:"setpoint,omitempty"`
+	Setpoint_L2            *float64                 `json:"setpoint_L2,omitempty"`
+	Setpoint_L3            *float64                 `json:"setpoint_L3,omitempty"`
+	SetpointReactive       *float64                 `json:"setpointReactive,omitempty"`
+	SetpointReactive_L2    *float64                 `json:"setpointReactive_L2,omitempty"`
+	SetpointReactive_L3    *float64                 `json:"setpointReactive_L3,omitempty"`
+	PreconditioningRequest *bool                    `json:"preconditioningRequest,omitempty"`
+	EvseSleep              *bool                    `json:"evseSleep,omitempty"`
+	V2xBaseline            *float64                 `json:"v2xBaseline,omitempty"`
+	OperationMode          OperationModeEnumType    `json:"operationMode,omitempty" validate:"omitempty,OperationModeEnumType"`
+	V2xFreqWattCurve       []V2XFreqWattPointType   `json:"v2xFreqWattCurve,omitempty" validate:"omitempty,min=1,max=20,dive"`
+	V2xSignalWattCurve     []V2XSignalWattPointType `json:"v2xSignalWattCurve,omitempty" validate:"omitempty,min=1,max=20,dive"`
+}
+
+type ChargingScheduleType struct {
+	CustomData             *CustomDataType              `json:"customData,omitempty"`
+	Id                     *int                         `json:"id" validate:"required"`
+	StartSchedule          string                       `json:"startSchedule,omitempty" validate:"omitempty,ISO8601date"`
+	Duration               *int                         `json:"duration,omitempty"`
+	ChargingRateUnit       ChargingRateUnitEnumType     `json:"chargingRateUnit" validate:"required,ChargingRateUnitEnumType"`
+	ChargingSchedulePeriod []ChargingSchedulePeriodType `json:"chargingSchedulePeriod" validate:"required,min=1,max=1024,dive"`
+	MinChargingRate        *float64                     `json:"minChargingRate,omitempty"`
+	SalesTariff            *SalesTariffType             `json:"salesTariff,omitempty"`
+}
+
+type ChargingScheduleUpdateType struct {
+	CustomData          *CustomDataType `json:"customData,omitempty"`
+	Limit               *float64        `json:"limit,omitempty"`
+	Limit_L2            *float64        `json:"limit_L2,omitempty"`
+	Limit_L3            *float64        `json:"limit_L3,omitempty"`
+	DischargeLimit      *float64        `json:"dischargeLimit,omitempty"`
+	DischargeLimit_L2   *float64        `json:"dischargeLimit_L2,omitempty"`
+	DischargeLimit_L3   *float64        `json:"dischargeLimit_L3,omitempty"`
+	Setpoint            *float64        `json:"setpoint,omitempty"`
+	Setpoint_L2         *float64        `json:"setpoint_L2,omitempty"`
+	Setpoint_L3         *float64        `json:"setpoint_L3,omitempty"`
+	SetpointReactive    *float64        `json:"setpointReactive,omitempty"`
+	SetpointReactive_L2 *float64        `json:"setpointReactive_L2,omitempty"`
+	SetpointReactive_L3 *float64        `json:"setpointReactive_L3,omitempty"`
+}
+
+type ChargingStationType struct {
+	CustomData      *CustomDataType `json:"customData,omitempty"`
+	SerialNumber    string          `json:"serialNumber,omitempty" validate:"omitempty,max=25"`
+	Model           string          `json:"model" validate:"required,max=20"`
+	Modem           *ModemType      `json:"modem,omitempty"`
+	VendorName      string          `json:"vendorName" validate:"required,max=50"`
+	FirmwareVersion string          `json:"firmwareVersion,omitempty" validate:"omitempty,max=50"`
+}
+
+type ClearChargingProfileType struct {
+	CustomData             *CustomDataType                `json:"customData,omitempty"`
+	EvseId                 *int                           `json:"evseId,omitempty"`
+	ChargingProfilePurpose ChargingProfilePurposeEnumType `json:"chargingProfilePurpose,omitempty" validate:"omitempty,ChargingProfilePurposeEnumType"`
+	StackLevel             *int                           `json:"stackLevel,omitempty"`
+}
+
+type ClearMonitoringResultType struct {
+	CustomData *CustomDataType               `json:"customData,omitempty"`
+	Status     ClearMonitoringStatusEnumType `json:"status" validate:"required,ClearMonitoringStatusEnumType"`
+	Id         *int                          `json:"id" validate:"required"`
+	StatusInfo *StatusInfoType               `json:"statusInfo,omitempty"`
+}
+
+type ClearTariffsResultType struct {
+	CustomData *CustomDataType           `json:"customData,omitempty"`
+	StatusInfo *StatusInfoType           `json:"statusInfo,omitempty"`
+	TariffId   string                    `json:"tariffId,omitempty" validate:"omitempty,max=60"`
+	Status     TariffClearStatusEnumType `json:"status" validate:"required,TariffClearStatusEnumType"`
+}
+
+type ComponentType struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Evse       *EVSEType       `json:"evse,omitempty"`
+	Name       string          `json:"name" validate:"required,max=50"`
+	Instance   string          `json:"instance,omitempty" validate:"omitempty,max=50"`
+}
+
+type ComponentVariableType struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Component  ComponentType   `json:"component"`
+	Variable   *VariableType   `json:"variable,omitempty"`
+}
+
+type CompositeScheduleType struct {
+	CustomData             *CustomDataType              `json:"customData,omitempty"`
+	ChargingSchedulePeriod []ChargingSchedulePeriodType `json:"chargingSchedulePeriod" validate:"required,min=1,dive"`
+	EvseId                 *int                         `json:"evseId" validate:"required"`
+	Duration               *int                         `json:"duration" validate:"required"`
+	ScheduleStart          string                       `json:"scheduleStart" validate:"required,ISO8601date"`
+	ChargingRateUnit       ChargingRateUnitEnumType     `json:"chargingRateUnit" validate:"required,ChargingRateUnitEnumType"`
+}
+
+type ConstantStreamDataType struct {
+	CustomData           *CustomDataType               `json:"customData,omitempty"`
+	Id                   *int                          `json:"id" validate:"required"`
+	Params               PeriodicEventStreamParamsType `json:"params"`
+	VariableMonitoringId *int                          `json:"variableMonitoringId" validate:"required"`
+}
+
+type ConsumptionCostType struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	StartValue *float64        `json:"startValue" validate:"required"`
+	Cost       []CostType      `json:"cost" validate:"required,min=1,max=3,dive"`
+}
+
+type CostType struct {
+	CustomData       *CustomDataType  `json:"customData,omitempty"`
+	CostKind         CostKindEnumType `json:"costKind" validate:"required,CostKindEnumType"`
+	Amount           *int             `json:"amount" validate:"required"`
+	AmountMultiplier *int             `json:"amountMultiplier,omitempty"`
+}
+
+// This class does not get 'AdditionalProperties = false' in the schema
+// generation, so it can be extended with arbitrary JSON properties to allow
+// adding custom data.
+type CustomDataType struct {
+	VendorId string `json:"vendorId" validate:"required,max=255"`
+}
+
+type DCChargingParametersType struct {
+	CustomData       *CustomDataType `json:"customData,omitempty"`
+	EvMaxCurrent     *int            `json:"evMaxCurrent" validate:"required"`
+	EvMaxVoltage     *int            `json:"evMaxVoltage" validate:"required"`
+	EnergyAmount     *int            `json:"energyAmount,omitempty"`
+	EvMaxPower       *int            `json:"evMaxPower,omitempty"`
+	StateOfCharge    *int            `json:"stateOfCharge,omitempty"`
+	EvEnergyCapacity *int            `json:"evEnergyCapacity,omitempty"`
+	FullSoC          *int            `json:"fullSoC,omitempty"`
+	BulkSoC          *int            `json:"bulkSoC,omitempty"`
+}
+
+type DERCurveGetType struct {
+	CustomData   *CustomDataType    `json:"customData,omitempty"`
+	Curve        DERCurveType       `json:"curve"`
+	Id           string             `json:"id" validate:"required,max=36"`
+	CurveType    DERControlEnumType `json:"curveType" validate:"required,DERControlEnumType"`
+	IsDefault    *bool              `json:"isDefault" validate:"required"`
+	IsSuperseded *bool              `json:"isSuperseded" validate:"required"`
+}
+
+type DERCurvePointsType struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	X          *float64        `json:"x" validate:"required"`
+	Y          *float64        `json:"y" validate:"required"`
+}
+
+type DERCurveType struct {
+	CustomData          *CustomDataType          `json:"customData,omitempty"`
+	CurveData           []DERCurvePointsType     `json:"curveData" validate:"required,min=1,max=10,dive"`
+	Hysteresis          *HysteresisType          `json:"hysteresis,omitempty"`
+	Priority            *int                     `json:"priority" validate:"required"`
+	ReactivePowerParams *ReactivePowerParamsType `json:"reactivePowerParams,omitempty"`
+	VoltageParams       *VoltageParamsType       `json:"voltageParams,omitempty"`
+	YUnit               DERUnitEnumType          `json:"yUnit" validate:"required,DERUnitEnumType"`
+	ResponseTime        *float64                 `json:"responseTime,omitempty"`
+	StartTime           string                   `json:"startTime,omitempty" validate:"omitempty,ISO8601date"`
+	Duration            *float64                 `json:"duration,omitempty"`
+}
+
+type EVSEType struct {
+	CustomData  *CustomDataType `json:"customData,omitempty"`
+	Id          *int            `json:"id" validate:"required"`
+	ConnectorId *int            `json:"connectorId,omitempty"`
+}
+
+type EnterServiceGetType struct {
+	CustomData   *CustomDataType  `json:"customData,omitempty"`
+	EnterService EnterServiceType `json:"enterService"`
+	Id           string           `json:"id" validate:"required,max=36"`
+}
+
+type EnterServiceType struct {
+	CustomData  *CustomDataType `json:"customData,omitempty"`
+	Priority    *int            `json:"priority" validate:"required"`
+	HighVoltage *float64        `json:"highVoltage" validate:"required"`
+	LowVoltage  *float64        `json:"lowVoltage" validate:"required"`
+	HighFreq    *float64        `json:"highFreq" validate:"required"`
+	LowFreq     *float64        `json:"lowFreq" validate:"required"`
+	Delay       *float64        `json:"delay,omitempty"`
+	RandomDelay *float64        `json:"randomDelay,omitempty"`
+	RampRate    *float64        `json:"rampRate,omitempty"`
+}
+
+type EventDataType struct {
+	CustomData            *CustomDataType           `json:"customData,omitempty"`
+	EventId               *int                      `json:"eventId" validate:"required"`
+	Timestamp             string                    `json:"timestamp" validate:"required,ISO8601date"`
+	Trigger               EventTriggerEnumType      `json:"trigger" validate:"required,EventTriggerEnumType"`
+	Cause                 *int                      `json:"cause,omitempty"`
+	ActualValue           string                    `json:"actualValue" validate:"required,max=2500"`
+	TechCode              string                    `json:"techCode,omitempty" validate:"omitempty,max=50"`
+	TechInfo              string                    `json:"techInfo,omitempty" validate:"omitempty,max=500"`
+	Cleared               *bool                     `json:"cleared,omitempty"`
+	TransactionId         string                    `json:"transactionId,omitempty" validate:"omitempty,max=36"`
+	Component             ComponentType             `json:"component"`
+	VariableMonitoringId  *int                      `json:"variableMonitoringId,omitempty"`
+	EventNotificationType EventNotificationEnumType `json:"eventNotificationType" validate:"required,EventNotificationEnumType"`
+	Variable              VariableType              `json:"variable"`
+}
+
+type FirmwareType struct {
+	CustomData         *CustomDataType `json:"customData,omitempty"`
+	Location           string          `json:"location" validate:"required,max=512"`
+	RetrieveDateTime   string          `json:"retrieveDateTime" validate:"required,ISO8601date"`
+	InstallDateTime    string          `json:"installDateTime,omitempty" validate:"omitempty,ISO8601date"`
+	SigningCertificate string          `json:"signingCertificate,omitempty" validate:"omitempty,max=5500"`
+	Signature          string          `json:"signature,omitempty" validate:"omitempty,max=800"`
+}
+
+type FixedPFGetType struct {
+	CustomData   *CustomDataType `json:"customData,omitempty"`
+	FixedPF      FixedPFType     `json:"fixedPF"`
+	Id           string          `json:"id" validate:"required,max=36"`
+	IsDefault    *bool           `json:"isDefault" validate:"required"`
+	IsSuperseded *bool           `json:"isSuperseded" validate:"required"`
+}
+
+type FixedPFType struct {
+	CustomData   *CustomDataType `json:"customData,omitempty"`
+	Priority     *int            `json:"priority" validate:"required"`
+	Displacement *float64        `json:"displacement" validate:"required"`
+	Excitation   *bool           `json:"excitation" validate:"required"`
+	StartTime    string          `json:"startTime,omitempty" validate:"omitempty,ISO8601date"`
+	Duration     *float64        `json:"duration,omitempty"`
+}
+
+type FixedVarGetType struct {
+	CustomData   *CustomDataType `json:"customData,omitempty"`
+	FixedVar     FixedVarType    `json:"fixedVar"`
+	Id           string          `json:"id" validate:"required,max=36"`
+	IsDefault    *bool           `json:"isDefault" validate:"required"`
+	IsSuperseded *bool           `json:"isSuperseded" validate:"required"`
+}
+
+type FixedVarType struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Priority   *int            `json:"priority" validate:"required"`
+	Setpoint   *float64        `json:"setpoint" validate:"required"`
+	Unit       DERUnitEnumType `json:"unit" validate:"required,DERUnitEnumType"`
+	StartTime  string          `json:"startTime,omitempty" validate:"omitempty,ISO8601date"`
+	Duration   *float64        `json:"duration,omitempty"`
+}
+
+type FreqDroopGetType struct {
+	CustomData   *CustomDataType `json:"customData,omitempty"`
+	FreqDroop    FreqDroopType   `json:"freqDroop"`
+	Id           string          `json:"id" validate:"required,max=36"`
+	IsDefault    *bool           `json:"isDefault" validate:"required"`
+	IsSuperseded *bool           `json:"isSuperseded" validate:"required"`
+}
+
+type FreqDroopType struct {
+	CustomData   *CustomDataType `json:"customData,omitempty"`
+	Priority     *int            `json:"priority" validate:"required"`
+	OverFreq     *float64        `json:"overFreq" validate:"required"`
+	UnderFreq    *float64        `json:"underFreq" validate:"required"`
+	OverDroop    *float64        `json:"overDroop" validate:"required"`
+	UnderDroop   *float64        `json:"underDroop" validate:"required"`
+	ResponseTime *float64        `json:"responseTime" validate:"required"`
+	StartTime    string          `json:"startTime,omitempty" validate:"omitempty,ISO8601date"`
+	Duration     *float64        `json:"duration,omitempty"`
+}
+
+type GetVariableDataType struct {
+	CustomData    *CustomDataType   `json:"customData,omitempty"`
+	AttributeType AttributeEnumType `json:"attributeType,omitempty" validate:"omitempty,AttributeEnumType"`
+	Component     ComponentType     `json:"component"`
+	Variable      VariableType      `json:"variable"`
+}
+
+type GetVariableResultType struct {
+	CustomData          *CustomDataType           `json:"customData,omitempty"`
+	AttributeStatusInfo *StatusInfoType           `json:"attributeStatusInfo,omitempty"`
+	AttributeStatus     GetVariableStatusEnumType `json:"attributeStatus" validate:"required,GetVariableStatusEnumType"`
+	AttributeType       AttributeEnumType         `json:"attributeType,omitempty" validate:"omitempty,AttributeEnumType"`
+	AttributeValue      string                    `json:"attributeValue,omitempty" validate:"omitempty,max=2500"`
+	Component           ComponentType             `json:"component"`
+	Variable            VariableType              `json:"variable"`
+}
+
+type GradientGetType struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Gradient   GradientType    `json:"gradient"`
+	Id         string          `json:"id" validate:"required,max=36"`
+}
+
+type GradientType struct {
+	CustomData   *CustomDataType `json:"customData,omitempty"`
+	Priority     *int            `json:"priority" validate:"required"`
+	Gradient     *float64        `json:"gradient" validate:"required"`
+	SoftGradient *float64        `json:"softGradient" validate:"required"`
+}
+
+type HysteresisType struct {
+	CustomData         *CustomDataType `json:"customData,omitempty"`
+	HysteresisHigh     *float64        `json:"hysteresisHigh,omitempty"`
+	HysteresisLow      *float64        `json:"hysteresisLow,omitempty"`
+	HysteresisDelay    *float64        `json:"hysteresisDelay,omitempty"`
+	HysteresisGradient *float64        `json:"hysteresisGradient,omitempty"`
+}
+
+type IdTokenInfoType struct {
+	CustomData          *CustomDataType             `json:"customData,omitempty"`
+	Status              AuthorizationStatusEnumType `json:"status" validate:"required,AuthorizationStatusEnumType"`
+	CacheExpiryDateTime string                      `json:"cacheExpiryDateTime,omitempty" validate:"omitempty,ISO8601date"`
+	ChargingPriority    *int                        `json:"chargingPriority,omitempty"`
+	Language1           string                      `json:"language1,omitempty" validate:"omitempty,max=8"`
+	EvseId              []int                       `json:"evseId,omitempty" validate:"omitempty,min=1"`
+	GroupIdToken        *IdTokenType                `json:"groupIdToken,omitempty"`
+	Language2           string                      `json:"language2,omitempty" validate:"omitempty,max=8"`
+	PersonalMessage     *MessageContentType         `json:"personalMessage,omitempty"`
+}
+
+type IdTokenType struct {
+	CustomData     *CustomDataType      `json:"customData,omitempty"`
+	AdditionalInfo []AdditionalInfoType `json:"additionalInfo,omitempty" validate:"omitempty,min=1,dive"`
+	IdToken        string               `json:"idToken" validate:"required,max=36"`
+	Type           IdTokenEnumType      `json:"type" validate:"required,IdTokenEnumType"`
+}
+
+type LimitMaxDischargeGetType struct {
+	CustomData        *CustomDataType       `json:"customData,omitempty"`
+	Id                string                `json:"id" validate:"required,max=36"`
+	IsDefault         *bool                 `json:"isDefault" validate:"required"`
+	IsSuperseded      *bool                 `json:"isSuperseded" validate:"required"`
+	LimitMaxDischarge LimitMaxDischargeType `json:"limitMaxDischarge"`
+}
+
+type LimitMaxDischargeType struct {
+	CustomData              *CustomDataType `json:"customData,omitempty"`
+	Priority                *int            `json:"priority" validate:"required"`
+	PctMaxDischargePower    *float64        `json:"pctMaxDischargePower,omitempty"`
+	PowerMonitoringMustTrip *DERCurveType   `json:"powerMonitoringMustTrip,omitempty"`
+	StartTime               string          `json:"startTime,omitempty" validate:"omitempty,ISO8601date"`
+	Duration                *float64        `json:"duration,omitempty"`
+}
+
+type LogParametersType struct {
+	CustomData      *CustomDataType `json:"customData,omitempty"`
+	RemoteLocation  string          `json:"remoteLocation" validate:"required,max=512"`
+	OldestTimestamp string          `json:"oldestTimestamp,omitempty" validate:"omitempty,ISO8601date"`
+	LatestTimestamp string          `json:"latestTimestamp,omitempty" validate:"omitempty,ISO8601date"`
+}
+
+type MessageContentType struct {
+	CustomData *CustomDataType       `json:"customData,omitempty"`
+	Format     MessageFormatEnumType `json:"format" validate:"required,MessageFormatEnumType"`
+	Language   string                `json:"language,omitempty" validate:"omitempty,max=8"`
+	Content    string                `json:"content" validate:"required,max=512"`
+}
+
+type MessageInfoType struct {
+	CustomData    *CustomDataType         `json:"customData,omitempty"`
+	Display       *ComponentType          `json:"display,omitempty"`
+	Id            *int                    `json:"id" validate:"required"`
+	Priority      MessagePriorityEnumType `json:"priority" validate:"required,MessagePriorityEnumType"`
+	State         MessageStateEnumType    `json:"state,omitempty" validate:"omitempty,MessageStateEnumType"`
+	StartDateTime string                  `json:"startDateTime,omitempty" validate:"omitempty,ISO8601date"`
+	EndDateTime   string                  `json:"endDateTime,omitempty" validate:"omitempty,ISO8601date"`
+	TransactionId string                  `json:"transactionId,omitempty" validate:"omitempty,max=36"`
+	Message       MessageContentType      `json:"message"`
+}
+
+type MeterValueType struct {
+	CustomData   *CustomDataType    `json:"customData,omitempty"`
+	SampledValue []SampledValueType `json:"sampledValue" validate:"required,min=1,dive"`
+	Timestamp    string             `json:"timestamp" validate:"required,ISO8601date"`
+}
+
+type ModemType struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Iccid      string          `json:"iccid,omitempty" validate:"omitempty,max=20"`
+	Imsi       string          `json:"imsi,omitempty" validate:"omitempty,max=20"`
+}
+
+type MonitoringDataType struct {
+	CustomData         *CustomDataType          `json:"customData,omitempty"`
+	Component          ComponentType            `json:"component"`
+	Variable           VariableType             `json:"variable"`
+	VariableMonitoring []VariableMonitoringType `json:"variableMonitoring" validate:"required,min=1,dive"`
+}
+
+type NetworkConnectionProfileType struct {
+	CustomData      *CustomDataType       `json:"customData,omitempty"`
+	Apn             *APNType              `json:"apn,omitempty"`
+	OcppVersion     OCPPVersionEnumType   `json:"ocppVersion" validate:"required,OCPPVersionEnumType"`
+	OcppTransport   OCPPTransportEnumType `json:"ocppTransport" validate:"required,OCPPTransportEnumType"`
+	OcppCsmsUrl     string                `json:"ocppCsmsUrl" validate:"required,max=512"`
+	MessageTimeout  *int                  `json:"messageTimeout" validate:"required"`
+	SecurityProfile *int                  `json:"securityProfile" validate:"required"`
+	OcppInterface   OCPPInterfaceEnumType `json:"ocppInterface" validate:"required,OCPPInterfaceEnumType"`
+	Vpn             *VPNType              `json:"vpn,omitempty"`
+}
+
+type OCSPRequestDataType struct {
+	CustomData     *CustomDataType       `json:"customData,omitempty"`
+	HashAlgorithm  HashAlgorithmEnumType `json:"hashAlgorithm" validate:"required,HashAlgorithmEnumType"`
+	IssuerNameHash string                `json:"issuerNameHash" validate:"required,max=128"`
+	IssuerKeyHash  string                `json:"issuerKeyHash" validate:"required,max=128"`
+	SerialNumber   string                `json:"serialNumber" validate:"required,max=40"`
+	ResponderURL   string                `json:"responderURL" validate:"required,max=512"`
+}
+
+type PeriodicEventStreamParamsType struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Interval   *int            `json:"interval,omitempty"`
+	Values     *int            `json:"values,omitempty"`
+}
+
+type PriceType struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	ExclTax    *float64        `json:"exclTax,omitempty"`
+	InclTax    *float64        `json:"inclTax,omitempty"`
+	TaxRates   []TaxRateType   `json:"taxRates,omitempty" validate:"omitempty,min=1,max=5,dive"`
+}
+
+type ReactivePowerParamsType struct {
+	CustomData                 *CustomDataType `json:"customData,omitempty"`
+	VRef                       *float64        `json:"vRef,omitempty"`
+	AutonomousVRefEnable       *bool           `json:"autonomousVRefEnable,omitempty"`
+	AutonomousVRefTimeConstant *float64        `json:"autonomousVRefTimeConstant,omitempty"`
+}
+
+type RelativeTimeIntervalType struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Start      *int            `json:"start" validate:"required"`
+	Duration   *int            `json:"duration,omitempty"`
+}
+
+type ReportDataType struct {
+	CustomData              *CustomDataType              `json:"customData,omitempty"`
+	Component               ComponentType                `json:"component"`
+	Variable                VariableType                 `json:"variable"`
+	VariableAttribute       []VariableAttributeType      `json:"variableAttribute" validate:"required,min=1,max=4,dive"`
+	VariableCharacteristics *VariableCharacteristicsType `json:"variableCharacteristics,omitempty"`
+}
+
+type SalesTariffEntryType struct {
+	CustomData           *CustomDataType          `json:"customData,omitempty"`
+	RelativeTimeInterval RelativeTimeIntervalType `json:"relativeTimeInterval"`
+	EPriceLevel          *int                     `json:"ePriceLevel,omitempty"`
+	ConsumptionCost      []ConsumptionCostType    `json:"consumptionCost,omitempty" validate:"omitempty,min=1,max=3,dive"`
+}
+
+type SalesTariffType struct {
+	CustomData             *CustomDataType        `json:"customData,omitempty"`
+	Id                     *int                   `json:"id" validate:"required"`
+	SalesTariffDescription string                 `json:"salesTariffDescription,omitempty" validate:"omitempty,max=32"`
+	NumEPriceLevels        *int                   `json:"numEPriceLevels,omitempty"`
+	SalesTariffEntry       []SalesTariffEntryType `json:"salesTariffEntry" validate:"required,min=1,max=1024,dive"`
+}
+
+type SampledValueType struct {
+	CustomData       *CustomDataType        `json:"customData,omitempty"`
+	Value            *float64               `json:"value" validate:"required"`
+	Context          ReadingContextEnumType `json:"context,omitempty" validate:"omitempty,ReadingContextEnumType"`
+	Measurand        MeasurandEnumType      `json:"measurand,omitempty" validate:"omitempty,MeasurandEnumType"`
+	Phase            PhaseEnumType          `json:"phase,omitempty" validate:"omitempty,PhaseEnumType"`
+	Location         LocationEnumType       `json:"location,omitempty" validate:"omitempty,LocationEnumType"`
+	SignedMeterValue *SignedMeterValueType  `json:"signedMeterValue,omitempty"`
+	UnitOfMeasure    *UnitOfMeasureType     `json:"unitOfMeasure,omitempty"`
+}
+
+type SetMonitoringDataType struct {
+	CustomData  *CustomDataType `json:"customData,omitempty"`
+	Id          *int            `json:"id,omitempty"`
+	Transaction *bool           `json:"transaction,omitempty"`
+	Value       *float64        `json:"value" validate:"required"`
+	Type        MonitorEnumType `json:"type" validate:"required,MonitorEnumType"`
+	Severity    *int            `json:"severity" validate:"required"`
+	Component   ComponentType   `json:"component"`
+	Variable    VariableType    `json:"variable"`
+}
+
+type SetMonitoringResultType struct {
+	CustomData *CustomDataType             `json:"customData,omitempty"`
+	Id         *int                        `json:"id,omitempty"`
+	StatusInfo *StatusInfoType             `json:"statusInfo,omitempty"`
+	Status     SetMonitoringStatusEnumType `json:"status" validate:"required,SetMonitoringStatusEnumType"`
+	Type       MonitorEnumType             `json:"type" validate:"required,MonitorEnumType"`
+	Component  ComponentType               `json:"component"`
+	Variable   VariableType                `json:"variable"`
+	Severity   *int                        `json:"severity" validate:"required"`
+}
+
+type SetVariableDataType struct {
+	CustomData     *CustomDataType   `json:"customData,omitempty"`
+	AttributeType  AttributeEnumType `json:"attributeType,omitempty" validate:"omitempty,AttributeEnumType"`
+	AttributeValue string            `json:"attributeValue" validate:"required,max=1000"`
+	Component      ComponentType     `json:"component"`
+	Variable       VariableType      `json:"variable"`
+}
+
+type SetVariableResultType struct {
+	CustomData          *CustomDataType           `json:"customData,omitempty"`
+	AttributeType       AttributeEnumType         `json:"attributeType,omitempty" validate:"omitempty,AttributeEnumType"`
+	AttributeStatus     SetVariableStatusEnumType `json:"attributeStatus" validate:"required,SetVariableStatusEnumType"`
+	AttributeStatusInfo *StatusInfoType           `json:"attributeStatusInfo,omitempty"`
+	Component           ComponentType             `json:"component"`
+	Variable            VariableType              `json:"variable"`
+}
+
+type SignedMeterValueType struct {
+	CustomData      *CustomDataType `json:"customData,omitempty"`
+	SignedMeterData string          `json:"signedMeterData" validate:"required,max=2500"`
+	SigningMethod   string          `json:"signingMethod" validate:"required,max=50"`
+	EncodingMethod  string          `json:"encodingMethod" validate:"required,max=50"`
+	PublicKey       string          `json:"publicKey" validate:"required,max=2500"`
+}
+
+type StatusInfoType struct {
+	CustomData     *CustomDataType `json:"customData,omitempty"`
+	ReasonCode     string          `json:"reasonCode" validate:"required,max=20"`
+	AdditionalInfo string          `json:"additionalInfo,omitempty" validate:"omitempty,max=512"`
+}
+
+type StreamDataElementType struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	T          *float64        `json:"t" validate:"required"`
+	V          string          `json:"v" validate:"required,max=2500"`
+}
+
+type TariffAssignmentType struct {
+	CustomData *CustomDataType    `json:"customData,omitempty"`
+	TariffId   string             `json:"tariffId" validate:"required,max=60"`
+	TariffKind TariffKindEnumType `json:"tariffKind" validate:"required,TariffKindEnumType"`
+	ValidFrom  string             `json:"validFrom,omitempty" validate:"omitempty,ISO8601date"`
+	EvseIds    []int              `json:"evseIds,omitempty" validate:"omitempty,min=1"`
+	IdTokens   []string           `json:"idTokens,omitempty" validate:"omitempty,min=1,dive,max=255"`
+}
+
+type TariffConditionsFixedType struct {
+	CustomData         *CustomDataType     `json:"customData,omitempty"`
+	StartTimeOfDay     string              `json:"startTimeOfDay,omitempty"`
+	EndTimeOfDay       string              `json:"endTimeOfDay,omitempty"`
+	DayOfWeek          []DayOfWeekEnumType `json:"dayOfWeek,omitempty" validate:"omitempty,min=1,max=7,dive,DayOfWeekEnumType"`
+	ValidFromDate      string              `json:"validFromDate,omitempty"`
+	ValidToDate        string              `json:"validToDate,omitempty"`
+	EvseKind           EvseKindEnumType    `json:"evseKind,omitempty" validate:"omitempty,EvseKindEnumType"`
+	PaymentBrand       string              `json:"paymentBrand,omitempty" validate:"omitempty,max=20"`
+	PaymentRecognition string              `json:"paymentRecognition,omitempty" validate:"omitempty,max=20"`
+}
+
+type TariffConditionsType struct {
+	CustomData      *CustomDataType     `json:"customData,omitempty"`
+	StartTimeOfDay  string              `json:"startTimeOfDay,omitempty"`
+	EndTimeOfDay    string              `json:"endTimeOfDay,omitempty"`
+	DayOfWeek       []DayOfWeekEnumType `json:"dayOfWeek,omitempty" validate:"omitempty,min=1,max=7,dive,DayOfWeekEnumType"`
+	ValidFromDate   string              `json:"validFromDate,omitempty"`
+	ValidToDate     string              `json:"validToDate,omitempty"`
+	EvseKind        EvseKindEnumType    `json:"evseKind,omitempty" validate:"omitempty,EvseKindEnumType"`
+	MinEnergy       *float64            `json:"minEnergy,omitempty"`
+	MaxEnergy       *float64            `json:"maxEnergy,omitempty"`
+	MinCurrent      *float64            `json:"minCurrent,omitempty"`
+	MaxCurrent      *float64            `json:"maxCurrent,omitempty"`
+	MinPower        *float64            `json:"minPower,omitempty"`
+	MaxPower        *float64            `json:"maxPower,omitempty"`
+	MinTime         *int                `json:"minTime,omitempty"`
+	MaxTime         *int                `json:"maxTime,omitempty"`
+	MinChargingTime *int                `json:"minChargingTime,omitempty"`
+	MaxChargingTime *int                `json:"maxChargingTime,omitempty"`
+	MinIdleTime     *int                `json:"minIdleTime,omitempty"`
+	MaxIdleTime     *int                `json:"maxIdleTime,omitempty"`
+}
+
+type TariffEnergyPriceType struct {
+	CustomData *CustomDataType       `json:"customData,omitempty"`
+	PriceKwh   *float64              `json:"priceKwh" validate:"required"`
+	Conditions *TariffConditionsType `json:"conditions,omitempty"`
+}
+
+type TariffEnergyType struct {
+	CustomData *CustomDataType         `json:"customData,omitempty"`
+	Prices     []TariffEnergyPriceType `json:"prices" validate:"required,min=1,dive"`
+	TaxRates   []TaxRateType           `json:"taxRates,omitempty" validate:"omitempty,min=1,max=5,dive"`
+}
+
+type TariffFixedPriceType struct {
+	CustomData *CustomDataType            `json:"customData,omitempty"`
+	Conditions *TariffConditionsFixedType `json:"conditions,omitempty"`
+	PriceFixed *float64                   `json:"priceFixed" validate:"required"`
+}
+
+type TariffFixedType struct {
+	CustomData *CustomDataType        `json:"customData,omitempty"`
+	Prices     []TariffFixedPriceType `json:"prices" validate:"required,min=1,dive"`
+	TaxRates   []TaxRateType          `json:"taxRates,omitempty" validate:"omitempty,min=1,max=5,dive"`
+}
+
+type TariffTimePriceType struct {
+	CustomData  *CustomDataType       `json:"customData,omitempty"`
+	PriceMinute *float64              `json:"priceMinute" validate:"required"`
+	Conditions  *TariffConditionsType `json:"conditions,omitempty"`
+}
+
+type TariffTimeType struct {
+	CustomData *CustomDataType       `json:"customData,omitempty"`
+	Prices     []TariffTimePriceType `json:"prices" validate:"required,min=1,dive"`
+	TaxRates   []TaxRateType         `json:"taxRates,omitempty" validate:"omitempty,min=1,max=5,dive"`
+}
+
+type TariffType struct {
+	CustomData       *CustomDataType      `json:"customData,omitempty"`
+	TariffId         string               `json:"tariffId" validate:"required,max=60"`
+	Description      []MessageContentType `json:"description,omitempty" validate:"omitempty,min=1,max=10,dive"`
+	Currency         string               `json:"currency" validate:"required,max=3"`
+	Energy           *TariffEnergyType    `json:"energy,omitempty"`
+	ValidFrom        string               `json:"validFrom,omitempty" validate:"omitempty,ISO8601date"`
+	ChargingTime     *TariffTimeType      `json:"chargingTime,omitempty"`
+	IdleTime         *TariffTimeType      `json:"idleTime,omitempty"`
+	FixedFee         *TariffFixedType     `json:"fixedFee,omitempty"`
+	ReservationTime  *TariffTimeType      `json:"reservationTime,omitempty"`
+	ReservationFixed *TariffFixedType     `json:"reservationFixed,omitempty"`
+	MinCost          *PriceType           `json:"minCost,omitempty"`
+	MaxCost          *PriceType           `json:"maxCost,omitempty"`
+}
+
+type TaxRateType struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Type       string          `json:"type" validate:"required,max=20"`
+	Tax        *float64        `json:"tax" validate:"required"`
+	Stack      *int            `json:"stack,omitempty"`
+}
+
+type TransactionType struct {
+	CustomData        *CustomDataType       `json:"customData,omitempty"`
+	TransactionId     string                `json:"transactionId" validate:"required,max=36"`
+	ChargingState     ChargingStateEnumType `json:"chargingState,omitempty" validate:"omitempty,ChargingStateEnumType"`
+	TimeSpentCharging *int                  `json:"timeSpentCharging,omitempty"`
+	StoppedReason     ReasonEnumType        `json:"stoppedReason,omitempty" validate:"omitempty,ReasonEnumType"`
+	RemoteStartId     *int                  `json:"remoteStartId,omitempty"`
+}
+
+type UnitOfMeasureType struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Unit       string          `json:"unit,omitempty" validate:"omitempty,max=20"`
+	Multiplier *int            `json:"multiplier,omitempty"`
+}
+
+type V2XChargingParametersType struct {
+	CustomData            *CustomDataType `json:"customData,omitempty"`
+	MinChargePower        *float64        `json:"minChargePower,omitempty"`
+	MaxChargePower        *float64        `json:"maxChargePower,omitempty"`
+	MinDischargePower     *float64        `json:"minDischargePower,omitempty"`
+	MaxDischargePower     *float64        `json:"maxDischargePower,omitempty"`
+	MinChargeCurrent      *float64        `json:"minChargeCurrent,omitempty"`
+	MaxChargeCurrent      *float64        `json:"maxChargeCurrent,omitempty"`
+	MinDischargeCurrent   *float64        `json:"minDischargeCurrent,omitempty"`
+	MaxDischargeCurrent   *float64        `json:"maxDischargeCurrent,omitempty"`
+	MinVoltage            *float64        `json:"minVoltage,omitempty"`
+	MaxVoltage            *float64        `json:"maxVoltage,omitempty"`
+	EvTargetEnergyRequest *float64        `json:"evTargetEnergyRequest,omitempty"`
+	EvMinEnergyRequest    *float64        `json:"evMinEnergyRequest,omitempty"`
+	EvMaxEnergyRequest    *float64        `json:"evMaxEnergyRequest,omitempty"`
+	EvMinV2XEnergyRequest *float64        `json:"evMinV2XEnergyRequest,omitempty"`
+	EvMaxV2XEnergyRequest *float64        `json:"evMaxV2XEnergyRequest,omitempty"`
+	TargetSoC             *int            `json:"targetSoC,omitempty"`
+}
+
+type V2XFreqWattPointType struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Frequency  *float64        `json:"frequency" validate:"required"`
+	Power      *float64        `json:"power" validate:"required"`
+}
+
+type V2XSignalWattPointType struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Signal     *int            `json:"signal" validate:"required"`
+	Power      *float64        `json:"power" validate:"required"`
+}
+
+type VPNType struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Server     string          `json:"server" validate:"required,max=512"`
+	User       string          `json:"user" validate:"required,max=20"`
+	Group      string          `json:"group,omitempty" validate:"omitempty,max=20"`
+	Password   string          `json:"password" validate:"required,max=20"`
+	Key        string          `json:"key" validate:"required,max=255"`
+	Type       VPNEnumType     `json:"type" validate:"required,VPNEnumType"`
+}
+
+type VariableAttributeType struct {
+	CustomData *CustomDataType    `json:"customData,omitempty"`
+	Type       AttributeEnumType  `json:"type,omitempty" validate:"omitempty,AttributeEnumType"`
+	Value      string             `json:"value,omitempty" validate:"omitempty,max=2500"`
+	Mutability MutabilityEnumType `json:"mutability,omitempty" validate:"omitempty,MutabilityEnumType"`
+	Persistent *bool              `json:"persistent,omitempty"`
+	Constant   *bool              `json:"constant,omitempty"`
+}
+
+type VariableCharacteristicsType struct {
+	CustomData         *CustomDataType `json:"customData,omitempty"`
+	Unit               string          `json:"unit,omitempty" validate:"omitempty,max=16"`
+	DataType           DataEnumType    `json:"dataType" validate:"required,DataEnumType"`
+	MinLimit           *float64        `json:"minLimit,omitempty"`
+	MaxLimit           *float64        `json:"maxLimit,omitempty"`
+	ValuesList         string          `json:"valuesList,omitempty" validate:"omitempty,max=1000"`
+	SupportsMonitoring *bool           `json:"supportsMonitoring" validate:"required"`
+}
+
+type VariableMonitoringType struct {
+	CustomData  *CustomDataType `json:"customData,omitempty"`
+	Id          *int            `json:"id" validate:"required"`
+	Transaction *bool           `json:"transaction" validate:"required"`
+	Value       *float64        `json:"value" validate:"required"`
+	Type        MonitorEnumType `json:"type" validate:"required,MonitorEnumType"`
+	Severity    *int            `json:"severity" validate:"required"`
+}
+
+type VariableType struct {
+	CustomData *CustomDataType `json:"customData,omitempty"`
+	Name       string          `json:"name" validate:"required,max=50"`
+	Instance   string          `json:"instance,omitempty" validate:"omitempty,max=50"`
+}
+
+type VoltageParamsType struct {
+	CustomData           *CustomDataType              `json:"customData,omitempty"`
+	Hv10MinMeanValue     *float64                     `json:"hv10MinMeanValue,omitempty"`
+	Hv10MinMeanTripDelay *float64                     `json:"hv10MinMeanTripDelay,omitempty"`
+	PowerDuringCessation PowerDuringCessationEnumType `json:"powerDuringCessation,omitempty" validate:"omitempty,PowerDuringCessationEnumType"`
+}
@@ -0,0 +1,2516 @@
+// Code generated by ocppgen from the OCPP JSON schemas. DO NOT EDIT.
+
+package v21
+
+type APNAuthenticationEnumType string
+
+const (
+	APNAuthenticationCHAP APNAuthenticationEnumType = "CHAP"
+	APNAuthenticationNONE APNAuthenticationEnumType = "NONE"
+	APNAuthenticationPAP  APNAuthenticationEnumType = "PAP"
+	APNAuthenticationAUTO APNAuthenticationEnumType = "AUTO"
+)
+
+// IsValid reports whether e is one of the APNAuthenticationEnumType values
+func (e APNAuthenticationEnumType) IsValid() bool {
+	switch e {
+	case APNAuthenticationCHAP,
+		APNAuthenticationNONE,
+		APNAuthenticationPAP,
+		APNAuthenticationAUTO:
+		return true
+	}
+	return false
+}
+
+type AttributeEnumType string
+
+const (
+	AttributeActual AttributeEnumType = "Actual"
+	AttributeTarget AttributeEnumType = "Target"
+	AttributeMinSet AttributeEnumType = "MinSet"
+	AttributeMaxSet AttributeEnumType = "MaxSet"
+)
+
+// IsValid reports whether e is one of the AttributeEnumType values
+func (e AttributeEnumType) IsValid() bool {
+	switch e {
+	case AttributeActual,
+		AttributeTarget,
+		AttributeMinSet,
+		AttributeMaxSet:
+		return true
+	}
+	return false
+}
+
+type AuthorizationStatusEnumType string
+
+const (
+	AuthorizationStatusAccepted           AuthorizationStatusEnumType = "Accepted"
+	AuthorizationStatusBlocked            AuthorizationStatusEnumType = "Blocked"
+	AuthorizationStatusConcurrentTx       AuthorizationStatusEnumType = "ConcurrentTx"
+	AuthorizationStatusExpired            AuthorizationStatusEnumType = "Expired"
+	AuthorizationStatusInvalid            AuthorizationStatusEnumType = "Invalid"
+	AuthorizationStatusNoCredit           AuthorizationStatusEnumType = "NoCredit"
+	AuthorizationStatusNotAllowedTypeEVSE AuthorizationStatusEnumType = "NotAllowedTypeEVSE"
+	AuthorizationStatusNotAtThisLocation  AuthorizationStatusEnumType = "NotAtThisLocation"
+	AuthorizationStatusNotAtThisTime      AuthorizationStatusEnumType = "NotAtThisTime"
+	AuthorizationStatusUnknown            AuthorizationStatusEnumType = "Unknown"
+)
+
+// IsValid reports whether e is one of the AuthorizationStatusEnumType values
+func (e AuthorizationStatusEnumType) IsValid() bool {
+	switch e {
+	case AuthorizationStatusAccepted,
+		AuthorizationStatusBlocked,
+		AuthorizationStatusConcurrentTx,
+		AuthorizationStatusExpired,
+		AuthorizationStatusInvalid,
+		AuthorizationStatusNoCredit,
+		AuthorizationStatusNotAllowedTypeEVSE,
+		AuthorizationStatusNotAtThisLocation,
+		AuthorizationStatusNotAtThisTime,
+		AuthorizationStatusUnknown:
+		return true
+	}
+	return false
+}
+
+type AuthorizeCertificateStatusEnumType string
+
+const (
+	AuthorizeCertificateStatusAccepted               AuthorizeCertificateStatusEnumType = "Accepted"
+	AuthorizeCertificateStatusSignatureError         AuthorizeCertificateStatusEnumType = "SignatureError"
+	AuthorizeCertificateStatusCertificateExpired     AuthorizeCertificateStatusEnumType = "CertificateExpired"
+	AuthorizeCertificateStatusCertificateRevoked     AuthorizeCertificateStatusEnumType = "CertificateRevoked"
+	AuthorizeCertificateStatusNoCertificateAvailable AuthorizeCertificateStatusEnumType = "NoCertificateAvailable"
+	AuthorizeCertificateStatusCertChainError         AuthorizeCertificateStatusEnumType = "CertChainError"
+	AuthorizeCertificateStatusContractCancelled      AuthorizeCertificateStatusEnumType = "ContractCancelled"
+)
+
+// IsValid reports whether e is one of the AuthorizeCertificateStatusEnumType values
+func (e AuthorizeCertificateStatusEnumType) IsValid() bool {
+	switch e {
+	case AuthorizeCertificateStatusAccepted,
+		AuthorizeCertificateStatusSignatureError,
+		AuthorizeCertificateStatusCertificateExpired,
+		AuthorizeCertificateStatusCertificateRevoked,
+		AuthorizeCertificateStatusNoCertificateAvailable,
+		AuthorizeCertificateStatusCertChainError,
+		AuthorizeCertificateStatusContractCancelled:
+		return true
+	}
+	return false
+}
+
+type BatterySwapEventEnumType string
+
+const (
+	BatterySwapEventBatteryIn         BatterySwapEventEnumType = "BatteryIn"
+	BatterySwapEventBatteryOut        BatterySwapEventEnumType = "BatteryOut"
+	BatterySwapEventBatteryOutTimeout BatterySwapEventEnumType = "BatteryOutTimeout"
+)
+
+// IsValid reports whether e is one of the BatterySwapEventEnumType values
+func (e BatterySwapEventEnumType) IsValid() bool {
+	switch e {
+	case BatterySwapEventBatteryIn,
+		BatterySwapEventBatteryOut,
+		BatterySwapEventBatteryOutTimeout:
+		return true
+	}
+	return false
+}
+
+type BootReasonEnumType string
+
+const (
+	BootReasonApplicationReset BootReasonEnumType = "ApplicationReset"
+	BootReasonFirmwareUpdate   BootReasonEnumType = "FirmwareUpdate"
+	BootReasonLocalReset       BootReasonEnumType = "LocalReset"
+	BootReasonPowerUp          BootReasonEnumType = "PowerUp"
+	BootReasonRemoteReset      BootReasonEnumType = "RemoteReset"
+	BootReasonScheduledReset   BootReasonEnumType = "ScheduledReset"
+	BootReasonTriggered        BootReasonEnumType = "Triggered"
+	BootReasonUnknown          BootReasonEnumType = "Unknown"
+	BootReasonWatchdog         BootReasonEnumType = "Watchdog"
+)
+
+// IsValid reports whether e is one of the BootReasonEnumType values
+func (e BootReasonEnumType) IsValid() bool {
+	switch e {
+	case BootReasonApplicationReset,
+		BootReasonFirmwareUpdate,
+		BootReasonLocalReset,
+		BootReasonPowerUp,
+		BootReasonRemoteReset,
+		BootReasonScheduledReset,
+		BootReasonTriggered,
+		BootReasonUnknown,
+		BootReasonWatchdog:
+		return true
+	}
+	return false
+}
+
+type CancelReservationStatusEnumType string
+
+const (
+	CancelReservationStatusAccepted CancelReservationStatusEnumType = "Accepted"
+	CancelReservationStatusRejected CancelReservationStatusEnumType = "Rejected"
+)
+
+// IsValid reports whether e is one of the CancelReservationStatusEnumType values
+func (e CancelReservationStatusEnumType) IsValid() bool {
+	switch e {
+	case CancelReservationStatusAccepted,
+		CancelReservationStatusRejected:
+		return true
+	}
+	return false
+}
+
+type CertificateActionEnumType string
+
+const (
+	CertificateActionInstall CertificateActionEnumType = "Install"
+	CertificateActionUpdate  CertificateActionEnumType = "Update"
+)
+
+// IsValid reports whether e is one of the CertificateActionEnumType values
+func (e CertificateActionEnumType) IsValid() bool {
+	switch e {
+	case CertificateActionInstall,
+		CertificateActionUpdate:
+		return true
+	}
+	return false
+}
+
+type CertificateSignedStatusEnumType string
+
+const (
+	CertificateSignedStatusAccepted CertificateSignedStatusEnumType = "Accepted"
+	CertificateSignedStatusRejected CertificateSignedStatusEnumType = "Rejected"
+)
+
+// IsValid reports whether e is one of the CertificateSignedStatusEnumType values
+func (e CertificateSignedStatusEnumType) IsValid() bool {
+	switch e {
+	case CertificateSignedStatusAccepted,
+		CertificateSignedStatusRejected:
+		return true
+	}
+	return false
+}
+
+type CertificateSigningUseEnumType string
+
+const (
+	CertificateSigningUseChargingStationCertificate CertificateSigningUseEnumType = "ChargingStationCertificate"
+	CertificateSigningUseV2GCertificate             CertificateSigningUseEnumType = "V2GCertificate"
+)
+
+// IsValid reports whether e is one of the CertificateSigningUseEnumType values
+func (e CertificateSigningUseEnumType) IsValid() bool {
+	switch e {
+	case CertificateSigningUseChargingStationCertificate,
+		CertificateSigningUseV2GCertificate:
+		return true
+	}
+	return false
+}
+
+type CertificateStatusEnumType string
+
+const (
+	CertificateStatusGood    CertificateStatusEnumType = "Good"
+	CertificateStatusRevoked CertificateStatusEnumType = "Revoked"
+	CertificateStatusUnknown CertificateStatusEnumType = "Unknown"
+	CertificateStatusFailed  CertificateStatusEnumType = "Failed"
+)
+
+// IsValid reports whether e is one of the CertificateStatusEnumType values
+func (e CertificateStatusEnumType) IsValid() bool {
+	switch e {
+	case CertificateStatusGood,
+		CertificateStatusRevoked,
+		CertificateStatusUnknown,
+		CertificateStatusFailed:
+		return true
+	}
+	return false
+}
+
+type CertificateStatusSourceEnumType string
+
+const (
+	CertificateStatusSourceCRL  CertificateStatusSourceEnumType = "CRL"
+	CertificateStatusSourceOCSP CertificateStatusSourceEnumType = "OCSP"
+)
+
+// IsValid reports whether e is one of the CertificateStatusSourceEnumType values
+func (e CertificateStatusSourceEnumType) IsValid() bool {
+	switch e {
+	case CertificateStatusSourceCRL,
+		CertificateStatusSourceOCSP:
+		return true
+	}
+	return false
+}
+
+type ChangeAvailabilityStatusEnumType string
+
+const (
+	ChangeAvailabilityStatusAccepted  ChangeAvailabilityStatusEnumType = "Accepted"
+	ChangeAvailabilityStatusRejected  ChangeAvailabilityStatusEnumType = "Rejected"
+	ChangeAvailabilityStatusScheduled ChangeAvailabilityStatusEnumType = "Scheduled"
+)
+
+// IsValid reports whether e is one of the ChangeAvailabilityStatusEnumType values
+func (e ChangeAvailabilityStatusEnumType) IsValid() bool {
+	switch e {
+	case ChangeAvailabilityStatusAccepted,
+		ChangeAvailabilityStatusRejected,
+		ChangeAvailabilityStatusScheduled:
+		return true
+	}
+	return false
+}
+
+type ChargingLimitSourceEnumType string
+
+const (
+	ChargingLimitSourceEMS   ChargingLimitSourceEnumType = "EMS"
+	ChargingLimitSourceOther ChargingLimitSourceEnumType = "Other"
+	ChargingLimitSourceSO    ChargingLimitSourceEnumType = "SO"
+	ChargingLimitSourceCSO   ChargingLimitSourceEnumType = "CSO"
+)
+
+// IsValid reports whether e is one of the ChargingLimitSourceEnumType values
+func (e ChargingLimitSourceEnumType) IsValid() bool {
+	switch e {
+	case ChargingLimitSourceEMS,
+		ChargingLimitSourceOther,
+		ChargingLimitSourceSO,
+		ChargingLimitSourceCSO:
+		return true
+	}
+	return false
+}
+
+type ChargingProfileKindEnumType string
+
+const (
+	ChargingProfileKindAbsolute  ChargingProfileKindEnumType = "Absolute"
+	ChargingProfileKindRecurring ChargingProfileKindEnumType = "Recurring"
+	ChargingProfileKindRelative  ChargingProfileKindEnumType = "Relative"
+	ChargingProfileKindDynamic   ChargingProfileKindEnumType = "Dynamic"
+)
+
+// IsValid reports whether e is one of the ChargingProfileKindEnumType values
+func (e ChargingProfileKindEnumType) IsValid() bool {
+	switch e {
+	case ChargingProfileKindAbsolute,
+		ChargingProfileKindRecurring,
+		ChargingProfileKindRelative,
+		ChargingProfileKindDynamic:
+		return true
+	}
+	return false
+}
+
+type ChargingProfilePurposeEnumType string
+
+const (
+	ChargingProfilePurposeChargingStationExternalConstraints ChargingProfilePurposeEnumType = "ChargingStationExternalConstraints"
+	ChargingProfilePurposeChargingStationMaxProfile          ChargingProfilePurposeEnumType = "ChargingStationMaxProfile"
+	ChargingProfilePurposeTxDefaultProfile                   ChargingProfilePurposeEnumType = "TxDefaultProfile"
+	ChargingProfilePurposeTxProfile                          ChargingProfilePurposeEnumType = "TxProfile"
+	ChargingProfilePurposePriorityCharging                   ChargingProfilePurposeEnumType = "PriorityCharging"
+	ChargingProfilePurposeLocalGeneration                    ChargingProfilePurposeEnumType = "LocalGeneration"
+)
+
+// IsValid reports whether e is one of the ChargingProfilePurposeEnumType values
+func (e ChargingProfilePurposeEnumType) IsValid() bool {
+	switch e {
+	case ChargingProfilePurposeChargingStationExternalConstraints,
+		ChargingProfilePurposeChargingStationMaxProfile,
+		ChargingProfilePurposeTxDefaultProfile,
+		ChargingProfilePurposeTxProfile,
+		ChargingProfilePurposePriorityCharging,
+		ChargingProfilePurposeLocalGeneration:
+		return true
+	}
+	return false
+}
+
+type ChargingProfileStatusEnumType string
+
+const (
+	ChargingProfileStatusAccepted ChargingProfileStatusEnumType = "Accepted"
+	ChargingProfileStatusRejected ChargingProfileStatusEnumType = "Rejected"
+)
+
+// IsValid reports whether e is one of the ChargingProfileStatusEnumType values
+func (e ChargingProfileStatusEnumType) IsValid() bool {
+	switch e {
+	case ChargingProfileStatusAccepted,
+		ChargingProfileStatusRejected:
+		return true
+	}
+	return false
+}
+
+type ChargingRateUnitEnumType string
+
+const (
+	ChargingRateUnitW ChargingRateUnitEnumType = "W"
+	ChargingRateUnitA ChargingRateUnitEnumType = "A"
+)
+
+// IsValid reports whether e is one of the ChargingRateUnitEnumType values
+func (e ChargingRateUnitEnumType) IsValid() bool {
+	switch e {
+	case ChargingRateUnitW,
+		ChargingRateUnitA:
+		return true
+	}
+	return false
+}
+
+type ChargingStateEnumType string
+
+const (
+	ChargingStateCharging      ChargingStateEnumType = "Charging"
+	ChargingStateEVConnected   ChargingStateEnumType = "EVConnected"
+	ChargingStateSuspendedEV   ChargingStateEnumType = "SuspendedEV"
+	ChargingStateSuspendedEVSE ChargingStateEnumType = "SuspendedEVSE"
+	ChargingStateIdle          ChargingStateEnumType = "Idle"
+)
+
+// IsValid reports whether e is one of the ChargingStateEnumType values
+func (e ChargingStateEnumType) IsValid() bool {
+	switch e {
+	case ChargingStateCharging,
+		ChargingStateEVConnected,
+		ChargingStateSuspendedEV,
+		ChargingStateSuspendedEVSE,
+		ChargingStateIdle:
+		return true
+	}
+	return false
+}
+
+type ClearCacheStatusEnumType string
+
+const (
+	ClearCacheStatusAccepted ClearCacheStatusEnumType = "Accepted"
+	ClearCacheStatusRejected ClearCacheStatusEnumType = "Rejected"
+)
+
+// IsValid reports whether e is one of the ClearCacheStatusEnumType values
+func (e ClearCacheStatusEnumType) IsValid() bool {
+	switch e {
+	case ClearCacheStatusAccepted,
+		ClearCacheStatusRejected:
+		return true
+	}
+	return false
+}
+
+type ClearChargingProfileStatusEnumType string
+
+const (
+	ClearChargingProfileStatusAccepted ClearChargingProfileStatusEnumType = "Accepted"
+	ClearChargingProfileStatusUnknown  ClearChargingProfileStatusEnumType = "Unknown"
+)
+
+// IsValid reports whether e is one of the ClearChargingProfileStatusEnumType values
+func (e ClearChargingProfileStatusEnumType) IsValid() bool {
+	switch e {
+	case ClearChargingProfileStatusAccepted,
+		ClearChargingProfileStatusUnknown:
+		return true
+	}
+	return false
+}
+
+type ClearMessageStatusEnumType string
+
+const (
+	ClearMessageStatusAccepted ClearMessageStatusEnumType = "Accepted"
+	ClearMessageStatusUnknown  ClearMessageStatusEnumType = "Unknown"
+)
+
+// IsValid reports whether e is one of the ClearMessageStatusEnumType values
+func (e ClearMessageStatusEnumType) IsValid() bool {
+	switch e {
+	case ClearMessageStatusAccepted,
+		ClearMessageStatusUnknown:
+		return true
+	}
+	return false
+}
+
+type ClearMonitoringStatusEnumType string
+
+const (
+	ClearMonitoringStatusAccepted ClearMonitoringStatusEnumType = "Accepted"
+	ClearMonitoringStatusRejected ClearMonitoringStatusEnumType = "Rejected"
+	ClearMonitoringStatusNotFound ClearMonitoringStatusEnumType = "NotFound"
+)
+
+// IsValid reports whether e is one of the ClearMonitoringStatusEnumType values
+func (e ClearMonitoringStatusEnumType) IsValid() bool {
+	switch e {
+	case ClearMonitoringStatusAccepted,
+		ClearMonitoringStatusRejected,
+		ClearMonitoringStatusNotFound:
+		return true
+	}
+	return false
+}
+
+type ComponentCriterionEnumType string
+
+const (
+	ComponentCriterionActive    ComponentCriterionEnumType = "Active"
+	ComponentCriterionAvailable ComponentCriterionEnumType = "Available"
+	ComponentCriterionEnabled   ComponentCriterionEnumType = "Enabled"
+	ComponentCriterionProblem   ComponentCriterionEnumType = "Problem"
+)
+
+// IsValid reports whether e is one of the ComponentCriterionEnumType values
+func (e ComponentCriterionEnumType) IsValid() bool {
+	switch e {
+	case ComponentCriterionActive,
+		ComponentCriterionAvailable,
+		ComponentCriterionEnabled,
+		ComponentCriterionProblem:
+		return true
+	}
+	return false
+}
+
+type ConnectorEnumType string
+
+const (
+	ConnectorCCCS1           ConnectorEnumType = "cCCS1"
+	ConnectorCCCS2           ConnectorEnumType = "cCCS2"
+	ConnectorCG105           ConnectorEnumType = "cG105"
+	ConnectorCTesla          ConnectorEnumType = "cTesla"
+	ConnectorCType1          ConnectorEnumType = "cType1"
+	ConnectorCType2          ConnectorEnumType = "cType2"
+	ConnectorS3091P16A       ConnectorEnumType = "s309-1P-16A"
+	ConnectorS3091P32A       ConnectorEnumType = "s309-1P-32A"
+	ConnectorS3093P16A       ConnectorEnumType = "s309-3P-16A"
+	ConnectorS3093P32A       ConnectorEnumType = "s309-3P-32A"
+	ConnectorSBS1361         ConnectorEnumType = "sBS1361"
+	ConnectorSCEE77          ConnectorEnumType = "sCEE-7-7"
+	ConnectorSType2          ConnectorEnumType = "sType2"
+	ConnectorSType3          ConnectorEnumType = "sType3"
+	ConnectorOther1PhMax16A  ConnectorEnumType = "Other1PhMax16A"
+	ConnectorOther1PhOver16A ConnectorEnumType = "Other1PhOver16A"
+	ConnectorOther3Ph        ConnectorEnumType = "Other3Ph"
+	ConnectorPan             ConnectorEnumType = "Pan"
+	ConnectorWInductive      ConnectorEnumType = "wInductive"
+	ConnectorWResonant       ConnectorEnumType = "wResonant"
+	ConnectorUndetermined    ConnectorEnumType = "Undetermined"
+	ConnectorUnknown         ConnectorEnumType = "Unknown"
+)
+
+// IsValid reports whether e is one of the ConnectorEnumType values
+func (e ConnectorEnumType) IsValid() bool {
+	switch e {
+	case ConnectorCCCS1,
+		ConnectorCCCS2,
+		ConnectorCG105,
+		ConnectorCTesla,
+		ConnectorCType1,
+		ConnectorCType2,
+		ConnectorS3091P16A,
+		ConnectorS3091P32A,
+		ConnectorS3093P16A,
+		ConnectorS3093P32A,
+		ConnectorSBS1361,
+		ConnectorSCEE77,
+		ConnectorSType2,
+		ConnectorSType3,
+		ConnectorOther1PhMax16A,
+		ConnectorOther1PhOver16A,
+		ConnectorOther3Ph,
+		ConnectorPan,
+		ConnectorWInductive,
+		ConnectorWResonant,
+		ConnectorUndetermined,
+		ConnectorUnknown:
+		return true
+	}
+	return false
+}
+
+type ConnectorStatusEnumType string
+
+const (
+	ConnectorStatusAvailable   ConnectorStatusEnumType = "Available"
+	ConnectorStatusOccupied    ConnectorStatusEnumType = "Occupied"
+	ConnectorStatusReserved    ConnectorStatusEnumType = "Reserved"
+	ConnectorStatusUnavailable ConnectorStatusEnumType = "Unavailable"
+	ConnectorStatusFaulted     ConnectorStatusEnumType = "Faulted"
+)
+
+// IsValid reports whether e is one of the ConnectorStatusEnumType values
+func (e ConnectorStatusEnumType) IsValid() bool {
+	switch e {
+	case ConnectorStatusAvailable,
+		ConnectorStatusOccupied,
+		ConnectorStatusReserved,
+		ConnectorStatusUnavailable,
+		ConnectorStatusFaulted:
+		return true
+	}
+	return false
+}
+
+type ControlModeEnumType string
+
+const (
+	ControlModeScheduledControl ControlModeEnumType = "ScheduledControl"
+	ControlModeDynamicControl   ControlModeEnumType = "DynamicControl"
+)
+
+// IsValid reports whether e is one of the ControlModeEnumType values
+func (e ControlModeEnumType) IsValid() bool {
+	switch e {
+	case ControlModeScheduledControl,
+		ControlModeDynamicControl:
+		return true
+	}
+	return false
+}
+
+type CostKindEnumType string
+
+const (
+	CostKindCarbonDioxideEmission         CostKindEnumType = "CarbonDioxideEmission"
+	CostKindRelativePricePercentage       CostKindEnumType = "RelativePricePercentage"
+	CostKindRenewableGenerationPercentage CostKindEnumType = "RenewableGenerationPercentage"
+)
+
+// IsValid reports whether e is one of the CostKindEnumType values
+func (e CostKindEnumType) IsValid() bool {
+	switch e {
+	case CostKindCarbonDioxideEmission,
+		CostKindRelativePricePercentage,
+		CostKindRenewableGenerationPercentage:
+		return true
+	}
+	return false
+}
+
+type CustomerInformationStatusEnumType string
+
+const (
+	CustomerInformationStatusAccepted CustomerInformationStatusEnumType = "Accepted"
+	CustomerInformationStatusRejected CustomerInformationStatusEnumType = "Rejected"
+	CustomerInformationStatusInvalid  CustomerInformationStatusEnumType = "Invalid"
+)
+
+// IsValid reports whether e is one of the CustomerInformationStatusEnumType values
+func (e CustomerInformationStatusEnumType) IsValid() bool {
+	switch e {
+	case CustomerInformationStatusAccepted,
+		CustomerInformationStatusRejected,
+		CustomerInformationStatusInvalid:
+		return true
+	}
+	return false
+}
+
+type DERControlEnumType string
+
+const (
+	DERControlEnterService            DERControlEnumType = "EnterService"
+	DERControlFreqDroop               DERControlEnumType = "FreqDroop"
+	DERControlFreqWatt                DERControlEnumType = "FreqWatt"
+	DERControlFixedPFAbsorb           DERControlEnumType = "FixedPFAbsorb"
+	DERControlFixedPFInject           DERControlEnumType = "FixedPFInject"
+	DERControlFixedVar                DERControlEnumType = "FixedVar"
+	DERControlGradients               DERControlEnumType = "Gradients"
+	DERControlHFMustTrip              DERControlEnumType = "HFMustTrip"
+	DERControlHFMayTrip               DERControlEnumType = "HFMayTrip"
+	DERControlHVMustTrip              DERControlEnumType = "HVMustTrip"
+	DERControlHVMomCess               DERControlEnumType = "HVMomCess"
+	DERControlHVMayTrip               DERControlEnumType = "HVMayTrip"
+	DERControlLimitMaxDischarge       DERControlEnumType = "LimitMaxDischarge"
+	DERControlLFMustTrip              DERControlEnumType = "LFMustTrip"
+	DERControlLVMustTrip              DERControlEnumType = "LVMustTrip"
+	DERControlLVMomCess               DERControlEnumType = "LVMomCess"
+	DERControlLVMayTrip               DERControlEnumType = "LVMayTrip"
+	DERControlPowerMonitoringMustTrip DERControlEnumType = "PowerMonitoringMustTrip"
+	DERControlVoltVar                 DERControlEnumType = "VoltVar"
+	DERControlVoltWatt                DERControlEnumType = "VoltWatt"
+	DERControlWattPF                  DERControlEnumType = "WattPF"
+	DERControlWattVar                 DERControlEnumType = "WattVar"
+)
+
+// IsValid reports whether e is one of the DERControlEnumType values
+func (e DERControlEnumType) IsValid() bool {
+	switch e {
+	case DERControlEnterService,
+		DERControlFreqDroop,
+		DERControlFreqWatt,
+		DERControlFixedPFAbsorb,
+		DERControlFixedPFInject,
+		DERControlFixedVar,
+		DERControlGradients,
+		DERControlHFMustTrip,
+		DERControlHFMayTrip,
+		DERControlHVMustTrip,
+		DERControlHVMomCess,
+		DERControlHVMayTrip,
+		DERControlLimitMaxDischarge,
+		DERControlLFMustTrip,
+		DERControlLVMustTrip,
+		DERControlLVMomCess,
+		DERControlLVMayTrip,
+		DERControlPowerMonitoringMustTrip,
+		DERControlVoltVar,
+		DERControlVoltWatt,
+		DERControlWattPF,
+		DERControlWattVar:
+		return true
+	}
+	return false
+}
+
+type DERControlStatusEnumType string
+
+const (
+	DERControlStatusAccepted     DERControlStatusEnumType = "Accepted"
+	DERControlStatusRejected     DERControlStatusEnumType = "Rejected"
+	DERControlStatusNotSupported DERControlStatusEnumType = "NotSupported"
+	DERControlStatusNotFound     DERControlStatusEnumType = "NotFound"
+)
+
+// IsValid reports whether e is one of the DERControlStatusEnumType values
+func (e DERControlStatusEnumType) IsValid() bool {
+	switch e {
+	case DERControlStatusAccepted,
+		DERControlStatusRejected,
+		DERControlStatusNotSupported,
+		DERControlStatusNotFound:
+		return true
+	}
+	return false
+}
+
+type DERUnitEnumType string
+
+const (
+	DERUnitNotApplicable DERUnitEnumType = "Not_Applicable"
+	DERUnitPctMaxW       DERUnitEnumType = "PctMaxW"
+	DERUnitPctMaxVar     DERUnitEnumType = "PctMaxVar"
+	DERUnitPctWAvail     DERUnitEnumType = "PctWAvail"
+	DERUnitPctVarAvail   DERUnitEnumType = "PctVarAvail"
+	DERUnitPctEffectiveV DERUnitEnumType = "PctEffectiveV"
+)
+
+// IsValid reports whether e is one of the DERUnitEnumType values
+func (e DERUnitEnumType) IsValid() bool {
+	switch e {
+	case DERUnitNotApplicable,
+		DERUnitPctMaxW,
+		DERUnitPctMaxVar,
+		DERUnitPctWAvail,
+		DERUnitPctVarAvail,
+		DERUnitPctEffectiveV:
+		return true
+	}
+	return false
+}
+
+type DataEnumType string
+
+const (
+	DataString       DataEnumType = "string"
+	DataDecimal      DataEnumType = "decimal"
+	DataInteger      DataEnumType = "integer"
+	DataDateTime     DataEnumType = "dateTime"
+	DataBoolean      DataEnumType = "boolean"
+	DataOptionList   DataEnumType = "OptionList"
+	DataSequenceList DataEnumType = "SequenceList"
+	DataMemberList   DataEnumType = "MemberList"
+)
+
+// IsValid reports whether e is one of the DataEnumType values
+func (e DataEnumType) IsValid() bool {
+	switch e {
+	case DataString,
+		DataDecimal,
+		DataInteger,
+		DataDateTime,
+		DataBoolean,
+		DataOptionList,
+		DataSequenceList,
+		DataMemberList:
+		return true
+	}
+	return false
+}
+
+type DataTransferStatusEnumType string
+
+const (
+	DataTransferStatusAccepted         DataTransferStatusEnumType = "Accepted"
+	DataTransferStatusRejected         DataTransferStatusEnumType = "Rejected"
+	DataTransferStatusUnknownMessageId DataTransferStatusEnumType = "UnknownMessageId"
+	DataTransferStatusUnknownVendorId  DataTransferStatusEnumType = "UnknownVendorId"
+)
+
+// IsValid reports whether e is one of the DataTransferStatusEnumType values
+func (e DataTransferStatusEnumType) IsValid() bool {
+	switch e {
+	case DataTransferStatusAccepted,
+		DataTransferStatusRejected,
+		DataTransferStatusUnknownMessageId,
+		DataTransferStatusUnknownVendorId:
+		return true
+	}
+	return false
+}
+
+type DayOfWeekEnumType string
+
+const (
+	DayOfWeekMonday    DayOfWeekEnumType = "Monday"
+	DayOfWeekTuesday   DayOfWeekEnumType = "Tuesday"
+	DayOfWeekWednesday DayOfWeekEnumType = "Wednesday"
+	DayOfWeekThursday  DayOfWeekEnumType = "Thursday"
+	DayOfWeekFriday    DayOfWeekEnumType = "Friday"
+	DayOfWeekSaturday  DayOfWeekEnumType = "Saturday"
+	DayOfWeekSunday    DayOfWeekEnumType = "Sunday"
+)
+
+// IsValid reports whether e is one of the DayOfWeekEnumType values
+func (e DayOfWeekEnumType) IsValid() bool {
+	switch e {
+	case DayOfWeekMonday,
+		DayOfWeekTuesday,
+		DayOfWeekWednesday,
+		DayOfWeekThursday,
+		DayOfWeekFriday,
+		DayOfWeekSaturday,
+		DayOfWeekSunday:
+		return true
+	}
+	return false
+}
+
+type DeleteCertificateStatusEnumType string
+
+const (
+	DeleteCertificateStatusAccepted DeleteCertificateStatusEnumType = "Accepted"
+	DeleteCertificateStatusFailed   DeleteCertificateStatusEnumType = "Failed"
+	DeleteCertificateStatusNotFound DeleteCertificateStatusEnumType = "NotFound"
+)
+
+// IsValid reports whether e is one of the DeleteCertificateStatusEnumType values
+func (e DeleteCertificateStatusEnumType) IsValid() bool {
+	switch e {
+	case DeleteCertificateStatusAccepted,
+		DeleteCertificateStatusFailed,
+		DeleteCertificateStatusNotFound:
+		return true
+	}
+	return false
+}
+
+type DisplayMessageStatusEnumType string
+
+const (
+	DisplayMessageStatusAccepted                  DisplayMessageStatusEnumType = "Accepted"
+	DisplayMessageStatusNotSupportedMessageFormat DisplayMessageStatusEnumType = "NotSupportedMessageFormat"
+	DisplayMessageStatusRejected                  DisplayMessageStatusEnumType = "Rejected"
+	DisplayMessageStatusNotSupportedPriority      DisplayMessageStatusEnumType = "NotSupportedPriority"
+	DisplayMessageStatusNotSupportedState         DisplayMessageStatusEnumType = "NotSupportedState"
+	DisplayMessageStatusUnknownTransaction        DisplayMessageStatusEnumType = "UnknownTransaction"
+)
+
+// IsValid reports whether e is one of the DisplayMessageStatusEnumType values
+func (e DisplayMessageStatusEnumType) IsValid() bool {
+	switch e {
+	case DisplayMessageStatusAccepted,
+		DisplayMessageStatusNotSupportedMessageFormat,
+		DisplayMessageStatusRejected,
+		DisplayMessageStatusNotSupportedPriority,
+		DisplayMessageStatusNotSupportedState,
+		DisplayMessageStatusUnknownTransaction:
+		return true
+	}
+	return false
+}
+
+type EnergyTransferModeEnumType string
+
+const (
+	EnergyTransferModeACSinglePhase EnergyTransferModeEnumType = "AC_single_phase"
+	EnergyTransferModeACTwoPhase    EnergyTransferModeEnumType = "AC_two_phase"
+	EnergyTransferModeACThreePhase  EnergyTransferModeEnumType = "AC_three_phase"
+	EnergyTransferModeDC            EnergyTransferModeEnumType = "DC"
+	EnergyTransferModeACBPT         EnergyTransferModeEnumType = "AC_BPT"
+	EnergyTransferModeACBPTDER      EnergyTransferModeEnumType = "AC_BPT_DER"
+	EnergyTransferModeACDER         EnergyTransferModeEnumType = "AC_DER"
+	EnergyTransferModeDCBPT         EnergyTransferModeEnumType = "DC_BPT"
+	EnergyTransferModeDCACDP        EnergyTransferModeEnumType = "DC_ACDP"
+	EnergyTransferModeDCACDPBPT     EnergyTransferModeEnumType = "DC_ACDP_BPT"
+	EnergyTransferModeWPT           EnergyTransferModeEnumType = "WPT"
+)
+
+// IsValid reports whether e is one of the EnergyTransferModeEnumType values
+func (e EnergyTransferModeEnumType) IsValid() bool {
+	switch e {
+	case EnergyTransferModeACSinglePhase,
+		EnergyTransferModeACTwoPhase,
+		EnergyTransferModeACThreePhase,
+		EnergyTransferModeDC,
+		EnergyTransferModeACBPT,
+		EnergyTransferModeACBPTDER,
+		EnergyTransferModeACDER,
+		EnergyTransferModeDCBPT,
+		EnergyTransferModeDCACDP,
+		EnergyTransferModeDCACDPBPT,
+		EnergyTransferModeWPT:
+		return true
+	}
+	return false
+}
+
+type EventNotificationEnumType string
+
+const (
+	EventNotificationHardWiredNotification EventNotificationEnumType = "HardWiredNotification"
+	EventNotificationHardWiredMonitor      EventNotificationEnumType = "HardWiredMonitor"
+	EventNotificationPreconfiguredMonitor  EventNotificationEnumType = "PreconfiguredMonitor"
+	EventNotificationCustomMonitor         EventNotificationEnumType = "CustomMonitor"
+)
+
+// IsValid reports whether e is one of the EventNotificationEnumType values
+func (e EventNotificationEnumType) IsValid() bool {
+	switch e {
+	case EventNotificationHardWiredNotification,
+		EventNotificationHardWiredMonitor,
+		EventNotificationPreconfiguredMonitor,
+		EventNotificationCustomMonitor:
+		return true
+	}
+	return false
+}
+
+type EventTriggerEnumType string
+
+const (
+	EventTriggerAlerting EventTriggerEnumType = "Alerting"
+	EventTriggerDelta    EventTriggerEnumType = "Delta"
+	EventTriggerPeriodic EventTriggerEnumType = "Periodic"
+)
+
+// IsValid reports whether e is one of the EventTriggerEnumType values
+func (e EventTriggerEnumType) IsValid() bool {
+	switch e {
+	case EventTriggerAlerting,
+		EventTriggerDelta,
+		EventTriggerPeriodic:
+		return true
+	}
+	return false
+}
+
+type EvseKindEnumType string
+
+const (
+	EvseKindAC EvseKindEnumType = "AC"
+	EvseKindDC EvseKindEnumType = "DC"
+)
+
+// IsValid reports whether e is one of the EvseKindEnumType values
+func (e EvseKindEnumType) IsValid() bool {
+	switch e {
+	case EvseKindAC,
+		EvseKindDC:
+		return true
+	}
+	return false
+}
+
+type FirmwareStatusEnumType string
+
+const (
+	FirmwareStatusDownloaded                FirmwareStatusEnumType = "Downloaded"
+	FirmwareStatusDownloadFailed            FirmwareStatusEnumType = "DownloadFailed"
+	FirmwareStatusDownloading               FirmwareStatusEnumType = "Downloading"
+	FirmwareStatusDownloadScheduled         FirmwareStatusEnumType = "DownloadScheduled"
+	FirmwareStatusDownloadPaused            FirmwareStatusEnumType = "DownloadPaused"
+	FirmwareStatusIdle                      FirmwareStatusEnumType = "Idle"
+	FirmwareStatusInstallationFailed        FirmwareStatusEnumType = "InstallationFailed"
+	FirmwareStatusInstalling                FirmwareStatusEnumType = "Installing"
+	FirmwareStatusInstalled                 FirmwareStatusEnumType = "Installed"
+	FirmwareStatusInstallRebooting          FirmwareStatusEnumType = "InstallRebooting"
+	FirmwareStatusInstallScheduled          FirmwareStatusEnumType = "InstallScheduled"
+	FirmwareStatusInstallVerificationFailed FirmwareStatusEnumType = "InstallVerificationFailed"
+	FirmwareStatusInvalidSignature          FirmwareStatusEnumType = "InvalidSignature"
+	FirmwareStatusSignatureVerified         FirmwareStatusEnumType = "SignatureVerified"
+)
+
+// IsValid reports whether e is one of the FirmwareStatusEnumType values
+func (e FirmwareStatusEnumType) IsValid() bool {
+	switch e {
+	case FirmwareStatusDownloaded,
+		FirmwareStatusDownloadFailed,
+		FirmwareStatusDownloading,
+		FirmwareStatusDownloadScheduled,
+		FirmwareStatusDownloadPaused,
+		FirmwareStatusIdle,
+		FirmwareStatusInstallationFailed,
+		FirmwareStatusInstalling,
+		FirmwareStatusInstalled,
+		FirmwareStatusInstallRebooting,
+		FirmwareStatusInstallScheduled,
+		FirmwareStatusInstallVerificationFailed,
+		FirmwareStatusInvalidSignature,
+		FirmwareStatusSignatureVerified:
+		return true
+	}
+	return false
+}
+
+type GenericDeviceModelStatusEnumType string
+
+const (
+	GenericDeviceModelStatusAccepted       GenericDeviceModelStatusEnumType = "Accepted"
+	GenericDeviceModelStatusRejected       GenericDeviceModelStatusEnumType = "Rejected"
+	GenericDeviceModelStatusNotSupported   GenericDeviceModelStatusEnumType = "NotSupported"
+	GenericDeviceModelStatusEmptyResultSet GenericDeviceModelStatusEnumType = "EmptyResultSet"
+)
+
+// IsValid reports whether e is one of the GenericDeviceModelStatusEnumType values
+func (e GenericDeviceModelStatusEnumType) IsValid() bool {
+	switch e {
+	case GenericDeviceModelStatusAccepted,
+		GenericDeviceModelStatusRejected,
+		GenericDeviceModelStatusNotSupported,
+		GenericDeviceModelStatusEmptyResultSet:
+		return true
+	}
+	return false
+}
+
+type GenericStatusEnumType string
+
+const (
+	GenericStatusAccepted GenericStatusEnumType = "Accepted"
+	GenericStatusRejected GenericStatusEnumType = "Rejected"
+)
+
+// IsValid reports whether e is one of the GenericStatusEnumType values
+func (e GenericStatusEnumType) IsValid() bool {
+	switch e {
+	case GenericStatusAccepted,
+		GenericStatusRejected:
+		return true
+	}
+	return false
+}
+
+type GetCertificateIdUseEnumType string
+
+const (
+	GetCertificateIdUseV2GRootCertificate          GetCertificateIdUseEnumType = "V2GRootCertificate"
+	GetCertificateIdUseMORootCertificate           GetCertificateIdUseEnumType = "MORootCertificate"
+	GetCertificateIdUseCSMSRootCertificate         GetCertificateIdUseEnumType = "CSMSRootCertificate"
+	GetCertificateIdUseV2GCertificateChain         GetCertificateIdUseEnumType = "V2GCertificateChain"
+	GetCertificateIdUseManufacturerRootCertificate GetCertificateIdUseEnumType = "ManufacturerRootCertificate"
+)
+
+// IsValid reports whether e is one of the GetCertificateIdUseEnumType values
+func (e GetCertificateIdUseEnumType) IsValid() bool {
+	switch e {
+	case GetCertificateIdUseV2GRootCertificate,
+		GetCertificateIdUseMORootCertificate,
+		GetCertificateIdUseCSMSRootCertificate,
+		GetCertificateIdUseV2GCertificateChain,
+		GetCertificateIdUseManufacturerRootCertificate:
+		return true
+	}
+	return false
+}
+
+type GetCertificateStatusEnumType string
+
+const (
+	GetCertificateStatusAccepted GetCertificateStatusEnumType = "Accepted"
+	GetCertificateStatusFailed   GetCertificateStatusEnumType = "Failed"
+)
+
+// IsValid reports whether e is one of the GetCertificateStatusEnumType values
+func (e GetCertificateStatusEnumType) IsValid() bool {
+	switch e {
+	case GetCertificateStatusAccepted,
+		GetCertificateStatusFailed:
+		return true
+	}
+	return false
+}
+
+type GetChargingProfileStatusEnumType string
+
+const (
+	GetChargingProfileStatusAccepted   GetChargingProfileStatusEnumType = "Accepted"
+	GetChargingProfileStatusNoProfiles GetChargingProfileStatusEnumType = "NoProfiles"
+)
+
+// IsValid reports whether e is one of the GetChargingProfileStatusEnumType values
+func (e GetChargingProfileStatusEnumType) IsValid() bool {
+	switch e {
+	case GetChargingProfileStatusAccepted,
+		GetChargingProfileStatusNoProfiles:
+		return true
+	}
+	return false
+}
+
+type GetDisplayMessagesStatusEnumType string
+
+const (
+	GetDisplayMessagesStatusAccepted GetDisplayMessagesStatusEnumType = "Accepted"
+	GetDisplayMessagesStatusUnknown  GetDisplayMessagesStatusEnumType = "Unknown"
+)
+
+// IsValid reports whether e is one of the GetDisplayMessagesStatusEnumType values
+func (e GetDisplayMessagesStatusEnumType) IsValid() bool {
+	switch e {
+	case GetDisplayMessagesStatusAccepted,
+		GetDisplayMessagesStatusUnknown:
+		return true
+	}
+	return false
+}
+
+type GetInstalledCertificateStatusEnumType string
+
+const (
+	GetInstalledCertificateStatusAccepted GetInstalledCertificateStatusEnumType = "Accepted"
+	GetInstalledCertificateStatusNotFound GetInstalledCertificateStatusEnumType = "NotFound"
+)
+
+// IsValid reports whether e is one of the GetInstalledCertificateStatusEnumType values
+func (e GetInstalledCertificateStatusEnumType) IsValid() bool {
+	switch e {
+	case GetInstalledCertificateStatusAccepted,
+		GetInstalledCertificateStatusNotFound:
+		return true
+	}
+	return false
+}
+
+type GetVariableStatusEnumType string
+
+const (
+	GetVariableStatusAccepted                  GetVariableStatusEnumType = "Accepted"
+	GetVariableStatusRejected                  GetVariableStatusEnumType = "Rejected"
+	GetVariableStatusUnknownComponent          GetVariableStatusEnumType = "UnknownComponent"
+	GetVariableStatusUnknownVariable           GetVariableStatusEnumType = "UnknownVariable"
+	GetVariableStatusNotSupportedAttributeType GetVariableStatusEnumType = "NotSupportedAttributeType"
+)
+
+// IsValid reports whether e is one of the GetVariableStatusEnumType values
+func (e GetVariableStatusEnumType) IsValid() bool {
+	switch e {
+	case GetVariableStatusAccepted,
+		GetVariableStatusRejected,
+		GetVariableStatusUnknownComponent,
+		GetVariableStatusUnknownVariable,
+		GetVariableStatusNotSupportedAttributeType:
+		return true
+	}
+	return false
+}
+
+type GridEventFaultEnumType string
+
+const (
+	GridEventFaultCurrentImbalance GridEventFaultEnumType = "CurrentImbalance"
+	GridEventFaultLocalEmergency   GridEventFaultEnumType = "LocalEmergency"
+	GridEventFaultLowInputPower    GridEventFaultEnumType = "LowInputPower"
+	GridEventFaultOverCurrent      GridEventFaultEnumType = "OverCurrent"
+	GridEventFaultOverFrequency    GridEventFaultEnumType = "OverFrequency"
+	GridEventFaultOverVoltage      GridEventFaultEnumType = "OverVoltage"
+	GridEventFaultPhaseRotation    GridEventFaultEnumType = "PhaseRotation"
+	GridEventFaultRemoteEmergency  GridEventFaultEnumType = "RemoteEmergency"
+	GridEventFaultUnderFrequency   GridEventFaultEnumType = "UnderFrequency"
+	GridEventFaultUnderVoltage     GridEventFaultEnumType = "UnderVoltage"
+	GridEventFaultVoltageImbalance GridEventFaultEnumType = "VoltageImbalance"
+)
+
+// IsValid reports whether e is one of the GridEventFaultEnumType values
+func (e GridEventFaultEnumType) IsValid() bool {
+	switch e {
+	case GridEventFaultCurrentImbalance,
+		GridEventFaultLocalEmergency,
+		GridEventFaultLowInputPower,
+		GridEventFaultOverCurrent,
+		GridEventFaultOverFrequency,
+		GridEventFaultOverVoltage,
+		GridEventFaultPhaseRotation,
+		GridEventFaultRemoteEmergency,
+		GridEventFaultUnderFrequency,
+		GridEventFaultUnderVoltage,
+		GridEventFaultVoltageImbalance:
+		return true
+	}
+	return false
+}
+
+type HashAlgorithmEnumType string
+
+const (
+	HashAlgorithmSHA256 HashAlgorithmEnumType = "SHA256"
+	HashAlgorithmSHA384 HashAlgorithmEnumType = "SHA384"
+	HashAlgorithmSHA512 HashAlgorithmEnumType = "SHA512"
+)
+
+// IsValid reports whether e is one of the HashAlgorithmEnumType values
+func (e HashAlgorithmEnumType) IsValid() bool {
+	switch e {
+	case HashAlgorithmSHA256,
+		HashAlgorithmSHA384,
+		HashAlgorithmSHA512:
+		return true
+	}
+	return false
+}
+
+type IdTokenEnumType string
+
+const (
+	IdTokenCentral         IdTokenEnumType = "Central"
+	IdTokenEMAID           IdTokenEnumType = "eMAID"
+	IdTokenISO14443        IdTokenEnumType = "ISO14443"
+	IdTokenISO15693        IdTokenEnumType = "ISO15693"
+	IdTokenKeyCode         IdTokenEnumType = "KeyCode"
+	IdTokenLocal           IdTokenEnumType = "Local"
+	IdTokenMacAddress      IdTokenEnumType = "MacAddress"
+	IdTokenNoAuthorization IdTokenEnumType = "NoAuthorization"
+)
+
+// IsValid reports whether e is one of the IdTokenEnumType values
+func (e IdTokenEnumType) IsValid() bool {
+	switch e {
+	case IdTokenCentral,
+		IdTokenEMAID,
+		IdTokenISO14443,
+		IdTokenISO15693,
+		IdTokenKeyCode,
+		IdTokenLocal,
+		IdTokenMacAddress,
+		IdTokenNoAuthorization:
+		return true
+	}
+	return false
+}
+
+type InstallCertificateStatusEnumType string
+
+const (
+	InstallCertificateStatusAccepted InstallCertificateStatusEnumType = "Accepted"
+	InstallCertificateStatusRejected InstallCertificateStatusEnumType = "Rejected"
+	InstallCertificateStatusFailed   InstallCertificateStatusEnumType = "Failed"
+)
+
+// IsValid reports whether e is one of the InstallCertificateStatusEnumType values
+func (e InstallCertificateStatusEnumType) IsValid() bool {
+	switch e {
+	case InstallCertificateStatusAccepted,
+		InstallCertificateStatusRejected,
+		InstallCertificateStatusFailed:
+		return true
+	}
+	return false
+}
+
+type InstallCertificateUseEnumType string
+
+const (
+	InstallCertificateUseV2GRootCertificate          InstallCertificateUseEnumType = "V2GRootCertificate"
+	InstallCertificateUseMORootCertificate           InstallCertificateUseEnumType = "MORootCertificate"
+	InstallCertificateUseCSMSRootCertificate         InstallCertificateUseEnumType = "CSMSRootCertificate"
+	InstallCertificateUseManufacturerRootCertificate InstallCertificateUseEnumType = "ManufacturerRootCertificate"
+)
+
+// IsValid reports whether e is one of the InstallCertificateUseEnumType values
+func (e InstallCertificateUseEnumType) IsValid() bool {
+	switch e {
+	case InstallCertificateUseV2GRootCertificate,
+		InstallCertificateUseMORootCertificate,
+		InstallCertificateUseCSMSRootCertificate,
+		InstallCertificateUseManufacturerRootCertificate:
+		return true
+	}
+	return false
+}
+
+type Iso15118EVCertificateStatusEnumType string
+
+const (
+	Iso15118EVCertificateStatusAccepted Iso15118EVCertificateStatusEnumType = "Accepted"
+	Iso15118EVCertificateStatusFailed   Iso15118EVCertificateStatusEnumType = "Failed"
+)
+
+// IsValid reports whether e is one of the Iso15118EVCertificateStatusEnumType values
+func (e Iso15118EVCertificateStatusEnumType) IsValid() bool {
+	switch e {
+	case Iso15118EVCertificateStatusAccepted,
+		Iso15118EVCertificateStatusFailed:
+		return true
+	}
+	return false
+}
+
+type LocationEnumType string
+
+const (
+	LocationBody   LocationEnumType = "Body"
+	LocationCable  LocationEnumType = "Cable"
+	LocationEV     LocationEnumType = "EV"
+	LocationInlet  LocationEnumType = "Inlet"
+	LocationOutlet LocationEnumType = "Outlet"
+)
+
+// IsValid reports whether e is one of the LocationEnumType values
+func (e LocationEnumType) IsValid() bool {
+	switch e {
+	case LocationBody,
+		LocationCable,
+		LocationEV,
+		LocationInlet,
+		LocationOutlet:
+		return true
+	}
+	return false
+}
+
+type LogEnumType string
+
+const (
+	LogDiagnosticsLog LogEnumType = "DiagnosticsLog"
+	LogSecurityLog    LogEnumType = "SecurityLog"
+)
+
+// IsValid reports whether e is one of the LogEnumType values
+func (e LogEnumType) IsValid() bool {
+	switch e {
+	case LogDiagnosticsLog,
+		LogSecurityLog:
+		return true
+	}
+	return false
+}
+
+type LogStatusEnumType string
+
+const (
+	LogStatusAccepted         LogStatusEnumType = "Accepted"
+	LogStatusRejected         LogStatusEnumType = "Rejected"
+	LogStatusAcceptedCanceled LogStatusEnumType = "AcceptedCanceled"
+)
+
+// IsValid reports whether e is one of the LogStatusEnumType values
+func (e LogStatusEnumType) IsValid() bool {
+	switch e {
+	case LogStatusAccepted,
+		LogStatusRejected,
+		LogStatusAcceptedCanceled:
+		return true
+	}
+	return false
+}
+
+type MeasurandEnumType string
+
+const (
+	MeasurandCurrentExport                MeasurandEnumType = "Current.Export"
+	MeasurandCurrentImport                MeasurandEnumType = "Current.Import"
+	MeasurandCurrentOffered               MeasurandEnumType = "Current.Offered"
+	MeasurandEnergyActiveExportRegister   MeasurandEnumType = "Energy.Active.Export.Register"
+	MeasurandEnergyActiveImportRegister   MeasurandEnumType = "Energy.Active.Import.Register"
+	MeasurandEnergyReactiveExportRegister MeasurandEnumType = "Energy.Reactive.Export.Register"
+	MeasurandEnergyReactiveImportRegister MeasurandEnumType = "Energy.Reactive.Import.Register"
+	MeasurandEnergyActiveExportInterval   MeasurandEnumType = "Energy.Active.Export.Interval"
+	MeasurandEnergyActiveImportInterval   MeasurandEnumType = "Energy.Active.Import.Interval"
+	MeasurandEnergyActiveNet              MeasurandEnumType = "Energy.Active.Net"
+	MeasurandEnergyReactiveExportInterval MeasurandEnumType = "Energy.Reactive.Export.Interval"
+	MeasurandEnergyReactiveImportInterval MeasurandEnumType = "Energy.Reactive.Import.Interval"
+	MeasurandEnergyReactiveNet            MeasurandEnumType = "Energy.Reactive.Net"
+	MeasurandEnergyApparentNet            MeasurandEnumType = "Energy.Apparent.Net"
+	MeasurandEnergyApparentImport         MeasurandEnumType = "Energy.Apparent.Import"
+	MeasurandEnergyApparentExport         MeasurandEnumType = "Energy.Apparent.Export"
+	MeasurandFrequency                    MeasurandEnumType = "Frequency"
+	MeasurandPowerActiveExport            MeasurandEnumType = "Power.Active.Export"
+	MeasurandPowerActiveImport            MeasurandEnumType = "Power.Active.Import"
+	MeasurandPowerFactor                  MeasurandEnumType = "Power.Factor"
+	MeasurandPowerOffered                 MeasurandEnumType = "Power.Offered"
+	MeasurandPowerReactiveExport          MeasurandEnumType = "Power.Reactive.Export"
+	MeasurandPowerReactiveImport          MeasurandEnumType = "Power.Reactive.Import"
+	MeasurandSoC                          MeasurandEnumType = "SoC"
+	MeasurandVoltage                      MeasurandEnumType = "Voltage"
+)
+
+// IsValid reports whether e is one of the MeasurandEnumType values
+func (e MeasurandEnumType) IsValid() bool {
+	switch e {
+	case MeasurandCurrentExport,
+		MeasurandCurrentImport,
+		MeasurandCurrentOffered,
+		MeasurandEnergyActiveExportRegister,
+		MeasurandEnergyActiveImportRegister,
+		MeasurandEnergyReactiveExportRegister,
+		MeasurandEnergyReactiveImportRegister,
+		MeasurandEnergyActiveExportInterval,
+		MeasurandEnergyActiveImportInterval,
+		MeasurandEnergyActiveNet,
+		MeasurandEnergyReactiveExportInterval,
+		MeasurandEnergyReactiveImportInterval,
+		MeasurandEnergyReactiveNet,
+		MeasurandEnergyApparentNet,
+		MeasurandEnergyApparentImport,
+		MeasurandEnergyApparentExport,
+		MeasurandFrequency,
+		MeasurandPowerActiveExport,
+		MeasurandPowerActiveImport,
+		MeasurandPowerFactor,
+		MeasurandPowerOffered,
+		MeasurandPowerReactiveExport,
+		MeasurandPowerReactiveImport,
+		MeasurandSoC,
+		MeasurandVoltage:
+		return true
+	}
+	return false
+}
+
+type MessageFormatEnumType string
+
+const (
+	MessageFormatASCII MessageFormatEnumType = "ASCII"
+	MessageFormatHTML  MessageFormatEnumType = "HTML"
+	MessageFormatURI   MessageFormatEnumType = "URI"
+	MessageFormatUTF8  MessageFormatEnumType = "UTF8"
+)
+
+// IsValid reports whether e is one of the MessageFormatEnumType values
+func (e MessageFormatEnumType) IsValid() bool {
+	switch e {
+	case MessageFormatASCII,
+		MessageFormatHTML,
+		MessageFormatURI,
+		MessageFormatUTF8:
+		return true
+	}
+	return false
+}
+
+type MessagePriorityEnumType string
+
+const (
+	MessagePriorityAlwaysFront MessagePriorityEnumType = "AlwaysFront"
+	MessagePriorityInFront     MessagePriorityEnumType = "InFront"
+	MessagePriorityNormalCycle MessagePriorityEnumType = "NormalCycle"
+)
+
+// IsValid reports whether e is one of the MessagePriorityEnumType values
+func (e MessagePriorityEnumType) IsValid() bool {
+	switch e {
+	case MessagePriorityAlwaysFront,
+		MessagePriorityInFront,
+		MessagePriorityNormalCycle:
+		return true
+	}
+	return false
+}
+
+type MessageStateEnumType string
+
+const (
+	MessageStateCharging    MessageStateEnumType = "Charging"
+	MessageStateFaulted     MessageStateEnumType = "Faulted"
+	MessageStateIdle        MessageStateEnumType = "Idle"
+	MessageStateUnavailable MessageStateEnumType = "Unavailable"
+)
+
+// IsValid reports whether e is one of the MessageStateEnumType values
+func (e MessageStateEnumType) IsValid() bool {
+	switch e {
+	case MessageStateCharging,
+		MessageStateFaulted,
+		MessageStateIdle,
+		MessageStateUnavailable:
+		return true
+	}
+	return false
+}
+
+type MessageTriggerEnumType string
+
+const (
+	MessageTriggerBootNotification                  MessageTriggerEnumType = "BootNotification"
+	MessageTriggerLogStatusNotification             MessageTriggerEnumType = "LogStatusNotification"
+	MessageTriggerFirmwareStatusNotification        MessageTriggerEnumType = "FirmwareStatusNotification"
+	MessageTriggerHeartbeat                         MessageTriggerEnumType = "Heartbeat"
+	MessageTriggerMeterValues                       MessageTriggerEnumType = "MeterValues"
+	MessageTriggerSignChargingStationCertificate    MessageTriggerEnumType = "SignChargingStationCertificate"
+	MessageTriggerSignV2GCertificate                MessageTriggerEnumType = "SignV2GCertificate"
+	MessageTriggerSignV2G20Certificate              MessageTriggerEnumType = "SignV2G20Certificate"
+	MessageTriggerStatusNotification                MessageTriggerEnumType = "StatusNotification"
+	MessageTriggerTransactionEvent                  MessageTriggerEnumType = "TransactionEvent"
+	MessageTriggerSignCombinedCertificate           MessageTriggerEnumType = "SignCombinedCertificate"
+	MessageTriggerPublishFirmwareStatusNotification MessageTriggerEnumType = "PublishFirmwareStatusNotification"
+	MessageTriggerCustomTrigger                     MessageTriggerEnumType = "CustomTrigger"
+)
+
+// IsValid reports whether e is one of the MessageTriggerEnumType values
+func (e MessageTriggerEnumType) IsValid() bool {
+	switch e {
+	case MessageTriggerBootNotification,
+		MessageTriggerLogStatusNotification,
+		MessageTriggerFirmwareStatusNotification,
+		MessageTriggerHeartbeat,
+		MessageTriggerMeterValues,
+		MessageTriggerSignChargingStationCertificate,
+		MessageTriggerSignV2GCertificate,
+		MessageTriggerSignV2G20Certificate,
+		MessageTriggerStatusNotification,
+		MessageTriggerTransactionEvent,
+		MessageTriggerSignCombinedCertificate,
+		MessageTriggerPublishFirmwareStatusNotification,
+		MessageTriggerCustomTrigger:
+		return true
+	}
+	return false
+}
+
+type MobilityNeedsModeEnumType string
+
+const (
+	MobilityNeedsModeEVCC     MobilityNeedsModeEnumType = "EVCC"
+	MobilityNeedsModeEVCCSECC MobilityNeedsModeEnumType = "EVCC_SECC"
+)
+
+// IsValid reports whether e is one of the MobilityNeedsModeEnumType values
+func (e MobilityNeedsModeEnumType) IsValid() bool {
+	switch e {
+	case MobilityNeedsModeEVCC,
+		MobilityNeedsModeEVCCSECC:
+		return true
+	}
+	return false
+}
+
+type MonitorEnumType string
+
+const (
+	MonitorUpperThreshold       MonitorEnumType = "UpperThreshold"
+	MonitorLowerThreshold       MonitorEnumType = "LowerThreshold"
+	MonitorDelta                MonitorEnumType = "Delta"
+	MonitorPeriodic             MonitorEnumType = "Periodic"
+	MonitorPeriodicClockAligned MonitorEnumType = "PeriodicClockAligned"
+)
+
+// IsValid reports whether e is one of the MonitorEnumType values
+func (e MonitorEnumType) IsValid() bool {
+	switch e {
+	case MonitorUpperThreshold,
+		MonitorLowerThreshold,
+		MonitorDelta,
+		MonitorPeriodic,
+		MonitorPeriodicClockAligned:
+		return true
+	}
+	return false
+}
+
+type MonitoringBaseEnumType string
+
+const (
+	MonitoringBaseAll            MonitoringBaseEnumType = "All"
+	MonitoringBaseFactoryDefault MonitoringBaseEnumType = "FactoryDefault"
+	MonitoringBaseHardWiredOnly  MonitoringBaseEnumType = "HardWiredOnly"
+)
+
+// IsValid reports whether e is one of the MonitoringBaseEnumType values
+func (e MonitoringBaseEnumType) IsValid() bool {
+	switch e {
+	case MonitoringBaseAll,
+		MonitoringBaseFactoryDefault,
+		MonitoringBaseHardWiredOnly:
+		return true
+	}
+	return false
+}
+
+type MonitoringCriterionEnumType string
+
+const (
+	MonitoringCriterionThresholdMonitoring MonitoringCriterionEnumType = "ThresholdMonitoring"
+	MonitoringCriterionDeltaMonitoring     MonitoringCriterionEnumType = "DeltaMonitoring"
+	MonitoringCriterionPeriodicMonitoring  MonitoringCriterionEnumType = "PeriodicMonitoring"
+)
+
+// IsValid reports whether e is one of the MonitoringCriterionEnumType values
+func (e MonitoringCriterionEnumType) IsValid() bool {
+	switch e {
+	case MonitoringCriterionThresholdMonitoring,
+		MonitoringCriterionDeltaMonitoring,
+		MonitoringCriterionPeriodicMonitoring:
+		return true
+	}
+	return false
+}
+
+type MutabilityEnumType string
+
+const (
+	MutabilityReadOnly  MutabilityEnumType = "ReadOnly"
+	MutabilityWriteOnly MutabilityEnumType = "WriteOnly"
+	MutabilityReadWrite MutabilityEnumType = "ReadWrite"
+)
+
+// IsValid reports whether e is one of the MutabilityEnumType values
+func (e MutabilityEnumType) IsValid() bool {
+	switch e {
+	case MutabilityReadOnly,
+		MutabilityWriteOnly,
+		MutabilityReadWrite:
+		return true
+	}
+	return false
+}
+
+type NotifyAllowedEnergyTransferStatusEnumType string
+
+const (
+	NotifyAllowedEnergyTransferStatusAccepted NotifyAllowedEnergyTransferStatusEnumType = "Accepted"
+	NotifyAllowedEnergyTransferStatusRejected NotifyAllowedEnergyTransferStatusEnumType = "Rejected"
+)
+
+// IsValid reports whether e is one of the NotifyAllowedEnergyTransferStatusEnumType values
+func (e NotifyAllowedEnergyTransferStatusEnumType) IsValid() bool {
+	switch e {
+	case NotifyAllowedEnergyTransferStatusAccepted,
+		NotifyAllowedEnergyTransferStatusRejected:
+		return true
+	}
+	return false
+}
+
+type NotifyEVChargingNeedsStatusEnumType string
+
+const (
+	NotifyEVChargingNeedsStatusAccepted   NotifyEVChargingNeedsStatusEnumType = "Accepted"
+	NotifyEVChargingNeedsStatusRejected   NotifyEVChargingNeedsStatusEnumType = "Rejected"
+	NotifyEVChargingNeedsStatusProcessing NotifyEVChargingNeedsStatusEnumType = "Processing"
+)
+
+// IsValid reports whether e is one of the NotifyEVChargingNeedsStatusEnumType values
+func (e NotifyEVChargingNeedsStatusEnumType) IsValid() bool {
+	switch e {
+	case NotifyEVChargingNeedsStatusAccepted,
+		NotifyEVChargingNeedsStatusRejected,
+		NotifyEVChargingNeedsStatusProcessing:
+		return true
+	}
+	return false
+}
+
+type OCPPInterfaceEnumType string
+
+const (
+	OCPPInterfaceWired0    OCPPInterfaceEnumType = "Wired0"
+	OCPPInterfaceWired1    OCPPInterfaceEnumType = "Wired1"
+	OCPPInterfaceWired2    OCPPInterfaceEnumType = "Wired2"
+	OCPPInterfaceWired3    OCPPInterfaceEnumType = "Wired3"
+	OCPPInterfaceWireless0 OCPPInterfaceEnumType = "Wireless0"
+	OCPPInterfaceWireless1 OCPPInterfaceEnumType = "Wireless1"
+	OCPPInterfaceWireless2 OCPPInterfaceEnumType = "Wireless2"
+	OCPPInterfaceWireless3 OCPPInterfaceEnumType = "Wireless3"
+)
+
+// IsValid reports whether e is one of the OCPPInterfaceEnumType values
+func (e OCPPInterfaceEnumType) IsValid() bool {
+	switch e {
+	case OCPPInterfaceWired0,
+		OCPPInterfaceWired1,
+		OCPPInterfaceWired2,
+		OCPPInterfaceWired3,
+		OCPPInterfaceWireless0,
+		OCPPInterfaceWireless1,
+		OCPPInterfaceWireless2,
+		OCPPInterfaceWireless3:
+		return true
+	}
+	return false
+}
+
+type OCPPTransportEnumType string
+
+const (
+	OCPPTransportJSON OCPPTransportEnumType = "JSON"
+	OCPPTransportSOAP OCPPTransportEnumType = "SOAP"
+)
+
+// IsValid reports whether e is one of the OCPPTransportEnumType values
+func (e OCPPTransportEnumType) IsValid() bool {
+	switch e {
+	case OCPPTransportJSON,
+		OCPPTransportSOAP:
+		return true
+	}
+	return false
+}
+
+type OCPPVersionEnumType string
+
+const (
+	OCPPVersionOCPP12  OCPPVersionEnumType = "OCPP12"
+	OCPPVersionOCPP15  OCPPVersionEnumType = "OCPP15"
+	OCPPVersionOCPP16  OCPPVersionEnumType = "OCPP16"
+	OCPPVersionOCPP20  OCPPVersionEnumType = "OCPP20"
+	OCPPVersionOCPP201 OCPPVersionEnumType = "OCPP201"
+	OCPPVersionOCPP21  OCPPVersionEnumType = "OCPP21"
+)
+
+// IsValid reports whether e is one of the OCPPVersionEnumType values
+func (e OCPPVersionEnumType) IsValid() bool {
+	switch e {
+	case OCPPVersionOCPP12,
+		OCPPVersionOCPP15,
+		OCPPVersionOCPP16,
+		OCPPVersionOCPP20,
+		OCPPVersionOCPP201,
+		OCPPVersionOCPP21:
+		return true
+	}
+	return false
+}
+
+type OperationModeEnumType string
+
+const (
+	OperationModeIdle               OperationModeEnumType = "Idle"
+	OperationModeChargingOnly       OperationModeEnumType = "ChargingOnly"
+	OperationModeCentralSetpoint    OperationModeEnumType = "CentralSetpoint"
+	OperationModeExternalSetpoint   OperationModeEnumType = "ExternalSetpoint"
+	OperationModeExternalLimits     OperationModeEnumType = "ExternalLimits"
+	OperationModeCentralFrequency   OperationModeEnumType = "CentralFrequency"
+	OperationModeLocalFrequency     OperationModeEnumType = "LocalFrequency"
+	OperationModeLocalLoadBalancing OperationModeEnumType = "LocalLoadBalancing"
+)
+
+// IsValid reports whether e is one of the OperationModeEnumType values
+func (e OperationModeEnumType) IsValid() bool {
+	switch e {
+	case OperationModeIdle,
+		OperationModeChargingOnly,
+		OperationModeCentralSetpoint,
+		OperationModeExternalSetpoint,
+		OperationModeExternalLimits,
+		OperationModeCentralFrequency,
+		OperationModeLocalFrequency,
+		OperationModeLocalLoadBalancing:
+		return true
+	}
+	return false
+}
+
+type OperationalStatusEnumType string
+
+const (
+	OperationalStatusInoperative OperationalStatusEnumType = "Inoperative"
+	OperationalStatusOperative   OperationalStatusEnumType = "Operative"
+)
+
+// IsValid reports whether e is one of the OperationalStatusEnumType values
+func (e OperationalStatusEnumType) IsValid() bool {
+	switch e {
+	case OperationalStatusInoperative,
+		OperationalStatusOperative:
+		return true
+	}
+	return false
+}
+
+type PaymentStatusEnumType string
+
+const (
+	PaymentStatusSettled  PaymentStatusEnumType = "Settled"
+	PaymentStatusCanceled PaymentStatusEnumType = "Canceled"
+	PaymentStatusRejected PaymentStatusEnumType = "Rejected"
+	PaymentStatusFailed   PaymentStatusEnumType = "Failed"
+)
+
+// IsValid reports whether e is one of the PaymentStatusEnumType values
+func (e PaymentStatusEnumType) IsValid() bool {
+	switch e {
+	case PaymentStatusSettled,
+		PaymentStatusCanceled,
+		PaymentStatusRejected,
+		PaymentStatusFailed:
+		return true
+	}
+	return false
+}
+
+type PhaseEnumType string
+
+const (
+	PhaseL1   PhaseEnumType = "L1"
+	PhaseL2   PhaseEnumType = "L2"
+	PhaseL3   PhaseEnumType = "L3"
+	PhaseN    PhaseEnumType = "N"
+	PhaseL1N  PhaseEnumType = "L1-N"
+	PhaseL2N  PhaseEnumType = "L2-N"
+	PhaseL3N  PhaseEnumType = "L3-N"
+	PhaseL1L2 PhaseEnumType = "L1-L2"
+	PhaseL2L3 PhaseEnumType = "L2-L3"
+	PhaseL3L1 PhaseEnumType = "L3-L1"
+)
+
+// IsValid reports whether e is one of the PhaseEnumType values
+func (e PhaseEnumType) IsValid() bool {
+	switch e {
+	case PhaseL1,
+		PhaseL2,
+		PhaseL3,
+		PhaseN,
+		PhaseL1N,
+		PhaseL2N,
+		PhaseL3N,
+		PhaseL1L2,
+		PhaseL2L3,
+		PhaseL3L1:
+		return true
+	}
+	return false
+}
+
+type PowerDuringCessationEnumType string
+
+const (
+	PowerDuringCessationActive   PowerDuringCessationEnumType = "Active"
+	PowerDuringCessationReactive PowerDuringCessationEnumType = "Reactive"
+)
+
+// IsValid reports whether e is one of the PowerDuringCessationEnumType values
+func (e PowerDuringCessationEnumType) IsValid() bool {
+	switch e {
+	case PowerDuringCessationActive,
+		PowerDuringCessationReactive:
+		return true
+	}
+	return false
+}
+
+type PriorityChargingStatusEnumType string
+
+const (
+	PriorityChargingStatusAccepted  PriorityChargingStatusEnumType = "Accepted"
+	PriorityChargingStatusRejected  PriorityChargingStatusEnumType = "Rejected"
+	PriorityChargingStatusNoProfile PriorityChargingStatusEnumType = "NoProfile"
+)
+
+// IsValid reports whether e is one of the PriorityChargingStatusEnumType values
+func (e PriorityChargingStatusEnumType) IsValid() bool {
+	switch e {
+	case PriorityChargingStatusAccepted,
+		PriorityChargingStatusRejected,
+		PriorityChargingStatusNoProfile:
+		return true
+	}
+	return false
+}
+
+type PublishFirmwareStatusEnumType string
+
+const (
+	PublishFirmwareStatusIdle              PublishFirmwareStatusEnumType = "Idle"
+	PublishFirmwareStatusDownloadScheduled PublishFirmwareStatusEnumType = "DownloadScheduled"
+	PublishFirmwareStatusDownloading       PublishFirmwareStatusEnumType = "Downloading"
+	PublishFirmwareStatusDownloaded        PublishFirmwareStatusEnumType = "Downloaded"
+	PublishFirmwareStatusPublished         PublishFirmwareStatusEnumType = "Published"
+	PublishFirmwareStatusDownloadFailed    PublishFirmwareStatusEnumType = "DownloadFailed"
+	PublishFirmwareStatusDownloadPaused    PublishFirmwareStatusEnumType = "DownloadPaused"
+	PublishFirmwareStatusInvalidChecksum   PublishFirmwareStatusEnumType = "InvalidChecksum"
+	PublishFirmwareStatusChecksumVerified  PublishFirmwareStatusEnumType = "ChecksumVerified"
+	PublishFirmwareStatusPublishFailed     PublishFirmwareStatusEnumType = "PublishFailed"
+)
+
+// IsValid reports whether e is one of the PublishFirmwareStatusEnumType values
+func (e PublishFirmwareStatusEnumType) IsValid() bool {
+	switch e {
+	case PublishFirmwareStatusIdle,
+		PublishFirmwareStatusDownloadScheduled,
+		PublishFirmwareStatusDownloading,
+		PublishFirmwareStatusDownloaded,
+		PublishFirmwareStatusPublished,
+		PublishFirmwareStatusDownloadFailed,
+		PublishFirmwareStatusDownloadPaused,
+		PublishFirmwareStatusInvalidChecksum,
+		PublishFirmwareStatusChecksumVerified,
+		PublishFirmwareStatusPublishFailed:
+		return true
+	}
+	return false
+}
+
+type ReadingContextEnumType string
+
+const (
+	ReadingContextInterruptionBegin ReadingContextEnumType = "Interruption.Begin"
+	ReadingContextInterruptionEnd   ReadingContextEnumType = "Interruption.End"
+	ReadingContextOther             ReadingContextEnumType = "Other"
+	ReadingContextSampleClock       ReadingContextEnumType = "Sample.Clock"
+	ReadingContextSamplePeriodic    ReadingContextEnumType = "Sample.Periodic"
+	ReadingContextTransactionBegin  ReadingContextEnumType = "Transaction.Begin"
+	ReadingContextTransactionEnd    ReadingContextEnumType = "Transaction.End"
+	ReadingContextTrigger           ReadingContextEnumType = "Trigger"
+)
+
+// IsValid reports whether e is one of the ReadingContextEnumType values
+func (e ReadingContextEnumType) IsValid() bool {
+	switch e {
+	case ReadingContextInterruptionBegin,
+		ReadingContextInterruptionEnd,
+		ReadingContextOther,
+		ReadingContextSampleClock,
+		ReadingContextSamplePeriodic,
+		ReadingContextTransactionBegin,
+		ReadingContextTransactionEnd,
+		ReadingContextTrigger:
+		return true
+	}
+	return false
+}
+
+type ReasonEnumType string
+
+const (
+	ReasonDeAuthorized       ReasonEnumType = "DeAuthorized"
+	ReasonEmergencyStop      ReasonEnumType = "EmergencyStop"
+	ReasonEnergyLimitReached ReasonEnumType = "EnergyLimitReached"
+	ReasonEVDisconnected     ReasonEnumType = "EVDisconnected"
+	ReasonGroundFault        ReasonEnumType = "GroundFault"
+	ReasonImmediateReset     ReasonEnumType = "ImmediateReset"
+	ReasonLocal              ReasonEnumType = "Local"
+	ReasonLocalOutOfCredit   ReasonEnumType = "LocalOutOfCredit"
+	ReasonMasterPass         ReasonEnumType = "MasterPass"
+	ReasonOther              ReasonEnumType = "Other"
+	ReasonOvercurrentFault   ReasonEnumType = "OvercurrentFault"
+	ReasonPowerLoss          ReasonEnumType = "PowerLoss"
+	ReasonPowerQuality       ReasonEnumType = "PowerQuality"
+	ReasonReboot             ReasonEnumType = "Reboot"
+	ReasonRemote             ReasonEnumType = "Remote"
+	ReasonSOCLimitReached    ReasonEnumType = "SOCLimitReached"
+	ReasonStoppedByEV        ReasonEnumType = "StoppedByEV"
+	ReasonTimeLimitReached   ReasonEnumType = "TimeLimitReached"
+	ReasonTimeout            ReasonEnumType = "Timeout"
+)
+
+// IsValid reports whether e is one of the ReasonEnumType values
+func (e ReasonEnumType) IsValid() bool {
+	switch e {
+	case ReasonDeAuthorized,
+		ReasonEmergencyStop,
+		ReasonEnergyLimitReached,
+		ReasonEVDisconnected,
+		ReasonGroundFault,
+		ReasonImmediateReset,
+		ReasonLocal,
+		ReasonLocalOutOfCredit,
+		ReasonMasterPass,
+		ReasonOther,
+		ReasonOvercurrentFault,
+		ReasonPowerLoss,
+		ReasonPowerQuality,
+		ReasonReboot,
+		ReasonRemote,
+		ReasonSOCLimitReached,
+		ReasonStoppedByEV,
+		ReasonTimeLimitReached,
+		ReasonTimeout:
+		return true
+	}
+	return false
+}
+
+type RecurrencyKindEnumType string
+
+const (
+	RecurrencyKindDaily  RecurrencyKindEnumType = "Daily"
+	RecurrencyKindWeekly RecurrencyKindEnumType = "Weekly"
+)
+
+// IsValid reports whether e is one of the RecurrencyKindEnumType values
+func (e RecurrencyKindEnumType) IsValid() bool {
+	switch e {
+	case RecurrencyKindDaily,
+		RecurrencyKindWeekly:
+		return true
+	}
+	return false
+}
+
+type RegistrationStatusEnumType string
+
+const (
+	RegistrationStatusAccepted RegistrationStatusEnumType = "Accepted"
+	RegistrationStatusPending  RegistrationStatusEnumType = "Pending"
+	RegistrationStatusRejected RegistrationStatusEnumType = "Rejected"
+)
+
+// IsValid reports whether e is one of the RegistrationStatusEnumType values
+func (e RegistrationStatusEnumType) IsValid() bool {
+	switch e {
+	case RegistrationStatusAccepted,
+		RegistrationStatusPending,
+		RegistrationStatusRejected:
+		return true
+	}
+	return false
+}
+
+type ReportBaseEnumType string
+
+const (
+	ReportBaseConfigurationInventory ReportBaseEnumType = "ConfigurationInventory"
+	ReportBaseFullInventory          ReportBaseEnumType = "FullInventory"
+	ReportBaseSummaryInventory       ReportBaseEnumType = "SummaryInventory"
+)
+
+// IsValid reports whether e is one of the ReportBaseEnumType values
+func (e ReportBaseEnumType) IsValid() bool {
+	switch e {
+	case ReportBaseConfigurationInventory,
+		ReportBaseFullInventory,
+		ReportBaseSummaryInventory:
+		return true
+	}
+	return false
+}
+
+type RequestStartStopStatusEnumType string
+
+const (
+	RequestStartStopStatusAccepted RequestStartStopStatusEnumType = "Accepted"
+	RequestStartStopStatusRejected RequestStartStopStatusEnumType = "Rejected"
+)
+
+// IsValid reports whether e is one of the RequestStartStopStatusEnumType values
+func (e RequestStartStopStatusEnumType) IsValid() bool {
+	switch e {
+	case RequestStartStopStatusAccepted,
+		RequestStartStopStatusRejected:
+		return true
+	}
+	return false
+}
+
+type ReservationUpdateStatusEnumType string
+
+const (
+	ReservationUpdateStatusExpired ReservationUpdateStatusEnumType = "Expired"
+	ReservationUpdateStatusRemoved ReservationUpdateStatusEnumType = "Removed"
+)
+
+// IsValid reports whether e is one of the ReservationUpdateStatusEnumType values
+func (e ReservationUpdateStatusEnumType) IsValid() bool {
+	switch e {
+	case ReservationUpdateStatusExpired,
+		ReservationUpdateStatusRemoved:
+		return true
+	}
+	return false
+}
+
+type ReserveNowStatusEnumType string
+
+const (
+	ReserveNowStatusAccepted    ReserveNowStatusEnumType = "Accepted"
+	ReserveNowStatusFaulted     ReserveNowStatusEnumType = "Faulted"
+	ReserveNowStatusOccupied    ReserveNowStatusEnumType = "Occupied"
+	ReserveNowStatusRejected    ReserveNowStatusEnumType = "Rejected"
+	ReserveNowStatusUnavailable ReserveNowStatusEnumType = "Unavailable"
+)
+
+// IsValid reports whether e is one of the ReserveNowStatusEnumType values
+func (e ReserveNowStatusEnumType) IsValid() bool {
+	switch e {
+	case ReserveNowStatusAccepted,
+		ReserveNowStatusFaulted,
+		ReserveNowStatusOccupied,
+		ReserveNowStatusRejected,
+		ReserveNowStatusUnavailable:
+		return true
+	}
+	return false
+}
+
+type ResetEnumType string
+
+const (
+	ResetImmediate ResetEnumType = "Immediate"
+	ResetOnIdle    ResetEnumType = "OnIdle"
+)
+
+// IsValid reports whether e is one of the ResetEnumType values
+func (e ResetEnumType) IsValid() bool {
+	switch e {
+	case ResetImmediate,
+		ResetOnIdle:
+		return true
+	}
+	return false
+}
+
+type ResetStatusEnumType string
+
+const (
+	ResetStatusAccepted  ResetStatusEnumType = "Accepted"
+	ResetStatusRejected  ResetStatusEnumType = "Rejected"
+	ResetStatusScheduled ResetStatusEnumType = "Scheduled"
+)
+
+// IsValid reports whether e is one of the ResetStatusEnumType values
+func (e ResetStatusEnumType) IsValid() bool {
+	switch e {
+	case ResetStatusAccepted,
+		ResetStatusRejected,
+		ResetStatusScheduled:
+		return true
+	}
+	return false
+}
+
+type SendLocalListStatusEnumType string
+
+const (
+	SendLocalListStatusAccepted        SendLocalListStatusEnumType = "Accepted"
+	SendLocalListStatusFailed          SendLocalListStatusEnumType = "Failed"
+	SendLocalListStatusVersionMismatch SendLocalListStatusEnumType = "VersionMismatch"
+)
+
+// IsValid reports whether e is one of the SendLocalListStatusEnumType values
+func (e SendLocalListStatusEnumType) IsValid() bool {
+	switch e {
+	case SendLocalListStatusAccepted,
+		SendLocalListStatusFailed,
+		SendLocalListStatusVersionMismatch:
+		return true
+	}
+	return false
+}
+
+type SetMonitoringStatusEnumType string
+
+const (
+	SetMonitoringStatusAccepted               SetMonitoringStatusEnumType = "Accepted"
+	SetMonitoringStatusUnknownComponent       SetMonitoringStatusEnumType = "UnknownComponent"
+	SetMonitoringStatusUnknownVariable        SetMonitoringStatusEnumType = "UnknownVariable"
+	SetMonitoringStatusUnsupportedMonitorType SetMonitoringStatusEnumType = "UnsupportedMonitorType"
+	SetMonitoringStatusRejected               SetMonitoringStatusEnumType = "Rejected"
+	SetMonitoringStatusDuplicate              SetMonitoringStatusEnumType = "Duplicate"
+)
+
+// IsValid reports whether e is one of the SetMonitoringStatusEnumType values
+func (e SetMonitoringStatusEnumType) IsValid() bool {
+	switch e {
+	case SetMonitoringStatusAccepted,
+		SetMonitoringStatusUnknownComponent,
+		SetMonitoringStatusUnknownVariable,
+		SetMonitoringStatusUnsupportedMonitorType,
+		SetMonitoringStatusRejected,
+		SetMonitoringStatusDuplicate:
+		return true
+	}
+	return false
+}
+
+type SetNetworkProfileStatusEnumType string
+
+const (
+	SetNetworkProfileStatusAccepted SetNetworkProfileStatusEnumType = "Accepted"
+	SetNetworkProfileStatusRejected SetNetworkProfileStatusEnumType = "Rejected"
+	SetNetworkProfileStatusFailed   SetNetworkProfileStatusEnumType = "Failed"
+)
+
+// IsValid reports whether e is one of the SetNetworkProfileStatusEnumType values
+func (e SetNetworkProfileStatusEnumType) IsValid() bool {
+	switch e {
+	case SetNetworkProfileStatusAccepted,
+		SetNetworkProfileStatusRejected,
+		SetNetworkProfileStatusFailed:
+		return true
+	}
+	return false
+}
+
+type SetVariableStatusEnumType string
+
+const (
+	SetVariableStatusAccepted                  SetVariableStatusEnumType = "Accepted"
+	SetVariableStatusRejected                  SetVariableStatusEnumType = "Rejected"
+	SetVariableStatusUnknownComponent          SetVariableStatusEnumType = "UnknownComponent"
+	SetVariableStatusUnknownVariable           SetVariableStatusEnumType = "UnknownVariable"
+	SetVariableStatusNotSupportedAttributeType SetVariableStatusEnumType = "NotSupportedAttributeType"
+	SetVariableStatusRebootRequired            SetVariableStatusEnumType = "RebootRequired"
+)
+
+// IsValid reports whether e is one of the SetVariableStatusEnumType values
+func (e SetVariableStatusEnumType) IsValid() bool {
+	switch e {
+	case SetVariableStatusAccepted,
+		SetVariableStatusRejected,
+		SetVariableStatusUnknownComponent,
+		SetVariableStatusUnknownVariable,
+		SetVariableStatusNotSupportedAttributeType,
+		SetVariableStatusRebootRequired:
+		return true
+	}
+	return false
+}
+
+type TariffChangeStatusEnumType string
+
+const (
+	TariffChangeStatusAccepted              TariffChangeStatusEnumType = "Accepted"
+	TariffChangeStatusRejected              TariffChangeStatusEnumType = "Rejected"
+	TariffChangeStatusTooManyElements       TariffChangeStatusEnumType = "TooManyElements"
+	TariffChangeStatusConditionNotSupported TariffChangeStatusEnumType = "ConditionNotSupported"
+	TariffChangeStatusTxNotFound            TariffChangeStatusEnumType = "TxNotFound"
+	TariffChangeStatusNoCurrencyChange      TariffChangeStatusEnumType = "NoCurrencyChange"
+)
+
+// IsValid reports whether e is one of the TariffChangeStatusEnumType values
+func (e TariffChangeStatusEnumType) IsValid() bool {
+	switch e {
+	case TariffChangeStatusAccepted,
+		TariffChangeStatusRejected,
+		TariffChangeStatusTooManyElements,
+		TariffChangeStatusConditionNotSupported,
+		TariffChangeStatusTxNotFound,
+		TariffChangeStatusNoCurrencyChange:
+		return true
+	}
+	return false
+}
+
+type TariffClearStatusEnumType string
+
+const (
+	TariffClearStatusAccepted TariffClearStatusEnumType = "Accepted"
+	TariffClearStatusRejected TariffClearStatusEnumType = "Rejected"
+	TariffClearStatusNoTariff TariffClearStatusEnumType = "NoTariff"
+)
+
+// IsValid reports whether e is one of the TariffClearStatusEnumType values
+func (e TariffClearStatusEnumType) IsValid() bool {
+	switch e {
+	case TariffClearStatusAccepted,
+		TariffClearStatusRejected,
+		TariffClearStatusNoTariff:
+		return true
+	}
+	return false
+}
+
+type TariffGetStatusEnumType string
+
+const (
+	TariffGetStatusAccepted TariffGetStatusEnumType = "Accepted"
+	TariffGetStatusRejected TariffGetStatusEnumType = "Rejected"
+	TariffGetStatusNoTariff TariffGetStatusEnumType = "NoTariff"
+)
+
+// IsValid reports whether e is one of the TariffGetStatusEnumType values
+func (e TariffGetStatusEnumType) IsValid() bool {
+	switch e {
+	case TariffGetStatusAccepted,
+		TariffGetStatusRejected,
+		TariffGetStatusNoTariff:
+		return true
+	}
+	return false
+}
+
+type TariffKindEnumType string
+
+const (
+	TariffKindDefaultTariff TariffKindEnumType = "DefaultTariff"
+	TariffKindDriverTariff  TariffKindEnumType = "DriverTariff"
+)
+
+// IsValid reports whether e is one of the TariffKindEnumType values
+func (e TariffKindEnumType) IsValid() bool {
+	switch e {
+	case TariffKindDefaultTariff,
+		TariffKindDriverTariff:
+		return true
+	}
+	return false
+}
+
+type TariffSetStatusEnumType string
+
+const (
+	TariffSetStatusAccepted              TariffSetStatusEnumType = "Accepted"
+	TariffSetStatusRejected              TariffSetStatusEnumType = "Rejected"
+	TariffSetStatusTooManyElements       TariffSetStatusEnumType = "TooManyElements"
+	TariffSetStatusConditionNotSupported TariffSetStatusEnumType = "ConditionNotSupported"
+	TariffSetStatusDuplicateTariffId     TariffSetStatusEnumType = "DuplicateTariffId"
+)
+
+// IsValid reports whether e is one of the TariffSetStatusEnumType values
+func (e TariffSetStatusEnumType) IsValid() bool {
+	switch e {
+	case TariffSetStatusAccepted,
+		TariffSetStatusRejected,
+		TariffSetStatusTooManyElements,
+		TariffSetStatusConditionNotSupported,
+		TariffSetStatusDuplicateTariffId:
+		return true
+	}
+	return false
+}
+
+type TransactionEventEnumType string
+
+const (
+	TransactionEventEnded   TransactionEventEnumType = "Ended"
+	TransactionEventStarted TransactionEventEnumType = "Started"
+	TransactionEventUpdated TransactionEventEnumType = "Updated"
+)
+
+// IsValid reports whether e is one of the TransactionEventEnumType values
+func (e TransactionEventEnumType) IsValid() bool {
+	switch e {
+	case TransactionEventEnded,
+		TransactionEventStarted,
+		TransactionEventUpdated:
+		return true
+	}
+	return false
+}
+
+type TriggerMessageStatusEnumType string
+
+const (
+	TriggerMessageStatusAccepted       TriggerMessageStatusEnumType = "Accepted"
+	TriggerMessageStatusRejected       TriggerMessageStatusEnumType = "Rejected"
+	TriggerMessageStatusNotImplemented TriggerMessageStatusEnumType = "NotImplemented"
+)
+
+// IsValid reports whether e is one of the TriggerMessageStatusEnumType values
+func (e TriggerMessageStatusEnumType) IsValid() bool {
+	switch e {
+	case TriggerMessageStatusAccepted,
+		TriggerMessageStatusRejected,
+		TriggerMessageStatusNotImplemented:
+		return true
+	}
+	return false
+}
+
+type TriggerReasonEnumType string
+
+const (
+	TriggerReasonAuthorized           TriggerReasonEnumType = "Authorized"
+	TriggerReasonCablePluggedIn       TriggerReasonEnumType = "CablePluggedIn"
+	TriggerReasonChargingRateChanged  TriggerReasonEnumType = "ChargingRateChanged"
+	TriggerReasonChargingStateChanged TriggerReasonEnumType = "ChargingStateChanged"
+	TriggerReasonDeauthorized         TriggerReasonEnumType = "Deauthorized"
+	TriggerReasonEnergyLimitReached   TriggerReasonEnumType = "EnergyLimitReached"
+	TriggerReasonEVCommunicationLost  TriggerReasonEnumType = "EVCommunicationLost"
+	TriggerReasonEVConnectTimeout     TriggerReasonEnumType = "EVConnectTimeout"
+	TriggerReasonMeterValueClock      TriggerReasonEnumType = "MeterValueClock"
+	TriggerReasonMeterValuePeriodic   TriggerReasonEnumType = "MeterValuePeriodic"
+	TriggerReasonTimeLimitReached     TriggerReasonEnumType = "TimeLimitReached"
+	TriggerReasonTrigger              TriggerReasonEnumType = "Trigger"
+	TriggerReasonUnlockCommand        TriggerReasonEnumType = "UnlockCommand"
+	TriggerReasonStopAuthorized       TriggerReasonEnumType = "StopAuthorized"
+	TriggerReasonEVDeparted           TriggerReasonEnumType = "EVDeparted"
+	TriggerReasonEVDetected           TriggerReasonEnumType = "EVDetected"
+	TriggerReasonRemoteStop           TriggerReasonEnumType = "RemoteStop"
+	TriggerReasonRemoteStart          TriggerReasonEnumType = "RemoteStart"
+	TriggerReasonAbnormalCondition    TriggerReasonEnumType = "AbnormalCondition"
+	TriggerReasonSignedDataReceived   TriggerReasonEnumType = "SignedDataReceived"
+	TriggerReasonResetCommand         TriggerReasonEnumType = "ResetCommand"
+)
+
+// IsValid reports whether e is one of the TriggerReasonEnumType values
+func (e TriggerReasonEnumType) IsValid() bool {
+	switch e {
+	case TriggerReasonAuthorized,
+		TriggerReasonCablePluggedIn,
+		TriggerReasonChargingRateChanged,
+		TriggerReasonChargingStateChanged,
+		TriggerReasonDeauthorized,
+		TriggerReasonEnergyLimitReached,
+		TriggerReasonEVCommunicationLost,
+		TriggerReasonEVConnectTimeout,
+		TriggerReasonMeterValueClock,
+		TriggerReasonMeterValuePeriodic,
+		TriggerReasonTimeLimitReached,
+		TriggerReasonTrigger,
+		TriggerReasonUnlockCommand,
+		TriggerReasonStopAuthorized,
+		TriggerReasonEVDeparted,
+		TriggerReasonEVDetected,
+		TriggerReasonRemoteStop,
+		TriggerReasonRemoteStart,
+		TriggerReasonAbnormalCondition,
+		TriggerReasonSignedDataReceived,
+		TriggerReasonResetCommand:
+		return true
+	}
+	return false
+}
+
+type UnlockStatusEnumType string
+
+const (
+	UnlockStatusUnlocked                     UnlockStatusEnumType = "Unlocked"
+	UnlockStatusUnlockFailed                 UnlockStatusEnumType = "UnlockFailed"
+	UnlockStatusOngoingAuthorizedTransaction UnlockStatusEnumType = "OngoingAuthorizedTransaction"
+	UnlockStatusUnknownConnector             UnlockStatusEnumType = "UnknownConnector"
+)
+
+// IsValid reports whether e is one of the UnlockStatusEnumType values
+func (e UnlockStatusEnumType) IsValid() bool {
+	switch e {
+	case UnlockStatusUnlocked,
+		UnlockStatusUnlockFailed,
+		UnlockStatusOngoingAuthorizedTransaction,
+		UnlockStatusUnknownConnector:
+		return true
+	}
+	return false
+}
+
+type UnpublishFirmwareStatusEnumType string
+
+const (
+	UnpublishFirmwareStatusDownloadOngoing UnpublishFirmwareStatusEnumType = "DownloadOngoing"
+	UnpublishFirmwareStatusNoFirmware      UnpublishFirmwareStatusEnumType = "NoFirmware"
+	UnpublishFirmwareStatusUnpublished     UnpublishFirmwareStatusEnumType = "Unpublished"
+)
+
+// IsValid reports whether e is one of the UnpublishFirmwareStatusEnumType values
+func (e UnpublishFirmwareStatusEnumType) IsValid() bool {
+	switch e {
+	case UnpublishFirmwareStatusDownloadOngoing,
+		UnpublishFirmwareStatusNoFirmware,
+		UnpublishFirmwareStatusUnpublished:
+		return true
+	}
+	return false
+}
+
+type UpdateEnumType string
+
+const (
+	UpdateDifferential UpdateEnumType = "Differential"
+	UpdateFull         UpdateEnumType = "Full"
+)
+
+// IsValid reports whether e is one of the UpdateEnumType values
+func (e UpdateEnumType) IsValid() bool {
+	switch e {
+	case UpdateDifferential,
+		UpdateFull:
+		return true
+	}
+	return false
+}
+
+type UpdateFirmwareStatusEnumType string
+
+const (
+	UpdateFirmwareStatusAccepted           UpdateFirmwareStatusEnumType = "Accepted"
+	UpdateFirmwareStatusRejected           UpdateFirmwareStatusEnumType = "Rejected"
+	UpdateFirmwareStatusAcceptedCanceled   UpdateFirmwareStatusEnumType = "AcceptedCanceled"
+	UpdateFirmwareStatusInvalidCertificate UpdateFirmwareStatusEnumType = "InvalidCertificate"
+	UpdateFirmwareStatusRevokedCertificate UpdateFirmwareStatusEnumType = "RevokedCertificate"
+)
+
+// IsValid reports whether e is one of the UpdateFirmwareStatusEnumType values
+func (e UpdateFirmwareStatusEnumType) IsValid() bool {
+	switch e {
+	case UpdateFirmwareStatusAccepted,
+		UpdateFirmwareStatusRejected,
+		UpdateFirmwareStatusAcceptedCanceled,
+		UpdateFirmwareStatusInvalidCertificate,
+		UpdateFirmwareStatusRevokedCertificate:
+		return true
+	}
+	return false
+}
+
+type UploadLogStatusEnumType string
+
+const (
+	UploadLogStatusBadMessage            UploadLogStatusEnumType = "BadMessage"
+	UploadLogStatusIdle                  UploadLogStatusEnumType = "Idle"
+	UploadLogStatusNotSupportedOperation UploadLogStatusEnumType = "NotSupportedOperation"
+	UploadLogStatusPermissionDenied      UploadLogStatusEnumType = "PermissionDenied"
+	UploadLogStatusUploaded              UploadLogStatusEnumType = "Uploaded"
+	UploadLogStatusUploadFailure         UploadLogStatusEnumType = "UploadFailure"
+	UploadLogStatusUploading             UploadLogStatusEnumType = "Uploading"
+	UploadLogStatusAcceptedCanceled      UploadLogStatusEnumType = "AcceptedCanceled"
+)
+
+// IsValid reports whether e is one of the UploadLogStatusEnumType values
+func (e UploadLogStatusEnumType) IsValid() bool {
+	switch e {
+	case UploadLogStatusBadMessage,
+		UploadLogStatusIdle,
+		UploadLogStatusNotSupportedOperation,
+		UploadLogStatusPermissionDenied,
+		UploadLogStatusUploaded,
+		UploadLogStatusUploadFailure,
+		UploadLogStatusUploading,
+		UploadLogStatusAcceptedCanceled:
+		return true
+	}
+	return false
+}
+
+type VPNEnumType string
+
+const (
+	VPNIKEv2 VPNEnumType = "IKEv2"
+	VPNIPSec VPNEnumType = "IPSec"
+	VPNL2TP  VPNEnumType = "L2TP"
+	VPNPPTP  VPNEnumType = "PPTP"
+)
+
+// IsValid reports whether e is one of the VPNEnumType values
+func (e VPNEnumType) IsValid() bool {
+	switch e {
+	case VPNIKEv2,
+		VPNIPSec,
+		VPNL2TP,
+		VPNPPTP:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+// Code generated by ocppgen from the OCPP JSON schemas. DO NOT EDIT.
+
+package v21
+
+import (
+	"github.com/aliml92/ocpp/internal/validation"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+// Validate checks payloads against their validator tags
+var Validate = validation.New()
+
+func IsISO8601Date(fl validator.FieldLevel) bool {
+	return validation.IsISO8601Date(fl)
+}
+
+// register the enum types as validator tags of the same name
+func init() {
+	Validate.RegisterValidation("APNAuthenticationEnumType", validation.Enum(APNAuthenticationEnumType.IsValid))
+	Validate.RegisterValidation("AttributeEnumType", validation.Enum(AttributeEnumType.IsValid))
+	Validate.RegisterValidation("AuthorizationStatusEnumType", validation.Enum(AuthorizationStatusEnumType.IsValid))
+	Validate.RegisterValidation("AuthorizeCertificateStatusEnumType", validation.Enum(AuthorizeCertificateStatusEnumType.IsValid))
+	Validate.RegisterValidation("BatterySwapEventEnumType", validation.Enum(BatterySwapEventEnumType.IsValid))
+	Validate.RegisterValidation("BootReasonEnumType", validation.Enum(BootReasonEnumType.IsValid))
+	Validate.RegisterValidation("CancelReservationStatusEnumType", validation.Enum(CancelReservationStatusEnumType.IsValid))
+	Validate.RegisterValidation("CertificateActionEnumType", validation.Enum(CertificateActionEnumType.IsValid))
+	Validate.RegisterValidation("CertificateSignedStatusEnumType", validation.Enum(CertificateSignedStatusEnumType.IsValid))
+	Validate.RegisterValidation("CertificateSigningUseEnumType", validation.Enum(CertificateSigningUseEnumType.IsValid))
+	Validate.RegisterValidation("CertificateStatusEnumType", validation.Enum(CertificateStatusEnumType.IsValid))
+	Validate.RegisterValidation("CertificateStatusSourceEnumType", validation.Enum(CertificateStatusSourceEnumType.IsValid))
+	Validate.RegisterValidation("ChangeAvailabilityStatusEnumType", validation.Enum(ChangeAvailabilityStatusEnumType.IsValid))
+	Validate.RegisterValidation("ChargingLimitSourceEnumType", validation.Enum(ChargingLimitSourceEnumType.IsValid))
+	Validate.RegisterValidation("ChargingProfileKindEnumType", validation.Enum(ChargingProfileKindEnumType.IsValid))
+	Validate.RegisterValidation("ChargingProfilePurposeEnumType", validation.Enum(ChargingProfilePurposeEnumType.IsValid))
+	Validate.RegisterValidation("ChargingProfileStatusEnumType", validation.Enum(ChargingProfileStatusEnumType.IsValid))
+	Validate.RegisterValidation("ChargingRateUnitEnumType", validation.Enum(ChargingRateUnitEnumType.IsValid))
+	Validate.RegisterValidation("ChargingStateEnumType", validation.Enum(ChargingStateEnumType.IsValid))
+	Validate.RegisterValidation("ClearCacheStatusEnumType", validation.Enum(ClearCacheStatusEnumType.IsValid))
+	Validate.RegisterValidation("ClearChargingProfileStatusEnumType", validation.Enum(ClearChargingProfileStatusEnumType.IsValid))
+	Validate.RegisterValidation("ClearMessageStatusEnumType", validation.Enum(ClearMessageStatusEnumType.IsValid))
+	Validate.RegisterValidation("ClearMonitoringStatusEnumType", validation.Enum(ClearMonitoringStatusEnumType.IsValid))
+	Validate.RegisterValidation("ComponentCriterionEnumType", validation.Enum(ComponentCriterionEnumType.IsValid))
+	Validate.RegisterValidation("ConnectorEnumType", validation.Enum(ConnectorEnumType.IsValid))
+	Validate.RegisterValidation("ConnectorStatusEnumType", validation.Enum(ConnectorStatusEnumType.IsValid))
+	Validate.RegisterValidation("ControlModeEnumType", validation.Enum(ControlModeEnumType.IsValid))
+	Validate.RegisterValidation("CostKindEnumType", validation.Enum(CostKindEnumType.IsValid))
+	Validate.RegisterValidation("CustomerInformationStatusEnumType", validation.Enum(CustomerInformationStatusEnumType.IsValid))
+	Validate.RegisterValidation("DERControlEnumType", validation.Enum(DERControlEnumType.IsValid))
+	Validate.RegisterValidation("DERControlStatusEnumType", validation.Enum(DERControlStatusEnumType.IsValid))
+	Validate.RegisterValidation("DERUnitEnumType", validation.Enum(DERUnitEnumType.IsValid))
+	Validate.RegisterValidation("DataEnumType", validation.Enum(DataEnumType.IsValid))
+	Validate.RegisterValidation("DataTransferStatusEnumType", validation.Enum(DataTransferStatusEnumType.IsValid))
+	Validate.RegisterValidation("DayOfWeekEnumType", validation.Enum(DayOfWeekEnumType.IsValid))
+	Validate.RegisterValidation("DeleteCertificateStatusEnumType", validation.Enum(DeleteCertificateStatusEnumType.IsValid))
+	Validate.RegisterValidation("DisplayMessageStatusEnumType", validation.Enum(DisplayMessageStatusEnumType.IsValid))
+	Validate.RegisterValidation("EnergyTransferModeEnumType", validation.Enum(EnergyTransferModeEnumType.IsValid))
+	Validate.RegisterValidation("EventNotificationEnumType", validation.Enum(EventNotificationEnumType.IsValid))
+	Validate.RegisterValidation("EventTriggerEnumType", validation.Enum(EventTriggerEnumType.IsValid))
+	Validate.RegisterValidation("EvseKindEnumType", validation.Enum(EvseKindEnumType.IsValid))
+	Validate.RegisterValidation("FirmwareStatusEnumType", validation.Enum(FirmwareStatusEnumType.IsValid))
+	Validate.RegisterValidation("GenericDeviceModelStatusEnumType", validation.Enum(GenericDeviceModelStatusEnumType.IsValid))
+	Validate.RegisterValidation("GenericStatusEnumType", validation.Enum(GenericStatusEnumType.IsValid))
+	Validate.RegisterValidation("GetCertificateIdUseEnumType", validation.Enum(GetCertificateIdUseEnumType.IsValid))
+	Validate.RegisterValidation("GetCertificateStatusEnumType", validation.Enum(GetCertificateStatusEnumType.IsValid))
+	Validate.RegisterValidation("GetChargingProfileStatusEnumType", validation.Enum(GetChargingProfileStatusEnumType.IsValid))
+	Validate.RegisterValidation("GetDisplayMessagesStatusEnumType", validation.Enum(GetDisplayMessagesStatusEnumType.IsValid))
+	Validate.RegisterValidation("GetInstalledCertificateStatusEnumType", validation.Enum(GetInstalledCertificateStatusEnumType.IsValid))
+	Validate.RegisterValidation("GetVariableStatusEnumType", validation.Enum(GetVariableStatusEnumType.IsValid))
+	Validate.RegisterValidation("GridEventFaultEnumType", validation.Enum(GridEventFaultEnumType.IsValid))
+	Validate.RegisterValidation("HashAlgorithmEnumType", validation.Enum(HashAlgorithmEnumType.IsValid))
+	Validate.RegisterValidation("IdTokenEnumType", validation.Enum(IdTokenEnumType.IsValid))
+	Validate.RegisterValidation("InstallCertificateStatusEnumType", validation.Enum(InstallCertificateStatusEnumType.IsValid))
+	Validate.RegisterValidation("InstallCertificateUseEnumType", validation.Enum(InstallCertificateUseEnumType.IsValid))
+	Validate.RegisterValidation("Iso15118EVCertificateStatusEnumType", validation.Enum(Iso15118EVCertificateStatusEnumType.IsValid))
+	Validate.RegisterValidation("LocationEnumType", validation.Enum(LocationEnumType.IsValid))
+	Validate.RegisterValidation("LogEnumType", validation.Enum(LogEnumType.IsValid))
+	Validate.RegisterValidation("LogStatusEnumType", validation.Enum(LogStatusEnumType.IsValid))
+	Validate.RegisterValidation("MeasurandEnumType", validation.Enum(MeasurandEnumType.IsValid))
+	Validate.RegisterValidation("MessageFormatEnumType", validation.Enum(MessageFormatEnumType.IsValid))
+	Validate.RegisterValidation("MessagePriorityEnumType", validation.Enum(MessagePriorityEnumType.IsValid))
+	Validate.RegisterValidation("MessageStateEnumType", validation.Enum(MessageStateEnumType.IsValid))
+	Validate.RegisterValidation("MessageTriggerEnumType", validation.Enum(MessageTriggerEnumType.IsValid))
+	Validate.RegisterValidation("MobilityNeedsModeEnumType", validation.Enum(MobilityNeedsModeEnumType.IsValid))
+	Validate.RegisterValidation("MonitorEnumType", validation.Enum(MonitorEnumType.IsValid))
+	Validate.RegisterValidation("MonitoringBaseEnumType", validation.Enum(MonitoringBaseEnumType.IsValid))
+	Validate.RegisterValidation("MonitoringCriterionEnumType", validation.Enum(MonitoringCriterionEnumType.IsValid))
+	Validate.RegisterValidation("MutabilityEnumType", validation.Enum(MutabilityEnumType.IsValid))
+	Validate.RegisterValidation("NotifyAllowedEnergyTransferStatusEnumType", validation.Enum(NotifyAllowedEnergyTransferStatusEnumType.IsValid))
+	Validate.RegisterValidation("NotifyEVChargingNeedsStatusEnumType", validation.Enum(NotifyEVChargingNeedsStatusEnumType.IsValid))
+	Validate.RegisterValidation("OCPPInterfaceEnumType", validation.Enum(OCPPInterfaceEnumType.IsValid))
+	Validate.RegisterValidation("OCPPTransportEnumType", validation.Enum(OCPPTransportEnumType.IsValid))
+	Validate.RegisterValidation("OCPPVersionEnumType", validation.Enum(OCPPVersionEnumType.IsValid))
+	Validate.RegisterValidation("OperationModeEnumType", validation.Enum(OperationModeEnumType.IsValid))
+	Validate.RegisterValidation("OperationalStatusEnumType", validation.Enum(OperationalStatusEnumType.IsValid))
+	Validate.RegisterValidation("PaymentStatusEnumType", validation.Enum(PaymentStatusEnumType.IsValid))
+	Validate.RegisterValidation("PhaseEnumType", validation.Enum(PhaseEnumType.IsValid))
+	Validate.RegisterValidation("PowerDuringCessationEnumType", validation.Enum(PowerDuringCessationEnumType.IsValid))
+	Validate.RegisterValidation("PriorityChargingStatusEnumType", validation.Enum(PriorityChargingStatusEnumType.IsValid))
+	Validate.RegisterValidation("PublishFirmwareStatusEnumType", validation.Enum(PublishFirmwareStatusEnumType.IsValid))
+	Validate.RegisterValidation("ReadingContextEnumType", validation.Enum(ReadingContextEnumType.IsValid))
+	Validate.RegisterValidation("ReasonEnumType", validation.Enum(ReasonEnumType.IsValid))
+	Validate.RegisterValidation("RecurrencyKindEnumType", validation.Enum(RecurrencyKindEnumType.IsValid))
+	Validate.RegisterValidation("RegistrationStatusEnumType", validation.Enum(RegistrationStatusEnumType.IsValid))
+	Validate.RegisterValidation("ReportBaseEnumType", validation.Enum(ReportBaseEnumType.IsValid))
+	Validate.RegisterValidation("RequestStartStopStatusEnumType", validation.Enum(RequestStartStopStatusEnumType.IsValid))
+	Validate.RegisterValidation("ReservationUpdateStatusEnumType", validation.Enum(ReservationUpdateStatusEnumType.IsValid))
+	Validate.RegisterValidation("ReserveNowStatusEnumType", validation.Enum(ReserveNowStatusEnumType.IsValid))
+	Validate.RegisterValidation("ResetEnumType", validation.Enum(ResetEnumType.IsValid))
+	Validate.RegisterValidation("ResetStatusEnumType", validation.Enum(ResetStatusEnumType.IsValid))
+	Validate.RegisterValidation("SendLocalListStatusEnumType", validation.Enum(SendLocalListStatusEnumType.IsValid))
+	Validate.RegisterValidation("SetMonitoringStatusEnumType", validation.Enum(SetMonitoringStatusEnumType.IsValid))
+	Validate.RegisterValidation("SetNetworkProfileStatusEnumType", validation.Enum(SetNetworkProfileStatusEnumType.IsValid))
+	Validate.RegisterValidation("SetVariableStatusEnumType", validation.Enum(SetVariableStatusEnumType.IsValid))
+	Validate.RegisterValidation("TariffChangeStatusEnumType", validation.Enum(TariffChangeStatusEnumType.IsValid))
+	Validate.RegisterValidation("TariffClearStatusEnumType", validation.Enum(TariffClearStatusEnumType.IsValid))
+	Validate.RegisterValidation("TariffGetStatusEnumType", validation.Enum(TariffGetStatusEnumType.IsValid))
+	Validate.RegisterValidation("TariffKindEnumType", validation.Enum(TariffKindEnumType.IsValid))
+	Validate.RegisterValidation("TariffSetStatusEnumType", validation.Enum(TariffSetStatusEnumType.IsValid))
+	Validate.RegisterValidation("TransactionEventEnumType", validation.Enum(TransactionEventEnumType.IsValid))
+	Validate.RegisterValidation("TriggerMessageStatusEnumType", validation.Enum(TriggerMessageStatusEnumType.IsValid))
+	Validate.RegisterValidation("TriggerReasonEnumType", validation.Enum(TriggerReasonEnumType.IsValid))
+	Validate.RegisterValidation("UnlockStatusEnumType", validation.Enum(UnlockStatusEnumType.IsValid))
+	Validate.RegisterValidation("UnpublishFirmwareStatusEnumType", validation.Enum(UnpublishFirmwareStatusEnumType.IsValid))
+	Validate.RegisterValidation("UpdateEnumType", validation.Enum(UpdateEnumType.IsValid))
+	Validate.RegisterValidation("UpdateFirmwareStatusEnumType", validation.Enum(UpdateFirmwareStatusEnumType.IsValid))
+	Validate.RegisterValidation("UploadLogStatusEnumType", validation.Enum(UploadLogStatusEnumType.IsValid))
+	Validate.RegisterValidation("VPNEnumType", validation.Enum(VPNEnumType.IsValid))
+}
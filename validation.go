@@ -0,0 +1,192 @@
+package ocpp
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	"gopkg.in/go-playground/validator.v9"
+)
+
+// payloadError converts an error of json.Unmarshal or of the validator into
+// an ocppError whose cause and details name the failing JSON fields, e.g.
+// "meterValue[0].sampledValue[1].measurand: Measurand"
+func payloadError(err error) *ocppError {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		path := typeErr.Field
+		if path == "" {
+			path = "payload"
+		}
+		field := path + ": " + typeErr.Value + " is not " + typeErr.Type.String()
+		return &ocppError{
+			code:    TypeConstraintViolation,
+			cause:   "Payload is not valid: " + field,
+			details: map[string]interface{}{"errors": []string{field}},
+		}
+	}
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		var code ErrorCode = FormatViolation
+		var syntaxErr *json.SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			code = PropertyConstraintViolation
+		}
+		return &ocppError{
+			code:  code,
+			cause: "Payload is not valid: " + err.Error(),
+		}
+	}
+	fields := make([]string, 0, len(validationErrs))
+	var code ErrorCode
+	for _, fe := range validationErrs {
+		rule := fe.Tag()
+		if fe.Param() != "" {
+			rule += "=" + fe.Param()
+		}
+		fields = append(fields, fieldPath(fe.Namespace())+": "+rule)
+		if code == "" {
+			code = validationErrorCode(fe)
+		}
+	}
+	return &ocppError{
+		code:    code,
+		cause:   "Payload is not valid: " + strings.Join(fields, ", "),
+		details: map[string]interface{}{"errors": fields},
+	}
+}
+
+// fieldPath strips the name of the payload type from a validator namespace
+func fieldPath(namespace string) string {
+	if i := strings.IndexByte(namespace, '.'); i >= 0 {
+		return namespace[i+1:]
+	}
+	return namespace
+}
+
+// validationErrorCode returns OccurrenceConstraintViolation for missing fields
+// and wrong number of array elements, and PropertyConstraintViolation for
+// invalid values such as wrong enum values or too long strings
+func validationErrorCode(fe validator.FieldError) ErrorCode {
+	switch fe.Tag() {
+	case "required":
+		return OccurrenceConstraintViolation
+	case "min", "max", "len", "gt", "gte", "lt", "lte":
+		if k := fe.Kind(); k == reflect.Slice || k == reflect.Array {
+			return OccurrenceConstraintViolation
+		}
+	}
+	return PropertyConstraintViolation
+}
+
+// ValidationMode controls what happens to a payload that fails validation
+type ValidationMode int
+
+const (
+	// ValidationStrict rejects invalid payloads, it is the default
+	ValidationStrict ValidationMode = iota
+	// ValidationWarn logs invalid payloads and processes them anyway
+	ValidationWarn
+	// ValidationOff skips validation
+	ValidationOff
+)
+
+// Direction selects the payloads a validation mode applies to
+type Direction int
+
+const (
+	// Incoming are payloads of received Calls and CallResults
+	Incoming Direction = 1 << iota
+	// Outgoing are payloads of sent Calls and CallResults
+	Outgoing
+	BothDirections = Incoming | Outgoing
+)
+
+func (d Direction) String() string {
+	switch d {
+	case Incoming:
+		return "incoming"
+	case Outgoing:
+		return "outgoing"
+	}
+	return "both directions"
+}
+
+type validationKey struct {
+	action string
+	dir    Direction
+}
+
+// validationModes maps actions and directions to validation modes,
+// the empty action holds the mode of all actions without their own
+type validationModes map[validationKey]ValidationMode
+
+func (m validationModes) set(action string, dir Direction, mode ValidationMode) {
+	for _, d := range []Direction{Incoming, Outgoing} {
+		if dir&d != 0 {
+			m[validationKey{action, d}] = mode
+		}
+	}
+}
+
+func (m validationModes) get(action string, dir Direction) ValidationMode {
+	if mode, ok := m[validationKey{action, dir}]; ok {
+		return mode
+	}
+	return m[validationKey{"", dir}]
+}
+
+// checkRequest validates the payload of a Call, raw is the payload as
+// received and nil for outgoing Calls
+func (cp *ChargePoint) checkRequest(action string, dir Direction, p Payload, raw json.RawMessage) *ocppError {
+	return cp.checkPayload(action, dir, false, p, raw)
+}
+
+// checkResponse validates the payload of a CallResult, raw is the payload as
+// received and nil for outgoing CallResults
+func (cp *ChargePoint) checkResponse(action string, dir Direction, p Payload, raw json.RawMessage) *ocppError {
+	return cp.checkPayload(action, dir, true, p, raw)
+}
+
+// checkPayload validates p according to the validation mode and backend of
+// the peer for action and dir
+func (cp *ChargePoint) checkPayload(action string, dir Direction, response bool, p Payload, raw json.RawMessage) *ocppError {
+	mode := cp.peer.getValidationMode(action, dir)
+	if mode == ValidationOff {
+		return nil
+	}
+	var e *ocppError
+	if cp.peer.getValidationBackend() == ValidationSchema {
+		e = cp.validateSchema(action, response, p, raw)
+	} else if err := cp.validatePayload(p); err != nil {
+		e = payloadError(err)
+	}
+	if e == nil {
+		return nil
+	}
+	if mode == ValidationWarn {
+		log.Errorf("ignoring invalid %s %s payload of %s: %s", dir, action, cp.Id, e.cause)
+		return nil
+	}
+	return e
+}
+
+// validateSchema checks raw, or p marshalled if raw is nil, against the JSON
+// schema of action
+func (cp *ChargePoint) validateSchema(action string, response bool, p Payload, raw json.RawMessage) *ocppError {
+	set := getSchemaSet(cp.proto)
+	if set == nil {
+		return nil
+	}
+	if raw == nil {
+		var err error
+		if raw, err = json.Marshal(p); err != nil {
+			return payloadError(err)
+		}
+	}
+	if err := set.validate(action, response, raw); err != nil {
+		return schemaError(err)
+	}
+	return nil
+}
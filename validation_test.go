@@ -0,0 +1,131 @@
+package ocpp
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aliml92/ocpp/v16"
+)
+
+func TestPayloadError(t *testing.T) {
+	cases := []struct {
+		name    string
+		action  string
+		payload string
+		code    ErrorCode
+		fields  []string
+	}{
+		{
+			"wrong enum value",
+			"MeterValues",
+			`{"connectorId":1,"meterValue":[{"timestamp":"2022-01-01T00:00:00Z","sampledValue":[{"value":"1"},{"value":"2","measurand":"Bogus"}]}]}`,
+			PropertyConstraintViolation,
+			[]string{"meterValue[0].sampledValue[1].measurand: Measurand"},
+		},
+		{
+			"missing required field",
+			"Authorize",
+			`{}`,
+			OccurrenceConstraintViolation,
+			[]string{"idTag: required"},
+		},
+		{
+			"missing required integer",
+			"StartTransaction",
+			`{"connectorId":1,"idTag":"tag","timestamp":"2022-01-01T00:00:00Z"}`,
+			OccurrenceConstraintViolation,
+			[]string{"meterStart: required"},
+		},
+		{
+			"missing required connector",
+			"MeterValues",
+			`{"meterValue":[{"timestamp":"2022-01-01T00:00:00Z","sampledValue":[{"value":"1"}]}]}`,
+			OccurrenceConstraintViolation,
+			[]string{"connectorId: required"},
+		},
+		{
+			"empty payload",
+			"CancelReservation",
+			`{}`,
+			OccurrenceConstraintViolation,
+			[]string{"reservationId: required"},
+		},
+		{
+			"too long string",
+			"Authorize",
+			`{"idTag":"0123456789012345678901"}`,
+			PropertyConstraintViolation,
+			[]string{"idTag: max=20"},
+		},
+		{
+			"wrong type",
+			"Authorize",
+			`{"idTag":1}`,
+			TypeConstraintViolation,
+			[]string{"idTag: number is not string"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			p, err := unmarshalRequestPayload(c.action, []byte(c.payload), ocppV16)
+			if err == nil {
+				err = payloadError(validateV16.Struct(p))
+			}
+			var ocppErr *ocppError
+			if !errors.As(err, &ocppErr) {
+				t.Fatalf("got %v want *ocppError", err)
+			}
+			if ocppErr.code != c.code {
+				t.Errorf("got code %s want %s", ocppErr.code, c.code)
+			}
+			if got := ocppErr.details["errors"]; !reflect.DeepEqual(got, c.fields) {
+				t.Errorf("got fields %v want %v", got, c.fields)
+			}
+		})
+	}
+}
+
+func TestValidationMode(t *testing.T) {
+	csms, ts := newTestServer(t, 10)
+	csms.On("Authorize", func(cp *ChargePoint, p Payload) Payload {
+		return &v16.AuthorizeConf{IdTagInfo: v16.IdTagInfo{Status: "Accepted"}}
+	})
+	csms.On("Heartbeat", func(cp *ChargePoint, p Payload) Payload {
+		return &v16.HeartbeatConf{CurrentTime: "yesterday"}
+	})
+	c := NewClient()
+	c.SetID("cp1")
+	c.AddSubProtocol(ocppV16)
+	c.SetCallQueueSize(8)
+	// let the client send a too long idTag
+	c.SetValidationMode("Authorize", Outgoing, ValidationOff)
+	cp, err := c.Start("ws"+strings.TrimPrefix(ts.URL, "http"), "/ws")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cp.Shutdown()
+	longTag := &v16.AuthorizeReq{IdTag: "0123456789012345678901"}
+
+	_, err = cp.Call("Authorize", longTag)
+	var callErr *CallError
+	if !errors.As(err, &callErr) || callErr.ErrorCode != PropertyConstraintViolation {
+		t.Errorf("strict: got %v want PropertyConstraintViolation", err)
+	}
+	csms.SetValidationMode("Authorize", Incoming, ValidationWarn)
+	if _, err := cp.Call("Authorize", longTag); err != nil {
+		t.Errorf("warn: got %v", err)
+	}
+
+	_, err = cp.Call("Heartbeat", &v16.HeartbeatReq{})
+	if !errors.As(err, &callErr) || callErr.ErrorCode != InternalError {
+		t.Errorf("invalid response: got %v want InternalError", err)
+	}
+	csms.SetValidationMode("", Outgoing, ValidationOff)
+	c.SetValidationMode("Heartbeat", BothDirections, ValidationOff)
+	res, err := cp.Call("Heartbeat", &v16.HeartbeatReq{})
+	if err != nil || res.(*v16.HeartbeatConf).CurrentTime != "yesterday" {
+		t.Errorf("off: got %v, %v", res, err)
+	}
+}
@@ -0,0 +1,113 @@
+package ocpp
+
+import "sync"
+
+// default number of goroutines running action handlers of a Server or Client
+const defaultWorkerPoolSize = 16
+
+// maxQueuedJobs is the number of Calls of a single ChargePoint waiting for
+// their handler with strict ordering
+const maxQueuedJobs = 64
+
+// workerPool runs action handlers on a fixed number of goroutines,
+// so a slow handler does not block the reader goroutine of a ChargePoint
+type workerPool struct {
+	mu     sync.Mutex
+	closed bool
+	tasks  chan func()
+}
+
+func newWorkerPool(size int) *workerPool {
+	p := &workerPool{
+		tasks: make(chan func(), 64*size),
+	}
+	for i := 0; i < size; i++ {
+		go p.work()
+	}
+	return p
+}
+
+func (p *workerPool) work() {
+	for task := range p.tasks {
+		task()
+	}
+}
+
+// submit queues task without blocking and returns false if the queue is full
+// or the pool is closed. Waiting for room would stall the reader goroutine,
+// which must keep reading the CallResults the running handlers may wait for
+func (p *workerPool) submit(task func()) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return false
+	}
+	select {
+	case p.tasks <- task:
+		return true
+	default:
+		return false
+	}
+}
+
+// close stops the goroutines of the pool once the queued tasks have run
+func (p *workerPool) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.closed {
+		p.closed = true
+		close(p.tasks)
+	}
+}
+
+// schedule runs an incoming Call handler job on the worker pool of the peer.
+// With strict ordering the jobs of a single ChargePoint run one at a time
+// in the order the Calls arrived. It returns false if the pool or the queue
+// of the ChargePoint is full
+func (cp *ChargePoint) schedule(job func()) bool {
+	pool, ordered := cp.peer.getWorkerPool()
+	if pool == nil {
+		job()
+		return true
+	}
+	if !ordered {
+		return pool.submit(job)
+	}
+	cp.jobsMu.Lock()
+	if len(cp.jobs) >= maxQueuedJobs {
+		cp.jobsMu.Unlock()
+		return false
+	}
+	cp.jobs = append(cp.jobs, job)
+	if cp.jobsRunning {
+		cp.jobsMu.Unlock()
+		return true
+	}
+	cp.jobsRunning = true
+	cp.jobsMu.Unlock()
+	if !pool.submit(cp.runJobs) {
+		// schedule is only called by the reader, job is the only one queued
+		cp.jobsMu.Lock()
+		cp.jobs, cp.jobsRunning = nil, false
+		cp.jobsMu.Unlock()
+		return false
+	}
+	return true
+}
+
+// runJobs runs queued jobs of the ChargePoint until the queue is empty
+func (cp *ChargePoint) runJobs() {
+	for {
+		cp.jobsMu.Lock()
+		if len(cp.jobs) == 0 {
+			cp.jobsRunning = false
+			cp.jobsMu.Unlock()
+			return
+		}
+		job := cp.jobs[0]
+		cp.jobs[0] = nil
+		cp.jobs = cp.jobs[1:]
+		cp.jobsMu.Unlock()
+		job()
+	}
+}
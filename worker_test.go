@@ -0,0 +1,65 @@
+package ocpp
+
+import "testing"
+
+func TestWorkerPoolRejectsWhenFull(t *testing.T) {
+	p := newWorkerPool(1)
+	release := make(chan struct{})
+	started := make(chan struct{})
+	if !p.submit(func() {
+		close(started)
+		<-release
+	}) {
+		t.Fatal("first task rejected")
+	}
+	<-started
+	done := make(chan struct{}, 64)
+	for i := 0; i < 64; i++ {
+		if !p.submit(func() { done <- struct{}{} }) {
+			t.Fatalf("task %d rejected before the queue is full", i)
+		}
+	}
+	if p.submit(func() {}) {
+		t.Error("task accepted with a full queue")
+	}
+	close(release)
+	p.close()
+	for i := 0; i < 64; i++ {
+		<-done
+	}
+	if p.submit(func() {}) {
+		t.Error("task accepted after close")
+	}
+}
+
+func TestScheduleRejectsWhenChargePointQueueFull(t *testing.T) {
+	s := NewServer()
+	s.SetWorkerPoolSize(1)
+	cp := &ChargePoint{peer: s}
+	release := make(chan struct{})
+	started := make(chan struct{})
+	if !cp.schedule(func() {
+		close(started)
+		<-release
+	}) {
+		t.Fatal("first job rejected")
+	}
+	<-started
+	done := make(chan int, maxQueuedJobs)
+	for i := 0; i < maxQueuedJobs; i++ {
+		i := i
+		if !cp.schedule(func() { done <- i }) {
+			t.Fatalf("job %d rejected before the queue is full", i)
+		}
+	}
+	if cp.schedule(func() {}) {
+		t.Error("job accepted with a full queue")
+	}
+	close(release)
+	for i := 0; i < maxQueuedJobs; i++ {
+		if got := <-done; got != i {
+			t.Fatalf("got job %d want %d", got, i)
+		}
+	}
+	s.workers.close()
+}